@@ -1,8 +1,11 @@
 package mocks
 
 import (
+	"bytes"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	"io/ioutil"
 	"net/http"
 )
 
@@ -35,7 +38,15 @@ func CreateMockClient() *api.Client {
 		return "mockToken", nil
 	}
 
-	auth := authentication.NewAuthenticator("test", nil)
+	// The authenticator gets its own stub so that tests replacing DoFunc only see API requests
+	tokenClient := &MockHttpClient{DoFunc: func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"access_token":"mockToken"}`)),
+		}, nil
+	}}
+	credentials := &models.APICredentials{ClientID: "test", ClientSecret: "test", Subdomain: "test"}
+	auth := authentication.NewAuthenticator("test", credentials, authentication.WithHTTPClient(tokenClient))
 	client := &api.Client{
 		HttpClient: mockClient,
 		Auth:       auth,
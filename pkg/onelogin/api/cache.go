@@ -0,0 +1,221 @@
+package api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheEntries is the number of responses kept by the MemoryCacheStorage of NewResponseCache.
+const DefaultCacheEntries = 1000
+
+// CachedResponse is a successful GET response kept by a CacheStorage.
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	StoredAt   time.Time
+	ExpiresAt  time.Time // After this the response is revalidated with its ETag, or fetched again
+}
+
+// CacheStorage keeps cached responses under their request URL, prefixed with the TokenKey of the
+// credentials they were fetched with. Implementations must be safe for concurrent use, and may be shared
+// by several clients, which never see each other's responses.
+type CacheStorage interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, response *CachedResponse)
+	Delete(key string)
+	DeletePrefix(prefix string)
+}
+
+// ResponseCache caches the responses of GET requests sent by a Client, see WithResponseCache.
+// Responses are kept for the TTL of the longest prefix in TTLs that matches the request path, or for
+// DefaultTTL when none matches; a TTL of 0 disables caching. An expired response with an ETag or
+// Last-Modified header is revalidated with If-None-Match or If-Modified-Since, so an unchanged
+// resource costs no body transfer. Any other request invalidates the cached responses of its resource,
+// e.g. updating /api/2/roles/5 drops everything cached under /api/2/roles.
+type ResponseCache struct {
+	Storage    CacheStorage             // Where responses are kept
+	DefaultTTL time.Duration            // TTL of paths that match no prefix in TTLs
+	TTLs       map[string]time.Duration // TTL by path prefix, e.g. "/api/2/roles"
+}
+
+// NewResponseCache returns a ResponseCache that keeps up to DefaultCacheEntries responses in memory
+// for the paths in ttls.
+func NewResponseCache(ttls map[string]time.Duration) *ResponseCache {
+	return &ResponseCache{Storage: NewMemoryCacheStorage(DefaultCacheEntries), TTLs: ttls}
+}
+
+// ttl returns how long responses for path are kept.
+func (rc *ResponseCache) ttl(path string) time.Duration {
+	ttl, longest := rc.DefaultTTL, -1
+	for prefix, t := range rc.TTLs {
+		if len(prefix) > longest && hasPathPrefix(path, prefix) {
+			ttl, longest = t, len(prefix)
+		}
+	}
+	return ttl
+}
+
+// cacheKey returns the storage key of the URL u fetched with the credentials identified by namespace, so that
+// clients of different tenants or credentials sharing a storage keep their responses apart.
+func cacheKey(namespace, u string) string {
+	return namespace + " " + u
+}
+
+// do answers a GET request made with the credentials identified by namespace from the cache when possible,
+// otherwise it sends it with send and caches the response.
+func (rc *ResponseCache) do(namespace string, req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ttl := rc.ttl(req.URL.Path)
+	if ttl <= 0 || rc.Storage == nil {
+		return send(req)
+	}
+
+	key := cacheKey(namespace, req.URL.String())
+	cached, ok := rc.Storage.Get(key)
+	now := time.Now()
+	if ok && now.Before(cached.ExpiresAt) {
+		return cached.response(req), nil
+	}
+	sent := req
+	if ok {
+		// the validators go on a copy, so the caller's request is left as it was
+		sent = req.Clone(req.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			sent.Header.Set("If-None-Match", etag)
+		} else if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			sent.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := send(sent)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && ok:
+		drainBody(resp.Body)
+		refreshed := *cached
+		refreshed.ExpiresAt = time.Now().Add(ttl)
+		rc.Storage.Set(key, &refreshed)
+		return refreshed.response(req), nil
+	case resp.StatusCode != http.StatusOK || strings.Contains(resp.Header.Get("Cache-Control"), "no-store"):
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rc.Storage.Set(key, &CachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+		StoredAt:   now,
+		ExpiresAt:  time.Now().Add(ttl),
+	})
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// invalidate drops the responses cached with the credentials identified by namespace for the resource u belongs to.
+func (rc *ResponseCache) invalidate(namespace string, u *url.URL) {
+	if rc.Storage == nil {
+		return
+	}
+	prefix := cacheKey(namespace, u.Scheme+"://"+u.Host+resourcePath(u.Path))
+	rc.Storage.Delete(prefix)
+	rc.Storage.DeletePrefix(prefix + "/")
+	rc.Storage.DeletePrefix(prefix + "?")
+}
+
+// resourcePath returns the collection a path belongs to, e.g. /api/2/roles for /api/2/roles/5/users.
+func resourcePath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	n := 1
+	if segments[0] == "api" && len(segments) >= 3 {
+		n = 3
+	}
+	if n > len(segments) {
+		n = len(segments)
+	}
+	return "/" + strings.Join(segments[:n], "/")
+}
+
+// hasPathPrefix reports whether path is prefix or lies below it.
+func hasPathPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// response builds an HTTP response for req from the cached response.
+func (cr *CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(cr.StatusCode) + " " + http.StatusText(cr.StatusCode),
+		StatusCode:    cr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cr.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(cr.Body)),
+		ContentLength: int64(len(cr.Body)),
+		Request:       req,
+	}
+}
+
+// MemoryCacheStorage keeps cached responses in memory. When it is full, the oldest response is dropped.
+type MemoryCacheStorage struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*CachedResponse
+}
+
+// NewMemoryCacheStorage creates a MemoryCacheStorage holding up to maxEntries responses, or any number
+// of responses when maxEntries is 0.
+func NewMemoryCacheStorage(maxEntries int) *MemoryCacheStorage {
+	return &MemoryCacheStorage{maxEntries: maxEntries, entries: map[string]*CachedResponse{}}
+}
+
+func (s *MemoryCacheStorage) Get(key string) (*CachedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	response, ok := s.entries[key]
+	return response, ok
+}
+
+func (s *MemoryCacheStorage) Set(key string, response *CachedResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok && s.maxEntries > 0 && len(s.entries) >= s.maxEntries {
+		var oldest string
+		for k, r := range s.entries {
+			if oldest == "" || r.StoredAt.Before(s.entries[oldest].StoredAt) {
+				oldest = k
+			}
+		}
+		delete(s.entries, oldest)
+	}
+	s.entries[key] = response
+}
+
+func (s *MemoryCacheStorage) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+}
+
+func (s *MemoryCacheStorage) DeletePrefix(prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k := range s.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(s.entries, k)
+		}
+	}
+}
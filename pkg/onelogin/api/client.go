@@ -2,18 +2,22 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
 	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/logging"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -27,6 +31,12 @@ type Client struct {
 	OLdomain            string                        // OneLogin domain
 	Timeout             time.Duration
 	CredentialsOverride *mod.APICredentials
+	RetryPolicy         *RetryPolicy   // Retry behaviour for failed requests; nil disables retries
+	RateLimiter         *RateLimiter   // Client-side request budget; nil disables rate limiting
+	UserAgent           string         // User-Agent header sent with every request
+	Logger              logging.Logger // Destination for diagnostic messages; nil discards them
+	Middleware          []Middleware   // Hooks run around every request, see Use
+	Cache               *ResponseCache // Cache for GET responses; nil disables caching
 }
 
 // HTTPClient is an interface that defines the Do method for making HTTP requests.
@@ -47,83 +57,268 @@ type IClient interface {
 	DeleteWithBody(path *string, body interface{}) (*http.Response, error)
 	Post(path *string, body interface{}) (*http.Response, error)
 	Put(path *string, body interface{}) (*http.Response, error)
+	GetWithContext(ctx context.Context, path *string, queryParams mod.Queryable) (*http.Response, error)
+	DeleteWithContext(ctx context.Context, path *string) (*http.Response, error)
+	DeleteWithBodyWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error)
+	PostWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error)
+	PutWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error)
+	Do(ctx context.Context, method string, path *string, queryParams interface{}, body interface{}) (*http.Response, error)
 	GetToken() (string, error)
 	GetAccountId() string
+	Close(ctx context.Context) error
 }
 
 // NewClient creates a new instance of the API client.
-func NewClient(credentials *mod.APICredentials, timeoutOverride *time.Duration) (IClient, error) {
+// Settings that are not provided through opts fall back to the ONELOGIN_* environment variables.
+func NewClient(opts ...Option) (IClient, error) {
+	cfg := &config{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	credentials := cfg.credentials
+	profileName := cfg.profile
+	if profileName == "" {
+		profileName = os.Getenv("ONELOGIN_PROFILE")
+	}
+	profile, err := loadProfile(profileName, credentials == nil && cfg.credentialsProvider == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := cfg.credentialsProvider
+	if provider == nil {
+		if profileName != "" {
+			provider = authentication.ProfileChain(credentials, profileName)
+		} else {
+			provider = authentication.DefaultCredentialsChain(credentials)
+		}
+	}
+
 	subdomain := os.Getenv("ONELOGIN_SUBDOMAIN")
+	clientID := os.Getenv("ONELOGIN_CLIENT_ID")
+	if profileName != "" {
+		// A selected profile must not be mixed with credentials left in the environment
+		subdomain, clientID = profile.Subdomain, ""
+	}
 	if credentials != nil {
 		subdomain = credentials.Subdomain
+		clientID = credentials.ClientID
+	} else if cfg.lazyAuth {
+		// The provider may run a command or read secrets, so with lazy auth it isn't asked until the first
+		// token request, which takes the subdomain from its credentials when none is known by then
+		if profile != nil {
+			if profile.Subdomain != "" {
+				subdomain = profile.Subdomain
+			}
+			clientID = profile.ClientID
+		}
+	} else {
+		// Credentials from a profile, a command or mounted secrets can also name the subdomain
+		resolved, err := provider.Credentials(context.Background())
+		if err != nil && !errors.Is(err, authentication.ErrNoCredentials) {
+			return nil, err
+		}
+		if resolved != nil {
+			if resolved.Subdomain != "" {
+				subdomain = resolved.Subdomain
+			}
+			clientID = resolved.ClientID
+		}
+		// The token request below uses them too, so a command isn't run or secrets read twice
+		provider = primedCredentials(provider, resolved, err)
+	}
+
+	region := cfg.region
+	if region == "" && profile != nil {
+		region = profile.Region
+	}
+	old, err := baseURL(cfg.baseURL, region, subdomain)
+	if err != nil && !(cfg.lazyAuth && subdomain == "") {
+		return nil, err
+	}
+
+	var profileTimeout time.Duration
+	if profile != nil {
+		profileTimeout, _ = profile.TimeoutDuration()
 	}
-	old := fmt.Sprintf("https://%s.onelogin.com", subdomain)
-	authenticator := authentication.NewAuthenticator(subdomain, credentials)
 	var timeoutDuration time.Duration
-	if timeoutOverride == nil {
+	if cfg.timeout != nil {
+		timeoutDuration = *cfg.timeout
+	} else if profileName != "" && profileTimeout > 0 {
+		timeoutDuration = profileTimeout
+	} else {
 		timeoutStr := os.Getenv("ONELOGIN_TIMEOUT")
 		timeout, err := strconv.Atoi(timeoutStr)
 		if err != nil || timeout <= 0 {
-			timeout = DefaultTimeout
+			timeoutDuration = time.Second * DefaultTimeout
+			if profileTimeout > 0 {
+				timeoutDuration = profileTimeout
+			}
+		} else {
+			timeoutDuration = time.Second * time.Duration(timeout)
 		}
-		timeoutDuration = time.Second * time.Duration(timeout)
-	} else {
-		timeoutDuration = *timeoutOverride
 	}
 
-	err := authenticator.GenerateToken()
-	if err != nil {
-		return nil, err
+	httpClient := cfg.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Transport: cfg.transport,
+			Timeout:   timeoutDuration,
+		}
+	}
+
+	userAgent := cfg.userAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+
+	retryPolicy := DefaultRetryPolicy()
+	if cfg.retryPolicySet {
+		retryPolicy = cfg.retryPolicy
+	}
+
+	rateLimiter := SharedRateLimiter(subdomain + "/" + clientID)
+	if subdomain == "" || clientID == "" {
+		// Credentials that are resolved lazily can't be matched with those of other clients
+		rateLimiter = NewRateLimiter(false)
+	}
+	if cfg.rateLimiterSet {
+		rateLimiter = cfg.rateLimiter
 	}
-	return &Client{
-		HttpClient: &http.Client{
-			Timeout: timeoutDuration,
-		},
-		Auth:                authenticator,
+
+	client := &Client{
+		HttpClient:          httpClient,
 		OLdomain:            old,
+		Timeout:             timeoutDuration,
 		CredentialsOverride: credentials,
-	}, nil
+		RetryPolicy:         retryPolicy,
+		RateLimiter:         rateLimiter,
+		UserAgent:           userAgent,
+		Logger:              cfg.logger,
+		Middleware:          cfg.middleware,
+		Cache:               cfg.responseCache,
+	}
+
+	// The authenticator shares the API client's transport, middleware and base URL
+	client.Auth = authentication.NewAuthenticator(subdomain, credentials,
+		authentication.WithHTTPClient(middlewareClient{client: client}),
+		authentication.WithBaseURL(old),
+		authentication.WithUserAgent(userAgent),
+		authentication.WithLogger(cfg.logger),
+		authentication.WithTokenStore(cfg.tokenStore),
+		authentication.WithCredentialsProvider(provider),
+	)
+
+	if !cfg.lazyAuth {
+		err := client.Auth.GenerateToken()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// primedCredentials returns a provider that answers its first call with credentials and err, already
+// obtained from provider, and asks provider on later calls so that rotated secrets are picked up.
+func primedCredentials(provider authentication.CredentialsProvider, credentials *mod.APICredentials, err error) authentication.CredentialsProvider {
+	var used int32
+	return authentication.CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		if atomic.CompareAndSwapInt32(&used, 0, 1) {
+			return credentials, err
+		}
+		return provider.Credentials(ctx)
+	})
+}
+
+// loadProfile returns the profile selected by name. Without a name, the default profile is returned
+// if implicit is set and the default credentials chain would take its credentials from it, so that its
+// region and timeout are never applied to credentials from elsewhere.
+func loadProfile(name string, implicit bool) (*authentication.Profile, error) {
+	if name != "" {
+		return authentication.LoadProfile("", name)
+	}
+	if !implicit || os.Getenv("ONELOGIN_CLIENT_ID") != "" || os.Getenv("ONELOGIN_CLIENT_SECRET") != "" {
+		return nil, nil
+	}
+	profile, err := authentication.LoadProfile("", "")
+	if errors.Is(err, authentication.ErrProfileNotFound) {
+		return nil, nil
+	}
+	return profile, err
+}
+
+// baseURL returns the explicit base URL if one is configured, otherwise the regional API host or
+// the tenant subdomain host, see utl.BaseURL.
+func baseURL(explicit, region, subdomain string) (string, error) {
+	if explicit != "" {
+		return utl.NormalizeBaseURL(explicit)
+	}
+	return utl.BaseURL(region, subdomain)
 }
 
 // newRequest creates a new HTTP request with the specified method, path, query parameters, and request body.
-func (c *Client) newRequest(method string, path *string, queryParams mod.Queryable, body io.Reader) (*http.Request, error) {
+// The request is bound to ctx so that cancelling ctx aborts it. Query parameters that implement mod.Queryable
+// and fail validation are reported as an *olerror.ValidationError before anything is sent.
+func (c *Client) newRequest(ctx context.Context, method string, path *string, queryParams interface{}, body io.Reader) (*http.Request, error) {
+	if q, ok := queryParams.(mod.Queryable); ok {
+		if err := mod.ValidateQuery(q); err != nil {
+			return nil, err
+		}
+	}
 
 	p, err := utl.AddQueryToPath(*path, queryParams)
 	if err != nil {
 		return nil, err
 	}
-	log.Println("Path:", p)
-	// Parse the OneLogin domain and path
-	u, err := url.Parse(c.OLdomain + p)
+	// Get authentication token
+	tk, err := c.Auth.GetTokenWithContext(ctx)
 	if err != nil {
+		c.logger().Error("failed to obtain access token", "error", err)
 		return nil, err
 	}
 
-	// Create a new HTTP request
-	req, err := http.NewRequest(method, u.String(), body)
+	// Parse the OneLogin domain and path. A lazily authenticated client learns its domain with the first token.
+	domain := c.OLdomain
+	if domain == "" {
+		domain = c.Auth.BaseURL()
+	}
+	u, err := url.Parse(domain + p)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get authentication token
-	log.Println("Getting authentication token...")
-	tk, err := c.Auth.GetToken()
+	// Create a new HTTP request
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
-		log.Println("Error getting authentication token:", err)
-		return nil, olerror.NewAuthenticationError("Access Token Retrieval Error")
+		return nil, err
 	}
-	log.Println("Authentication token retrieved successfully.")
 
 	// Set request headers
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tk))
 	req.Header.Set("Content-Type", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
 	return req, nil
 }
 
+// logger returns the client's logger wrapped so that secrets are never logged.
+func (c *Client) logger() logging.Logger {
+	return logging.WithRedaction(c.Logger)
+}
+
 // Get sends a GET request to the specified path with the given query parameters.
 func (c *Client) Get(path *string, queryParams mod.Queryable) (*http.Response, error) {
-	req, err := c.newRequest(http.MethodGet, path, queryParams, http.NoBody)
+	return c.GetWithContext(context.Background(), path, queryParams)
+}
+
+// GetWithContext sends a GET request bound to ctx to the specified path with the given query parameters.
+func (c *Client) GetWithContext(ctx context.Context, path *string, queryParams mod.Queryable) (*http.Response, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, path, queryParams, http.NoBody)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +328,12 @@ func (c *Client) Get(path *string, queryParams mod.Queryable) (*http.Response, e
 
 // Delete sends a DELETE request to the specified path with the given query parameters.
 func (c *Client) Delete(path *string) (*http.Response, error) {
-	req, err := c.newRequest(http.MethodDelete, path, nil, http.NoBody)
+	return c.DeleteWithContext(context.Background(), path)
+}
+
+// DeleteWithContext sends a DELETE request bound to ctx to the specified path.
+func (c *Client) DeleteWithContext(ctx context.Context, path *string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, path, nil, http.NoBody)
 	if err != nil {
 		return nil, err
 	}
@@ -143,12 +343,20 @@ func (c *Client) Delete(path *string) (*http.Response, error) {
 
 // Delete sends a DELETE request to the specified path with the given query parameters and request body.
 func (c *Client) DeleteWithBody(path *string, body interface{}) (*http.Response, error) {
-	// Convert request body to JSON
+	return c.DeleteWithBodyWithContext(context.Background(), path, body)
+}
+
+// DeleteWithBodyWithContext sends a DELETE request bound to ctx to the specified path with the given request body.
+func (c *Client) DeleteWithBodyWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error) {
+	// Validate and convert request body to JSON
+	if err := mod.Validate(body); err != nil {
+		return nil, err
+	}
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req, err := c.newRequest(http.MethodDelete, path, nil, bytes.NewReader(jsonBody))
+	req, err := c.newRequest(ctx, http.MethodDelete, path, nil, bytes.NewReader(jsonBody))
 	if err != nil {
 		return nil, err
 	}
@@ -158,13 +366,21 @@ func (c *Client) DeleteWithBody(path *string, body interface{}) (*http.Response,
 
 // Post sends a POST request to the specified path with the given query parameters and request body.
 func (c *Client) Post(path *string, body interface{}) (*http.Response, error) {
-	// Convert request body to JSON
+	return c.PostWithContext(context.Background(), path, body)
+}
+
+// PostWithContext sends a POST request bound to ctx to the specified path with the given request body.
+func (c *Client) PostWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error) {
+	// Validate and convert request body to JSON
+	if err := mod.Validate(body); err != nil {
+		return nil, err
+	}
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := c.newRequest(http.MethodPost, path, nil, bytes.NewReader(jsonBody))
+	req, err := c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(jsonBody))
 	if err != nil {
 		return nil, err
 	}
@@ -174,13 +390,45 @@ func (c *Client) Post(path *string, body interface{}) (*http.Response, error) {
 
 // Put sends a PUT request to the specified path with the given query parameters and request body.
 func (c *Client) Put(path *string, body interface{}) (*http.Response, error) {
-	// Convert request body to JSON
+	return c.PutWithContext(context.Background(), path, body)
+}
+
+// PutWithContext sends a PUT request bound to ctx to the specified path with the given request body.
+func (c *Client) PutWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error) {
+	// Validate and convert request body to JSON
+	if err := mod.Validate(body); err != nil {
+		return nil, err
+	}
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := c.newRequest(http.MethodPut, path, nil, bytes.NewReader(jsonBody))
+	req, err := c.newRequest(ctx, http.MethodPut, path, nil, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendRequest(req)
+}
+
+// Do sends a request bound to ctx with any method to the specified path. queryParams can be a mod.Queryable,
+// any other struct with json or url tags, or a map, and is encoded with utl.EncodeQuery. A non-nil body is
+// validated and sent as JSON. The path is not checked against the known API routes, which is left to callers.
+func (c *Client) Do(ctx context.Context, method string, path *string, queryParams interface{}, body interface{}) (*http.Response, error) {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		if err := mod.Validate(body); err != nil {
+			return nil, err
+		}
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+
+	req, err := c.newRequest(ctx, method, path, queryParams, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -188,23 +436,50 @@ func (c *Client) Put(path *string, body interface{}) (*http.Response, error) {
 	return c.sendRequest(req)
 }
 
-// sendRequest sends the specified HTTP request and returns the HTTP response.
+// sendRequest sends the specified HTTP request and returns the HTTP response. GET requests are answered
+// from the client's Cache when possible, and other requests invalidate the cached responses of their resource.
+// Cached responses are kept per tenant and client ID, so clients sharing a cache never see each other's.
 func (c *Client) sendRequest(req *http.Request) (*http.Response, error) {
-	resp, err := c.HttpClient.Do(req)
+	if c.Cache == nil {
+		return c.send(req)
+	}
+	namespace := c.Auth.TokenKey()
+	if req.Method != http.MethodGet {
+		defer c.Cache.invalidate(namespace, req.URL)
+		return c.send(req)
+	}
+	return c.Cache.do(namespace, req, c.send)
+}
+
+// send sends the specified HTTP request, refreshing the access token once if it is rejected.
+// Transient failures are retried according to the client's RetryPolicy.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	resp, err := c.doWithRetry(req)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check for API errors
 	if resp.StatusCode == http.StatusUnauthorized {
-		// Regenerate the token and reattempt the request
-		err := c.Auth.GenerateToken()
+		drainBody(resp.Body)
+		c.logger().Info("access token rejected, refreshing", "method", req.Method, "url", req.URL.String())
+
+		// Replace the rejected token, unless a concurrent request already did, and reattempt the request
+		c.Auth.InvalidateToken(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+		tk, err := c.Auth.GetTokenWithContext(req.Context())
 		if err != nil {
 			return nil, olerror.NewAuthenticationError("Failed to refresh access token")
 		}
 
+		// The original body has been consumed, so rebuild the request with a rewound body and the new token
+		retry, err := rewindRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		retry.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tk))
+
 		// Retry the request
-		resp, err = c.HttpClient.Do(req)
+		resp, err = c.doWithRetry(retry)
 		if err != nil {
 			return nil, err
 		}
@@ -213,6 +488,28 @@ func (c *Client) sendRequest(req *http.Request) (*http.Response, error) {
 	return resp, nil
 }
 
+// do sends a single request, waiting for and then resynchronizing the client's rate limiter.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	logger := c.logger()
+	logger.Debug("sending request", "method", req.Method, "url", req.URL.String(), "headers", req.Header)
+	start := time.Now()
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		logger.Warn("request failed", "method", req.Method, "url", req.URL.String(), "error", err)
+	} else {
+		logger.Debug("received response", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "duration", time.Since(start))
+	}
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(resp)
+	}
+	return resp, err
+}
+
 func (c *Client) GetToken() (string, error) {
 	return c.Auth.GetToken()
 }
@@ -220,3 +517,14 @@ func (c *Client) GetToken() (string, error) {
 func (c *Client) GetAccountId() string {
 	return c.Auth.GetAccountId()
 }
+
+// Close revokes the client's current access token, if it has one, and closes the idle connections of
+// HttpClient when it supports it. Idle connections are closed even when the revocation fails. The client
+// remains usable and requests a new token on its next call.
+func (c *Client) Close(ctx context.Context) error {
+	err := c.Auth.RevokeTokenWithContext(ctx, nil)
+	if closer, ok := c.HttpClient.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+	return err
+}
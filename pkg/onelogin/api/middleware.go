@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Middleware observes or modifies every HTTP request sent by a Client, including the
+// Authenticator's token requests. Hooks run once per attempt, so retried requests pass through
+// them again.
+type Middleware interface {
+	// BeforeSend runs before the request is sent and may return a modified request. Returning an
+	// error aborts the attempt.
+	BeforeSend(req *http.Request) (*http.Request, error)
+	// AfterReceive runs after a response is received and may return a modified response. Returning
+	// an error discards the response.
+	AfterReceive(req *http.Request, resp *http.Response) (*http.Response, error)
+	// OnError runs when the attempt fails and returns the error to report, which may be err itself.
+	OnError(req *http.Request, err error) error
+}
+
+// MiddlewareFuncs adapts plain functions to the Middleware interface. Nil hooks are skipped.
+type MiddlewareFuncs struct {
+	BeforeSendFunc   func(req *http.Request) (*http.Request, error)
+	AfterReceiveFunc func(req *http.Request, resp *http.Response) (*http.Response, error)
+	OnErrorFunc      func(req *http.Request, err error) error
+}
+
+func (m MiddlewareFuncs) BeforeSend(req *http.Request) (*http.Request, error) {
+	if m.BeforeSendFunc == nil {
+		return req, nil
+	}
+	return m.BeforeSendFunc(req)
+}
+
+func (m MiddlewareFuncs) AfterReceive(req *http.Request, resp *http.Response) (*http.Response, error) {
+	if m.AfterReceiveFunc == nil {
+		return resp, nil
+	}
+	return m.AfterReceiveFunc(req, resp)
+}
+
+func (m MiddlewareFuncs) OnError(req *http.Request, err error) error {
+	if m.OnErrorFunc == nil {
+		return err
+	}
+	return m.OnErrorFunc(req, err)
+}
+
+// Use appends middleware to the client's chain. BeforeSend hooks run in the order the middleware
+// was added, AfterReceive and OnError hooks in reverse order. Use must not be called while
+// requests are in flight.
+func (c *Client) Use(middleware ...Middleware) {
+	c.Middleware = append(c.Middleware, middleware...)
+}
+
+// roundTrip sends a single request through the middleware chain.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	chain := c.Middleware
+	for _, mw := range chain {
+		next, err := mw.BeforeSend(req)
+		if err != nil {
+			return nil, runOnError(chain, req, err)
+		}
+		req = next
+	}
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, runOnError(chain, req, err)
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		next, err := chain[i].AfterReceive(req, resp)
+		if err != nil {
+			drainBody(resp.Body)
+			return nil, runOnError(chain, req, err)
+		}
+		resp = next
+	}
+	return resp, nil
+}
+
+func runOnError(chain []Middleware, req *http.Request, err error) error {
+	for i := len(chain) - 1; i >= 0; i-- {
+		err = chain[i].OnError(req, err)
+	}
+	return err
+}
+
+// middlewareClient routes requests of another component, such as the Authenticator,
+// through the client's middleware chain.
+type middlewareClient struct {
+	client *Client
+}
+
+func (m middlewareClient) Do(req *http.Request) (*http.Response, error) {
+	return m.client.roundTrip(req)
+}
+
+// HeaderMiddleware sets the given headers on every request, replacing existing values.
+func HeaderMiddleware(headers http.Header) Middleware {
+	return MiddlewareFuncs{
+		BeforeSendFunc: func(req *http.Request) (*http.Request, error) {
+			for key, values := range headers {
+				req.Header.Del(key)
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			return req, nil
+		},
+	}
+}
+
+type timingKey struct{}
+
+// TimingMiddleware reports how long every request took. resp is nil when the request failed.
+func TimingMiddleware(observe func(req *http.Request, resp *http.Response, duration time.Duration, err error)) Middleware {
+	elapsed := func(req *http.Request) time.Duration {
+		start, ok := req.Context().Value(timingKey{}).(time.Time)
+		if !ok {
+			return 0
+		}
+		return time.Since(start)
+	}
+	return MiddlewareFuncs{
+		BeforeSendFunc: func(req *http.Request) (*http.Request, error) {
+			return req.WithContext(context.WithValue(req.Context(), timingKey{}, time.Now())), nil
+		},
+		AfterReceiveFunc: func(req *http.Request, resp *http.Response) (*http.Response, error) {
+			observe(req, resp, elapsed(req), nil)
+			return resp, nil
+		},
+		OnErrorFunc: func(req *http.Request, err error) error {
+			observe(req, nil, elapsed(req), err)
+			return err
+		},
+	}
+}
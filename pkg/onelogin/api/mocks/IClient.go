@@ -3,10 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	http "net/http"
 
-	models "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 )
 
 // IClient is an autogenerated mock type for the IClient type
@@ -22,6 +24,52 @@ func (_m *IClient) EXPECT() *IClient_Expecter {
 	return &IClient_Expecter{mock: &_m.Mock}
 }
 
+// Close provides a mock function with given fields: ctx
+func (_m *IClient) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type IClient_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IClient_Expecter) Close(ctx interface{}) *IClient_Close_Call {
+	return &IClient_Close_Call{Call: _e.mock.On("Close", ctx)}
+}
+
+func (_c *IClient_Close_Call) Run(run func(ctx context.Context)) *IClient_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IClient_Close_Call) Return(_a0 error) *IClient_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IClient_Close_Call) RunAndReturn(run func(context.Context) error) *IClient_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: path
 func (_m *IClient) Delete(path *string) (*http.Response, error) {
 	ret := _m.Called(path)
@@ -139,6 +187,187 @@ func (_c *IClient_DeleteWithBody_Call) RunAndReturn(run func(*string, interface{
 	return _c
 }
 
+// DeleteWithBodyWithContext provides a mock function with given fields: ctx, path, body
+func (_m *IClient) DeleteWithBodyWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error) {
+	ret := _m.Called(ctx, path, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithBodyWithContext")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, interface{}) (*http.Response, error)); ok {
+		return rf(ctx, path, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, interface{}) *http.Response); ok {
+		r0 = rf(ctx, path, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, interface{}) error); ok {
+		r1 = rf(ctx, path, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IClient_DeleteWithBodyWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithBodyWithContext'
+type IClient_DeleteWithBodyWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithBodyWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path *string
+//   - body interface{}
+func (_e *IClient_Expecter) DeleteWithBodyWithContext(ctx interface{}, path interface{}, body interface{}) *IClient_DeleteWithBodyWithContext_Call {
+	return &IClient_DeleteWithBodyWithContext_Call{Call: _e.mock.On("DeleteWithBodyWithContext", ctx, path, body)}
+}
+
+func (_c *IClient_DeleteWithBodyWithContext_Call) Run(run func(ctx context.Context, path *string, body interface{})) *IClient_DeleteWithBodyWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IClient_DeleteWithBodyWithContext_Call) Return(_a0 *http.Response, _a1 error) *IClient_DeleteWithBodyWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IClient_DeleteWithBodyWithContext_Call) RunAndReturn(run func(context.Context, *string, interface{}) (*http.Response, error)) *IClient_DeleteWithBodyWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWithContext provides a mock function with given fields: ctx, path
+func (_m *IClient) DeleteWithContext(ctx context.Context, path *string) (*http.Response, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithContext")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) (*http.Response, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *http.Response); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IClient_DeleteWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithContext'
+type IClient_DeleteWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path *string
+func (_e *IClient_Expecter) DeleteWithContext(ctx interface{}, path interface{}) *IClient_DeleteWithContext_Call {
+	return &IClient_DeleteWithContext_Call{Call: _e.mock.On("DeleteWithContext", ctx, path)}
+}
+
+func (_c *IClient_DeleteWithContext_Call) Run(run func(ctx context.Context, path *string)) *IClient_DeleteWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *IClient_DeleteWithContext_Call) Return(_a0 *http.Response, _a1 error) *IClient_DeleteWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IClient_DeleteWithContext_Call) RunAndReturn(run func(context.Context, *string) (*http.Response, error)) *IClient_DeleteWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Do provides a mock function with given fields: ctx, method, path, queryParams, body
+func (_m *IClient) Do(ctx context.Context, method string, path *string, queryParams interface{}, body interface{}) (*http.Response, error) {
+	ret := _m.Called(ctx, method, path, queryParams, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Do")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, interface{}, interface{}) (*http.Response, error)); ok {
+		return rf(ctx, method, path, queryParams, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, interface{}, interface{}) *http.Response); ok {
+		r0 = rf(ctx, method, path, queryParams, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, interface{}, interface{}) error); ok {
+		r1 = rf(ctx, method, path, queryParams, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IClient_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
+type IClient_Do_Call struct {
+	*mock.Call
+}
+
+// Do is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path *string
+//   - queryParams interface{}
+//   - body interface{}
+func (_e *IClient_Expecter) Do(ctx interface{}, method interface{}, path interface{}, queryParams interface{}, body interface{}) *IClient_Do_Call {
+	return &IClient_Do_Call{Call: _e.mock.On("Do", ctx, method, path, queryParams, body)}
+}
+
+func (_c *IClient_Do_Call) Run(run func(ctx context.Context, method string, path *string, queryParams interface{}, body interface{})) *IClient_Do_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(interface{}), args[4].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IClient_Do_Call) Return(_a0 *http.Response, _a1 error) *IClient_Do_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IClient_Do_Call) RunAndReturn(run func(context.Context, string, *string, interface{}, interface{}) (*http.Response, error)) *IClient_Do_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: path, queryParams
 func (_m *IClient) Get(path *string, queryParams models.Queryable) (*http.Response, error) {
 	ret := _m.Called(path, queryParams)
@@ -298,6 +527,66 @@ func (_c *IClient_GetToken_Call) RunAndReturn(run func() (string, error)) *IClie
 	return _c
 }
 
+// GetWithContext provides a mock function with given fields: ctx, path, queryParams
+func (_m *IClient) GetWithContext(ctx context.Context, path *string, queryParams models.Queryable) (*http.Response, error) {
+	ret := _m.Called(ctx, path, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, models.Queryable) (*http.Response, error)); ok {
+		return rf(ctx, path, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, models.Queryable) *http.Response); ok {
+		r0 = rf(ctx, path, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, models.Queryable) error); ok {
+		r1 = rf(ctx, path, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IClient_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type IClient_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path *string
+//   - queryParams models.Queryable
+func (_e *IClient_Expecter) GetWithContext(ctx interface{}, path interface{}, queryParams interface{}) *IClient_GetWithContext_Call {
+	return &IClient_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx, path, queryParams)}
+}
+
+func (_c *IClient_GetWithContext_Call) Run(run func(ctx context.Context, path *string, queryParams models.Queryable)) *IClient_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IClient_GetWithContext_Call) Return(_a0 *http.Response, _a1 error) *IClient_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IClient_GetWithContext_Call) RunAndReturn(run func(context.Context, *string, models.Queryable) (*http.Response, error)) *IClient_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Post provides a mock function with given fields: path, body
 func (_m *IClient) Post(path *string, body interface{}) (*http.Response, error) {
 	ret := _m.Called(path, body)
@@ -357,6 +646,66 @@ func (_c *IClient_Post_Call) RunAndReturn(run func(*string, interface{}) (*http.
 	return _c
 }
 
+// PostWithContext provides a mock function with given fields: ctx, path, body
+func (_m *IClient) PostWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error) {
+	ret := _m.Called(ctx, path, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostWithContext")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, interface{}) (*http.Response, error)); ok {
+		return rf(ctx, path, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, interface{}) *http.Response); ok {
+		r0 = rf(ctx, path, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, interface{}) error); ok {
+		r1 = rf(ctx, path, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IClient_PostWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostWithContext'
+type IClient_PostWithContext_Call struct {
+	*mock.Call
+}
+
+// PostWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path *string
+//   - body interface{}
+func (_e *IClient_Expecter) PostWithContext(ctx interface{}, path interface{}, body interface{}) *IClient_PostWithContext_Call {
+	return &IClient_PostWithContext_Call{Call: _e.mock.On("PostWithContext", ctx, path, body)}
+}
+
+func (_c *IClient_PostWithContext_Call) Run(run func(ctx context.Context, path *string, body interface{})) *IClient_PostWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IClient_PostWithContext_Call) Return(_a0 *http.Response, _a1 error) *IClient_PostWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IClient_PostWithContext_Call) RunAndReturn(run func(context.Context, *string, interface{}) (*http.Response, error)) *IClient_PostWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: path, body
 func (_m *IClient) Put(path *string, body interface{}) (*http.Response, error) {
 	ret := _m.Called(path, body)
@@ -416,6 +765,66 @@ func (_c *IClient_Put_Call) RunAndReturn(run func(*string, interface{}) (*http.R
 	return _c
 }
 
+// PutWithContext provides a mock function with given fields: ctx, path, body
+func (_m *IClient) PutWithContext(ctx context.Context, path *string, body interface{}) (*http.Response, error) {
+	ret := _m.Called(ctx, path, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutWithContext")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string, interface{}) (*http.Response, error)); ok {
+		return rf(ctx, path, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string, interface{}) *http.Response); ok {
+		r0 = rf(ctx, path, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string, interface{}) error); ok {
+		r1 = rf(ctx, path, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IClient_PutWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutWithContext'
+type IClient_PutWithContext_Call struct {
+	*mock.Call
+}
+
+// PutWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path *string
+//   - body interface{}
+func (_e *IClient_Expecter) PutWithContext(ctx interface{}, path interface{}, body interface{}) *IClient_PutWithContext_Call {
+	return &IClient_PutWithContext_Call{Call: _e.mock.On("PutWithContext", ctx, path, body)}
+}
+
+func (_c *IClient_PutWithContext_Call) Run(run func(ctx context.Context, path *string, body interface{})) *IClient_PutWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IClient_PutWithContext_Call) Return(_a0 *http.Response, _a1 error) *IClient_PutWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IClient_PutWithContext_Call) RunAndReturn(run func(context.Context, *string, interface{}) (*http.Response, error)) *IClient_PutWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIClient creates a new instance of IClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIClient(t interface {
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/logging"
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// DefaultUserAgent is sent with every request unless overridden with WithUserAgent.
+const DefaultUserAgent = "onelogin-go-sdk/v4"
+
+// Option configures a Client created by NewClient.
+type Option func(cfg *config)
+
+// config collects the settings applied by Options before the Client is built.
+type config struct {
+	credentials         *mod.APICredentials
+	timeout             *time.Duration
+	baseURL             string
+	region              string
+	httpClient          HTTPClient
+	transport           http.RoundTripper
+	userAgent           string
+	logger              logging.Logger
+	retryPolicy         *RetryPolicy
+	retryPolicySet      bool
+	rateLimiter         *RateLimiter
+	rateLimiterSet      bool
+	lazyAuth            bool
+	middleware          []Middleware
+	tokenStore          authentication.TokenStore
+	credentialsProvider authentication.CredentialsProvider
+	profile             string
+	responseCache       *ResponseCache
+}
+
+// WithCredentials authenticates with the given credentials instead of the
+// ONELOGIN_CLIENT_ID, ONELOGIN_CLIENT_SECRET and ONELOGIN_SUBDOMAIN environment variables.
+func WithCredentials(credentials *mod.APICredentials) Option {
+	return func(cfg *config) {
+		cfg.credentials = credentials
+	}
+}
+
+// WithCredentialsProvider obtains the client ID and secret from provider, which is asked again before every
+// token request, instead of authentication.DefaultCredentialsChain.
+func WithCredentialsProvider(provider authentication.CredentialsProvider) Option {
+	return func(cfg *config) {
+		cfg.credentialsProvider = provider
+	}
+}
+
+// WithProfile takes the subdomain, region, timeout and credentials from the named profile of the profiles
+// file (see authentication.Profile) instead of ONELOGIN_PROFILE. Credentials and subdomain in the
+// ONELOGIN_* environment variables are ignored, while other options still take precedence.
+func WithProfile(name string) Option {
+	return func(cfg *config) {
+		cfg.profile = name
+	}
+}
+
+// WithTimeout sets the HTTP timeout instead of ONELOGIN_TIMEOUT. It is ignored when WithHTTPClient is used.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.timeout = &timeout
+	}
+}
+
+// WithBaseURL sends API and token requests to baseURL, e.g. a custom domain or a local test server.
+// It takes precedence over WithRegion and the subdomain.
+func WithBaseURL(baseURL string) Option {
+	return func(cfg *config) {
+		cfg.baseURL = baseURL
+	}
+}
+
+// WithRegion targets the regional API host, https://api.us.onelogin.com for "us" or
+// https://api.eu.onelogin.com for "eu", instead of the tenant subdomain.
+func WithRegion(region string) Option {
+	return func(cfg *config) {
+		cfg.region = region
+	}
+}
+
+// WithHTTPClient sends API and token requests through client.
+func WithHTTPClient(client HTTPClient) Option {
+	return func(cfg *config) {
+		cfg.httpClient = client
+	}
+}
+
+// WithTransport builds the HTTP client used for API and token requests around transport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(cfg *config) {
+		cfg.transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with API and token requests.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *config) {
+		cfg.userAgent = userAgent
+	}
+}
+
+// WithLogger sends the diagnostic messages of the client and its authenticator to logger.
+// Tokens, client secrets and passwords are redacted before they reach it.
+func WithLogger(logger logging.Logger) Option {
+	return func(cfg *config) {
+		cfg.logger = logger
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. A nil policy disables retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(cfg *config) {
+		cfg.retryPolicy = policy
+		cfg.retryPolicySet = true
+	}
+}
+
+// WithRateLimiter replaces the rate limiter shared by clients using the same credentials.
+// A nil limiter disables client-side rate limiting.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(cfg *config) {
+		cfg.rateLimiter = limiter
+		cfg.rateLimiterSet = true
+	}
+}
+
+// WithLazyAuth defers obtaining an access token until the first API call, so that creating
+// the client performs no network requests. Authentication errors are returned by that call instead.
+func WithLazyAuth() Option {
+	return func(cfg *config) {
+		cfg.lazyAuth = true
+	}
+}
+
+// WithMiddleware adds middleware that runs around every API and token request.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(cfg *config) {
+		cfg.middleware = append(cfg.middleware, middleware...)
+	}
+}
+
+// WithTokenStore shares access tokens through store, e.g. an authentication.FileTokenStore, so that
+// processes using the same subdomain and client ID reuse an unexpired token instead of each requesting one.
+func WithTokenStore(store authentication.TokenStore) Option {
+	return func(cfg *config) {
+		cfg.tokenStore = store
+	}
+}
+
+// WithResponseCache caches the responses of GET requests in cache, see ResponseCache. Caching is off by default.
+func WithResponseCache(cache *ResponseCache) Option {
+	return func(cfg *config) {
+		cfg.responseCache = cache
+	}
+}
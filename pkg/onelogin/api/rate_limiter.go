@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+// RateLimiter is a token bucket that mirrors the rate limit window reported by OneLogin
+// in the X-RateLimit-* response headers. It is safe for concurrent use.
+type RateLimiter struct {
+	mu        sync.Mutex
+	limit     int           // Requests allowed per window; 0 until the first response is seen
+	remaining int           // Requests left in the current window
+	resetAt   time.Time     // When the current window ends
+	window    time.Duration // Longest window reported so far, used to start the next window on reset
+	failFast  bool
+	now       func() time.Time
+}
+
+var (
+	rateLimitersMu sync.Mutex
+	rateLimiters   = map[string]*RateLimiter{}
+)
+
+// NewRateLimiter creates a rate limiter. When failFast is true, Wait returns a
+// *olerror.RateLimitError instead of blocking until the window resets.
+func NewRateLimiter(failFast bool) *RateLimiter {
+	return &RateLimiter{failFast: failFast, now: time.Now}
+}
+
+// SharedRateLimiter returns the rate limiter registered under key, creating it on first use.
+// Clients that authenticate with the same credentials share one budget through it. Limiters stay
+// registered until RemoveSharedRateLimiter is called, so programs that create clients for many
+// short-lived credentials should remove them when they are done.
+func SharedRateLimiter(key string) *RateLimiter {
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+	rl, ok := rateLimiters[key]
+	if !ok {
+		rl = NewRateLimiter(false)
+		rateLimiters[key] = rl
+	}
+	return rl
+}
+
+// RemoveSharedRateLimiter unregisters the rate limiter registered under key. Clients already using it
+// keep it, while the next SharedRateLimiter call for key creates a new one.
+func RemoveSharedRateLimiter(key string) {
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+	delete(rateLimiters, key)
+}
+
+// SetFailFast switches between blocking and failing when the budget is exhausted.
+func (rl *RateLimiter) SetFailFast(failFast bool) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.failFast = failFast
+}
+
+// Wait takes one request from the budget, blocking until the window resets if it is exhausted.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait, ok := rl.reserve()
+		if ok {
+			return nil
+		}
+		if rl.isFailFast() {
+			return olerror.NewRateLimitError("request budget exhausted", wait)
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise it reports how long until the window resets.
+func (rl *RateLimiter) reserve() (time.Duration, bool) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.limit == 0 {
+		// No rate limit information yet
+		return 0, true
+	}
+	now := rl.now()
+	if !now.Before(rl.resetAt) {
+		// Start the next window, so that the budget still applies until the next response updates it
+		rl.remaining = rl.limit
+		window := rl.window
+		if window < time.Second {
+			window = time.Second
+		}
+		rl.resetAt = now.Add(window)
+	}
+	if rl.remaining > 0 {
+		rl.remaining--
+		return 0, true
+	}
+	return rl.resetAt.Sub(now), false
+}
+
+func (rl *RateLimiter) isFailFast() bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.failFast
+}
+
+// Update resynchronizes the bucket from the rate limit headers of resp.
+func (rl *RateLimiter) Update(resp *http.Response) {
+	if resp == nil || resp.Header.Get("X-RateLimit-Limit") == "" {
+		return
+	}
+	md := utl.ParseResponseMetadata(resp)
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.limit = md.RateLimitLimit
+	rl.remaining = md.RateLimitRemaining
+	if resp.StatusCode == http.StatusTooManyRequests {
+		rl.remaining = 0
+	}
+	reset := time.Duration(md.RateLimitReset) * time.Second
+	rl.resetAt = rl.now().Add(reset)
+	if reset > rl.window {
+		rl.window = reset
+	}
+}
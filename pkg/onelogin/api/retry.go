@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+const (
+	DefaultMaxAttempts = 3
+	DefaultBaseDelay   = 500 * time.Millisecond
+	DefaultMaxDelay    = 30 * time.Second
+)
+
+// RetryPolicy controls how the Client retries failed requests.
+type RetryPolicy struct {
+	MaxAttempts        int           // Total number of attempts, including the first one
+	BaseDelay          time.Duration // Delay before the first retry; doubled on every subsequent attempt
+	MaxDelay           time.Duration // Upper bound for the backoff delay and for waits requested by the server
+	RetryableStatuses  map[int]bool  // HTTP status codes that trigger a retry
+	RetryNonIdempotent bool          // Whether POST requests may be retried
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: DefaultMaxAttempts,
+		BaseDelay:   DefaultBaseDelay,
+		MaxDelay:    DefaultMaxDelay,
+		RetryableStatuses: map[int]bool{
+			http.StatusTooManyRequests:    true,
+			http.StatusBadGateway:         true,
+			http.StatusServiceUnavailable: true,
+			http.StatusGatewayTimeout:     true,
+		},
+	}
+}
+
+// shouldRetry reports whether the outcome of the given attempt warrants another one.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be replayed
+		return false
+	}
+	if err != nil {
+		var rateLimitErr *olerror.RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			return false
+		}
+		return req.Context().Err() == nil
+	}
+	return p.RetryableStatuses[resp.StatusCode]
+}
+
+// delay returns how long to wait before the next attempt. Server hints from the Retry-After or
+// X-RateLimit-Reset headers take precedence over exponential backoff. It reports false when the server
+// asks for a longer wait than MaxDelay, so that a large hint can't block the caller for hours.
+func (p *RetryPolicy) delay(resp *http.Response, attempt int) (time.Duration, bool) {
+	if resp != nil {
+		if wait, ok := utl.RetryAfter(resp); ok {
+			if p.MaxDelay > 0 && wait > p.MaxDelay {
+				return wait, false
+			}
+			return wait, true
+		}
+	}
+
+	backoff := p.BaseDelay << uint(attempt-1)
+	if backoff <= 0 || (p.MaxDelay > 0 && backoff > p.MaxDelay) {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0, true
+	}
+	// Full jitter spreads out clients that failed at the same moment
+	return time.Duration(rand.Int63n(int64(backoff) + 1)), true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// rewindRequest returns a copy of req whose body has been reset to its beginning.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody == nil {
+		return clone, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone.Body = body
+	return clone, nil
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// drainBody discards and closes a response body so the connection can be reused.
+func drainBody(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	body.Close()
+}
+
+// doWithRetry sends req, retrying according to the client's RetryPolicy.
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	policy := c.RetryPolicy
+	if policy == nil {
+		return c.do(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.do(req)
+		if !policy.shouldRetry(req, resp, err, attempt) {
+			return resp, err
+		}
+
+		wait, ok := policy.delay(resp, attempt)
+		if !ok {
+			c.logger().Info("not retrying request, server asked to wait longer than MaxDelay", "method", req.Method, "url", req.URL.String(), "wait", wait)
+			return resp, err
+		}
+		c.logger().Info("retrying request", "method", req.Method, "url", req.URL.String(), "attempt", attempt+1, "wait", wait)
+		if resp != nil {
+			drainBody(resp.Body)
+		}
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) CreateAuthServer(authServer *mod.AuthServer) (interface{}, error) {
+	return sdk.CreateAuthServerWithContext(context.Background(), authServer)
+}
+
+func (sdk *OneloginSDK) CreateAuthServerWithContext(ctx context.Context, authServer *mod.AuthServer) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, authServer)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, authServer)
 	if err != nil {
 		return nil, err
 	}
@@ -23,11 +28,15 @@ func (sdk *OneloginSDK) CreateAuthServer(authServer *mod.AuthServer) (interface{
 
 // was ListAuthServers
 func (sdk *OneloginSDK) GetAuthServers(queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAuthServersWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetAuthServersWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -35,11 +44,15 @@ func (sdk *OneloginSDK) GetAuthServers(queryParams mod.Queryable) (interface{},
 }
 
 func (sdk *OneloginSDK) GetAuthServerByID(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAuthServerByIDWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAuthServerByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -47,11 +60,15 @@ func (sdk *OneloginSDK) GetAuthServerByID(id int, queryParams mod.Queryable) (in
 }
 
 func (sdk *OneloginSDK) UpdateAuthServer(id int, authServer mod.AuthServer) (interface{}, error) {
+	return sdk.UpdateAuthServerWithContext(context.Background(), id, authServer)
+}
+
+func (sdk *OneloginSDK) UpdateAuthServerWithContext(ctx context.Context, id int, authServer mod.AuthServer) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, authServer)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, authServer)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +76,15 @@ func (sdk *OneloginSDK) UpdateAuthServer(id int, authServer mod.AuthServer) (int
 }
 
 func (sdk *OneloginSDK) DeleteAuthServer(id int) (interface{}, error) {
+	return sdk.DeleteAuthServerWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) DeleteAuthServerWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -72,11 +93,15 @@ func (sdk *OneloginSDK) DeleteAuthServer(id int) (interface{}, error) {
 
 // Claim related endpoints
 func (sdk *OneloginSDK) CreateAuthServerClaim(id int, claim mod.AccessTokenClaim) (interface{}, error) {
+	return sdk.CreateAuthServerClaimWithContext(context.Background(), id, claim)
+}
+
+func (sdk *OneloginSDK) CreateAuthServerClaimWithContext(ctx context.Context, id int, claim mod.AccessTokenClaim) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "claims")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, claim)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, claim)
 	if err != nil {
 		return nil, err
 	}
@@ -84,11 +109,15 @@ func (sdk *OneloginSDK) CreateAuthServerClaim(id int, claim mod.AccessTokenClaim
 }
 
 func (sdk *OneloginSDK) DeleteAuthClaim(id, claimID int) (interface{}, error) {
+	return sdk.DeleteAuthClaimWithContext(context.Background(), id, claimID)
+}
+
+func (sdk *OneloginSDK) DeleteAuthClaimWithContext(ctx context.Context, id, claimID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "claims", claimID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -96,11 +125,15 @@ func (sdk *OneloginSDK) DeleteAuthClaim(id, claimID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetAuthClaims(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAuthClaimsWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAuthClaimsWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "claims")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -108,11 +141,15 @@ func (sdk *OneloginSDK) GetAuthClaims(id int, queryParams mod.Queryable) (interf
 }
 
 func (sdk *OneloginSDK) UpdateClaim(id, claimID int, claim mod.AccessTokenClaim) (interface{}, error) {
+	return sdk.UpdateClaimWithContext(context.Background(), id, claimID, claim)
+}
+
+func (sdk *OneloginSDK) UpdateClaimWithContext(ctx context.Context, id, claimID int, claim mod.AccessTokenClaim) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "claims", claimID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, claim)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, claim)
 	if err != nil {
 		return nil, err
 	}
@@ -121,11 +158,15 @@ func (sdk *OneloginSDK) UpdateClaim(id, claimID int, claim mod.AccessTokenClaim)
 
 // Scopes related endpoints
 func (sdk *OneloginSDK) CreateAuthServerScope(id int, scope mod.Scope) (interface{}, error) {
+	return sdk.CreateAuthServerScopeWithContext(context.Background(), id, scope)
+}
+
+func (sdk *OneloginSDK) CreateAuthServerScopeWithContext(ctx context.Context, id int, scope mod.Scope) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "scopes")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, scope)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -133,11 +174,15 @@ func (sdk *OneloginSDK) CreateAuthServerScope(id int, scope mod.Scope) (interfac
 }
 
 func (sdk *OneloginSDK) DeleteAuthServerScope(id, scopeID int) (interface{}, error) {
+	return sdk.DeleteAuthServerScopeWithContext(context.Background(), id, scopeID)
+}
+
+func (sdk *OneloginSDK) DeleteAuthServerScopeWithContext(ctx context.Context, id, scopeID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "scopes", scopeID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -145,11 +190,15 @@ func (sdk *OneloginSDK) DeleteAuthServerScope(id, scopeID int) (interface{}, err
 }
 
 func (sdk *OneloginSDK) GetAuthServerScopes(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAuthServerScopesWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAuthServerScopesWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "scopes")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -157,11 +206,15 @@ func (sdk *OneloginSDK) GetAuthServerScopes(id int, queryParams mod.Queryable) (
 }
 
 func (sdk *OneloginSDK) UpdateAuthServerScope(id, scopeID int, scope mod.Scope) (interface{}, error) {
+	return sdk.UpdateAuthServerScopeWithContext(context.Background(), id, scopeID, scope)
+}
+
+func (sdk *OneloginSDK) UpdateAuthServerScopeWithContext(ctx context.Context, id, scopeID int, scope mod.Scope) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "scopes", scopeID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, scope)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -171,11 +224,15 @@ func (sdk *OneloginSDK) UpdateAuthServerScope(id, scopeID int, scope mod.Scope)
 // Client App related endpoints
 
 func (sdk *OneloginSDK) CreateClientApp(id int, clientApp mod.ClientApp) (interface{}, error) {
+	return sdk.CreateClientAppWithContext(context.Background(), id, clientApp)
+}
+
+func (sdk *OneloginSDK) CreateClientAppWithContext(ctx context.Context, id int, clientApp mod.ClientApp) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "clients")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, clientApp)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, clientApp)
 	if err != nil {
 		return nil, err
 	}
@@ -183,11 +240,15 @@ func (sdk *OneloginSDK) CreateClientApp(id int, clientApp mod.ClientApp) (interf
 }
 
 func (sdk *OneloginSDK) GetClientApps(id int) (interface{}, error) {
+	return sdk.GetClientAppsWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) GetClientAppsWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "clients")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -195,11 +256,15 @@ func (sdk *OneloginSDK) GetClientApps(id int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) DeleteClientApp(id, clientID int) (interface{}, error) {
+	return sdk.DeleteClientAppWithContext(context.Background(), id, clientID)
+}
+
+func (sdk *OneloginSDK) DeleteClientAppWithContext(ctx context.Context, id, clientID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "clients", clientID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -207,13 +272,43 @@ func (sdk *OneloginSDK) DeleteClientApp(id, clientID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) UpdateClientApp(id, clientID int, clientApp mod.ClientApp) (interface{}, error) {
+	return sdk.UpdateClientAppWithContext(context.Background(), id, clientID, clientApp)
+}
+
+func (sdk *OneloginSDK) UpdateClientAppWithContext(ctx context.Context, id, clientID int, clientApp mod.ClientApp) (interface{}, error) {
 	p, err := utl.BuildAPIPath(APIAuthPath, id, "clients", clientID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, clientApp)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, clientApp)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) CreateAuthServerTyped(authServer *mod.AuthServer) (*mod.Response[mod.AuthServer], error) {
+	return sdk.CreateAuthServerTypedWithContext(context.Background(), authServer)
+}
+
+func (sdk *OneloginSDK) CreateAuthServerTypedWithContext(ctx context.Context, authServer *mod.AuthServer) (*mod.Response[mod.AuthServer], error) {
+	p, err := utl.BuildAPIPath(APIAuthPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.AuthServer](sdk.Client.PostWithContext(ctx, &p, authServer))
+}
+
+func (sdk *OneloginSDK) GetAuthServersTyped(queryParams mod.Queryable) (*mod.Response[[]mod.AuthServer], error) {
+	return sdk.GetAuthServersTypedWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetAuthServersTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.AuthServer], error) {
+	p, err := utl.BuildAPIPath(APIAuthPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.AuthServer](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) CreateApp(app mod.App) (interface{}, error) {
+	return sdk.CreateAppWithContext(context.Background(), app)
+}
+
+func (sdk *OneloginSDK) CreateAppWithContext(ctx context.Context, app mod.App) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, app)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, app)
 	if err != nil {
 		return nil, err
 	}
@@ -24,11 +29,15 @@ func (sdk *OneloginSDK) CreateApp(app mod.App) (interface{}, error) {
 
 // was ListApps
 func (sdk *OneloginSDK) GetApps(queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAppsWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetAppsWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -36,11 +45,15 @@ func (sdk *OneloginSDK) GetApps(queryParams mod.Queryable) (interface{}, error)
 }
 
 func (sdk *OneloginSDK) GetAppByID(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAppByIDWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAppByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -49,11 +62,15 @@ func (sdk *OneloginSDK) GetAppByID(id int, queryParams mod.Queryable) (interface
 }
 
 func (sdk *OneloginSDK) UpdateApp(id int, app mod.App) (interface{}, error) {
+	return sdk.UpdateAppWithContext(context.Background(), id, app)
+}
+
+func (sdk *OneloginSDK) UpdateAppWithContext(ctx context.Context, id int, app mod.App) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, app)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, app)
 	if err != nil {
 		return nil, err
 	}
@@ -62,11 +79,15 @@ func (sdk *OneloginSDK) UpdateApp(id int, app mod.App) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) DeleteApp(id int) (interface{}, error) {
+	return sdk.DeleteAppWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) DeleteAppWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +96,15 @@ func (sdk *OneloginSDK) DeleteApp(id int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) CreateAppRule(id int, appRule mod.AppRule) (interface{}, error) {
+	return sdk.CreateAppRuleWithContext(context.Background(), id, appRule)
+}
+
+func (sdk *OneloginSDK) CreateAppRuleWithContext(ctx context.Context, id int, appRule mod.AppRule) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, appRule)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, appRule)
 	if err != nil {
 		return nil, err
 	}
@@ -88,11 +113,15 @@ func (sdk *OneloginSDK) CreateAppRule(id int, appRule mod.AppRule) (interface{},
 }
 
 func (sdk *OneloginSDK) GetAppRules(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAppRulesWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAppRulesWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -101,11 +130,15 @@ func (sdk *OneloginSDK) GetAppRules(id int, queryParams mod.Queryable) (interfac
 }
 
 func (sdk *OneloginSDK) GetAppRuleByID(id, ruleID int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetAppRuleByIDWithContext(context.Background(), id, ruleID, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAppRuleByIDWithContext(ctx context.Context, id, ruleID int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -114,11 +147,15 @@ func (sdk *OneloginSDK) GetAppRuleByID(id, ruleID int, queryParams mod.Queryable
 }
 
 func (sdk *OneloginSDK) UpdateAppRule(id, ruleID int, appRule mod.AppRule, queryParams map[string]string) (interface{}, error) {
+	return sdk.UpdateAppRuleWithContext(context.Background(), id, ruleID, appRule, queryParams)
+}
+
+func (sdk *OneloginSDK) UpdateAppRuleWithContext(ctx context.Context, id, ruleID int, appRule mod.AppRule, queryParams map[string]string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id, "rules", ruleID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, appRule)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, appRule)
 	if err != nil {
 		return nil, err
 	}
@@ -127,11 +164,15 @@ func (sdk *OneloginSDK) UpdateAppRule(id, ruleID int, appRule mod.AppRule, query
 }
 
 func (sdk *OneloginSDK) DeleteAppRule(id, ruleID int, queryParams map[string]string) (interface{}, error) {
+	return sdk.DeleteAppRuleWithContext(context.Background(), id, ruleID, queryParams)
+}
+
+func (sdk *OneloginSDK) DeleteAppRuleWithContext(ctx context.Context, id, ruleID int, queryParams map[string]string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, id, "rules", ruleID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -139,13 +180,67 @@ func (sdk *OneloginSDK) DeleteAppRule(id, ruleID int, queryParams map[string]str
 }
 
 func (sdk *OneloginSDK) GetAppUsers(appID int) (interface{}, error) {
+	return sdk.GetAppUsersWithContext(context.Background(), appID)
+}
+
+func (sdk *OneloginSDK) GetAppUsersWithContext(ctx context.Context, appID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(AppPath, appID, "users")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) CreateAppTyped(app mod.App) (*mod.Response[mod.App], error) {
+	return sdk.CreateAppTypedWithContext(context.Background(), app)
+}
+
+func (sdk *OneloginSDK) CreateAppTypedWithContext(ctx context.Context, app mod.App) (*mod.Response[mod.App], error) {
+	p, err := utl.BuildAPIPath(AppPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.App](sdk.Client.PostWithContext(ctx, &p, app))
+}
+
+func (sdk *OneloginSDK) GetAppsTyped(queryParams mod.Queryable) (*mod.Response[[]mod.App], error) {
+	return sdk.GetAppsTypedWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetAppsTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.App], error) {
+	p, err := utl.BuildAPIPath(AppPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.App](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
+
+func (sdk *OneloginSDK) GetAppByIDTyped(id int, queryParams mod.Queryable) (*mod.Response[mod.App], error) {
+	return sdk.GetAppByIDTypedWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetAppByIDTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[mod.App], error) {
+	p, err := utl.BuildAPIPath(AppPath, id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.App](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
+
+func (sdk *OneloginSDK) UpdateAppTyped(id int, app mod.App) (*mod.Response[mod.App], error) {
+	return sdk.UpdateAppTypedWithContext(context.Background(), id, app)
+}
+
+func (sdk *OneloginSDK) UpdateAppTypedWithContext(ctx context.Context, id int, app mod.App) (*mod.Response[mod.App], error) {
+	p, err := utl.BuildAPIPath(AppPath, id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.App](sdk.Client.PutWithContext(ctx, &p, app))
+}
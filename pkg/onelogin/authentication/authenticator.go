@@ -1,16 +1,22 @@
 package authentication
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	"io"
+	"io/ioutil"
 	"net/http"
-	"os"
 	"strings"
+	"sync"
+	"time"
 
 	olError "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/logging"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
 
 const (
@@ -18,26 +24,139 @@ const (
 	RevokePath string = "/auth/oauth2/revoke"
 )
 
+// RefreshWindow is how long before expiry GetToken starts refreshing the access token.
+const RefreshWindow = 60 * time.Second
+
+// Authenticator obtains and caches OneLogin access tokens. It is safe for concurrent use.
 type Authenticator struct {
+	mu                  sync.Mutex
 	accessToken         string
+	rejected            string // Last token invalidated after the API rejected it; never adopted from the store again
 	subdomain           string
+	resolveSubdomain    bool // Created without a subdomain, which is then taken from the credentials
+	derivedBaseURL      bool // The base URL follows from the subdomain or region instead of WithBaseURL
 	expiresIn           int
+	expiresAt           time.Time
 	accountId           string
+	tokenKey            string // TokenKey of the subdomain and client ID the current token was obtained for
 	credentialsOverride *mod.APICredentials
+	refreshing          *tokenRefresh // In-flight token request shared by concurrent callers
+	httpClient          HTTPClient
+	baseURL             string
+	baseURLErr          error  // Invalid subdomain, region or base URL, returned by every request
+	region              string // Regional API host to use instead of the tenant subdomain
+	userAgent           string
+	logger              logging.Logger
+	store               TokenStore          // Shares tokens with other Authenticators; nil keeps them in memory only
+	provider            CredentialsProvider // Asked for the client ID and secret before every token request
 }
 
-func NewAuthenticator(subdomain string, credentialsOverride *mod.APICredentials) *Authenticator {
-	return &Authenticator{subdomain: subdomain, credentialsOverride: credentialsOverride}
+// tokenRefresh tracks a single in-flight token request.
+type tokenRefresh struct {
+	done chan struct{}
+	err  error
+}
+
+func NewAuthenticator(subdomain string, credentialsOverride *mod.APICredentials, opts ...Option) *Authenticator {
+	a := &Authenticator{subdomain: subdomain, credentialsOverride: credentialsOverride}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(a)
+		}
+	}
+	if a.httpClient == nil {
+		a.httpClient = &http.Client{}
+	}
+	a.resolveSubdomain = subdomain == ""
+	if a.baseURL == "" {
+		a.derivedBaseURL = true
+		a.baseURL, a.baseURLErr = utl.BaseURL(a.region, subdomain)
+	} else {
+		a.baseURL, a.baseURLErr = utl.NormalizeBaseURL(a.baseURL)
+	}
+	if a.logger == nil {
+		a.logger = logging.NopLogger{}
+	}
+	if a.provider == nil {
+		a.provider = DefaultCredentialsChain(credentialsOverride)
+	}
+	return a
 }
 
 func (a *Authenticator) GenerateToken() error {
-	// Read & Check environment variables
-	clientID := os.Getenv("ONELOGIN_CLIENT_ID")
-	clientSecret := os.Getenv("ONELOGIN_CLIENT_SECRET")
+	return a.GenerateTokenWithContext(context.Background())
+}
 
-	if a.credentialsOverride != nil {
-		clientID = a.credentialsOverride.ClientID
-		clientSecret = a.credentialsOverride.ClientSecret
+// GenerateTokenWithContext requests a new access token, aborting the token request if ctx is cancelled.
+// Concurrent callers share a single in-flight token request.
+func (a *Authenticator) GenerateTokenWithContext(ctx context.Context) error {
+	return a.refresh(ctx, true)
+}
+
+// InvalidateToken forgets the current access token if it is rejected, e.g. after the API answered a request
+// made with it with HTTP 401, so that the next call to GetToken requests a new one. When another caller has
+// already replaced the rejected token, the current token is kept, so that concurrent requests rejected with
+// the same token cause a single refresh.
+func (a *Authenticator) InvalidateToken(rejected string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if rejected == "" || a.accessToken != rejected {
+		return
+	}
+	a.accessToken = ""
+	a.rejected = rejected
+	a.logger.Info("access token invalidated")
+}
+
+// refresh obtains a new token, joining an in-flight request when there is one. Unless force
+// is set, no request is made when another caller already replaced the stale token.
+func (a *Authenticator) refresh(ctx context.Context, force bool) error {
+	for {
+		a.mu.Lock()
+		call := a.refreshing
+		if call == nil {
+			if !force && !a.isStale() {
+				a.mu.Unlock()
+				return nil
+			}
+			call = &tokenRefresh{done: make(chan struct{})}
+			a.refreshing = call
+			a.mu.Unlock()
+
+			call.err = a.requestToken(ctx)
+
+			a.mu.Lock()
+			a.refreshing = nil
+			a.mu.Unlock()
+			close(call.done)
+			return call.err
+		}
+		a.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-call.done:
+		}
+		// The shared request was abandoned by the caller that started it; try again with ours
+		if call.err == context.Canceled || call.err == context.DeadlineExceeded {
+			continue
+		}
+		return call.err
+	}
+}
+
+// requestToken fetches a new access token from the token endpoint and stores it.
+func (a *Authenticator) requestToken(ctx context.Context) error {
+	// Ask the credentials provider, so that rotated secrets are picked up
+	clientID, clientSecret, subdomain, err := a.credentials(ctx)
+	if err != nil {
+		a.logger.Error("failed to load credentials", "error", err)
+		return olError.NewAuthenticationError(fmt.Sprintf("Failed to load credentials: %v", err))
+	}
+	baseURL, subdomain, err := a.endpoint(subdomain)
+	if err != nil {
+		return err
 	}
 
 	if len(clientID) == 0 {
@@ -48,8 +167,29 @@ func (a *Authenticator) GenerateToken() error {
 		return olError.NewAuthenticationError("Missing ONELOGIN_CLIENT_SECRET Env Variable")
 	}
 
+	// Another process may already have obtained a token for the same credentials
+	key := TokenKey(subdomain, clientID)
+	if a.store != nil {
+		// Hold the store's lock until the new token is saved, so other processes wait for it instead of
+		// requesting their own
+		if locker, ok := a.store.(TokenLocker); ok {
+			unlock, err := locker.Lock(ctx, key)
+			switch {
+			case err == nil:
+				defer unlock()
+			case ctx.Err() != nil:
+				return ctx.Err()
+			default:
+				a.logger.Warn("failed to lock token store", "error", err)
+			}
+		}
+		if a.loadStoredToken(ctx, key) {
+			return nil
+		}
+	}
+
 	// Construct the authentication URL
-	authURL := fmt.Sprintf("https://%s.onelogin.com%s", a.subdomain, TkPath)
+	authURL := baseURL + TkPath
 
 	// Create authentication request payload
 	data := map[string]string{
@@ -63,7 +203,7 @@ func (a *Authenticator) GenerateToken() error {
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest(http.MethodPost, authURL, strings.NewReader(string(jsonData)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authURL, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return olError.NewRequestError("Failed to create authentication request")
 	}
@@ -72,13 +212,21 @@ func (a *Authenticator) GenerateToken() error {
 	encodedCredentials := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", clientID, clientSecret)))
 	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", encodedCredentials))
 	req.Header.Add("Content-Type", "application/json")
+	if a.userAgent != "" {
+		req.Header.Set("User-Agent", a.userAgent)
+	}
 
 	// Send the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	a.logger.Debug("requesting access token", "url", authURL, "headers", req.Header)
+	resp, err := a.httpClient.Do(req)
 	if err != nil {
+		a.logger.Error("access token request failed", "url", authURL, "error", err)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		return olError.NewRequestError("Failed to send authentication request")
 	}
+	defer resp.Body.Close()
 
 	// Parse the authentication response
 	var result map[string]interface{}
@@ -89,6 +237,7 @@ func (a *Authenticator) GenerateToken() error {
 
 	// Check if authentication failed
 	if resp.StatusCode != http.StatusOK {
+		a.logger.Error("authentication failed", "url", authURL, "status", resp.StatusCode)
 		return olError.NewAuthenticationError("Authentication failed")
 	}
 
@@ -98,6 +247,9 @@ func (a *Authenticator) GenerateToken() error {
 		return olError.NewAuthenticationError("Authentication Failed at Endpoint")
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	accountId, ok := result["account_id"].(float64)
 	if ok {
 		a.accountId = fmt.Sprintf("%d", int(accountId))
@@ -106,31 +258,124 @@ func (a *Authenticator) GenerateToken() error {
 	expiresIn, ok := result["expires_in"].(float64)
 	if ok {
 		a.expiresIn = int(expiresIn)
+		a.expiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	} else {
+		a.expiresIn = 0
+		a.expiresAt = time.Time{}
 	}
 
 	// Store access token
 	a.accessToken = accessToken
+	a.tokenKey = key
+	a.logger.Info("access token obtained", "account_id", a.accountId, "expires_in", a.expiresIn)
+
+	// Tokens without an expiry can't be checked for freshness by other processes, so they aren't shared
+	if a.store != nil && !a.expiresAt.IsZero() {
+		token := &Token{AccessToken: a.accessToken, AccountID: a.accountId, ExpiresAt: a.expiresAt}
+		if err := a.store.Save(ctx, key, token); err != nil {
+			a.logger.Warn("failed to save access token to store", "error", err)
+		}
+	}
 
 	return nil
 }
 
+// loadStoredToken adopts the token saved in the store under key and reports whether it did. The saved
+// token is only used when it differs from the current token, which is being replaced because it is
+// missing, stale or was rejected, and doesn't expire within RefreshWindow.
+func (a *Authenticator) loadStoredToken(ctx context.Context, key string) bool {
+	token, err := a.store.Load(ctx, key)
+	if err != nil {
+		a.logger.Warn("failed to load access token from store", "error", err)
+		return false
+	}
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if token.AccessToken == a.accessToken || token.AccessToken == a.rejected || !time.Now().Before(token.ExpiresAt.Add(-RefreshWindow)) {
+		return false
+	}
+	a.accessToken = token.AccessToken
+	a.tokenKey = key
+	a.accountId = token.AccountID
+	a.expiresAt = token.ExpiresAt
+	a.expiresIn = int(time.Until(token.ExpiresAt).Seconds())
+	a.logger.Info("access token loaded from store", "account_id", a.accountId, "expires_in", a.expiresIn)
+	return true
+}
+
+// credentials asks the credentials provider for the client ID, secret and subdomain. All are empty when
+// the provider has none, which the callers report as missing.
+func (a *Authenticator) credentials(ctx context.Context) (string, string, string, error) {
+	credentials, err := a.provider.Credentials(ctx)
+	if errors.Is(err, ErrNoCredentials) || (err == nil && credentials == nil) {
+		return "", "", "", nil
+	}
+	if err != nil {
+		return "", "", "", err
+	}
+	return credentials.ClientID, credentials.ClientSecret, credentials.Subdomain, nil
+}
+
+// endpoint returns the base URL and subdomain of token requests. An Authenticator created without a
+// subdomain, e.g. by a client using WithLazyAuth, adopts the first subdomain its credentials name.
+func (a *Authenticator) endpoint(subdomain string) (string, string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.resolveSubdomain && a.subdomain == "" && subdomain != "" {
+		a.subdomain = subdomain
+		if a.derivedBaseURL {
+			a.baseURL, a.baseURLErr = utl.BaseURL(a.region, subdomain)
+		}
+	}
+	return a.baseURL, a.subdomain, a.baseURLErr
+}
+
+// BaseURL returns the base URL token requests are sent to. It is empty while the subdomain is still to be
+// taken from the credentials, or when the subdomain, region or base URL is invalid.
+func (a *Authenticator) BaseURL() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.baseURL
+}
+
 func (a *Authenticator) RevokeToken(token *string) error {
-	// Read environment variables
-	clientID := os.Getenv("ONELOGIN_CLIENT_ID")
-	clientSecret := os.Getenv("ONELOGIN_CLIENT_SECRET")
+	return a.RevokeTokenWithContext(context.Background(), token)
+}
 
-	if a.credentialsOverride != nil {
-		clientID = a.credentialsOverride.ClientID
-		clientSecret = a.credentialsOverride.ClientSecret
+// RevokeTokenWithContext revokes the given access token, aborting the request if ctx is cancelled.
+// A nil token revokes the authenticator's current token, if it has one. Once the current token is
+// revoked it is forgotten, so the next call to GetToken requests a new one.
+func (a *Authenticator) RevokeTokenWithContext(ctx context.Context, token *string) error {
+	a.mu.Lock()
+	current := a.accessToken
+	a.mu.Unlock()
+	if token == nil {
+		if current == "" {
+			return nil
+		}
+		token = &current
+	}
+	// Ask the credentials provider
+	clientID, clientSecret, subdomain, err := a.credentials(ctx)
+	if err != nil {
+		return olError.NewAuthenticationError(fmt.Sprintf("Failed to load credentials: %v", err))
+	}
+	baseURL, subdomain, err := a.endpoint(subdomain)
+	if err != nil {
+		return err
 	}
 
 	// Check if required environment variables are missing
 	if clientID == "" || clientSecret == "" {
-		return errors.New("missing client ID, client secret, or subdomain")
+		return olError.NewAuthenticationError("Missing client ID or client secret")
 	}
 
 	// Construct the revoke URL
-	revokeURL := fmt.Sprintf("%s.onelogin.com%s", a.subdomain, RevokePath)
+	revokeURL := baseURL + RevokePath
 
 	// Create revoke request payload
 	data := struct {
@@ -142,42 +387,116 @@ func (a *Authenticator) RevokeToken(token *string) error {
 	// Convert payload to JSON
 	jsonData, err := json.Marshal(data)
 	if err != nil {
-		return fmt.Errorf("failed to create revocation request: %w", err)
+		return olError.NewSerializationError("Unable to convert payload to JSON")
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", revokeURL, strings.NewReader(string(jsonData)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, revokeURL, strings.NewReader(string(jsonData)))
 	if err != nil {
-		return fmt.Errorf("failed to create revocation request: %w", err)
+		return olError.NewRequestError("Failed to create revocation request")
 	}
 
 	// Add authorization header with base64-encoded credentials
 	encodedCredentials := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", clientID, clientSecret)))
 	req.Header.Add("Authorization", fmt.Sprintf("Basic %s", encodedCredentials))
 	req.Header.Add("Content-Type", "application/json")
+	if a.userAgent != "" {
+		req.Header.Set("User-Agent", a.userAgent)
+	}
 
 	// Send the HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	a.logger.Debug("revoking access token", "url", revokeURL, "headers", req.Header)
+	resp, err := a.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to revoke: %w", err)
+		a.logger.Error("access token revocation failed", "url", revokeURL, "error", err)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return olError.NewRequestError("Failed to send revocation request")
 	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
 
 	// Check if revocation failed
 	if resp.StatusCode != http.StatusOK {
-		return olError.NewAuthenticationError("Revocation failed")
+		a.logger.Error("revocation failed", "url", revokeURL, "status", resp.StatusCode)
+		return olError.NewAuthenticationError(fmt.Sprintf("Revocation failed with status %d", resp.StatusCode))
 	}
 
-	// Success condition feedback
-	fmt.Println("Revocation successful")
+	a.mu.Lock()
+	if a.accessToken == *token {
+		a.accessToken = ""
+		a.expiresIn = 0
+		a.expiresAt = time.Time{}
+	}
+	a.mu.Unlock()
+	a.logger.Info("access token revoked")
+
+	// Keep other processes from picking up the revoked token
+	if a.store != nil {
+		key := TokenKey(subdomain, clientID)
+		if stored, err := a.store.Load(ctx, key); err == nil && stored != nil && stored.AccessToken == *token {
+			if err := a.store.Delete(ctx, key); err != nil {
+				a.logger.Warn("failed to delete access token from store", "error", err)
+			}
+		}
+	}
 
 	return nil
 }
 
 func (a *Authenticator) GetToken() (string, error) {
+	return a.GetTokenWithContext(context.Background())
+}
+
+// GetTokenWithContext returns the current access token. A token is requested first when none
+// has been obtained yet, or when the current one expires within RefreshWindow.
+func (a *Authenticator) GetTokenWithContext(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	token, stale := a.accessToken, a.isStale()
+	a.mu.Unlock()
+	if !stale {
+		return token, nil
+	}
+
+	if err := a.refresh(ctx, false); err != nil {
+		return "", err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	return a.accessToken, nil
 }
 
+// TokenKey returns the TokenKey of the subdomain and client ID the current access token was obtained for,
+// or an empty string before the first token.
+func (a *Authenticator) TokenKey() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tokenKey
+}
+
+// ExpiresAt returns when the current access token expires, or the zero time if unknown.
+func (a *Authenticator) ExpiresAt() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.expiresAt
+}
+
+// isStale reports whether there is no token yet or the current one is about to expire.
+// Callers must hold a.mu.
+func (a *Authenticator) isStale() bool {
+	if a.accessToken == "" {
+		return true
+	}
+	if a.expiresAt.IsZero() {
+		return false
+	}
+	return !time.Now().Before(a.expiresAt.Add(-RefreshWindow))
+}
+
 func (a *Authenticator) GetAccountId() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	return a.accountId
 }
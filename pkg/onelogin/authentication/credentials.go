@@ -0,0 +1,206 @@
+package authentication
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// DefaultSecretsDir is where FileCredentials looks for mounted secrets when ONELOGIN_SECRETS_DIR is not set.
+const DefaultSecretsDir = "/var/run/secrets/onelogin"
+
+// ErrNoCredentials is returned by a CredentialsProvider that has no credentials to offer,
+// so that a chain moves on to its next provider.
+var ErrNoCredentials = errors.New("no credentials found")
+
+// CredentialsProvider supplies the client ID and secret used to request access tokens. The Authenticator
+// asks its provider before every token request, so rotated secrets are picked up without a restart.
+// A provider may also name the subdomain, which NewClient uses when none is configured otherwise.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (*mod.APICredentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to a CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context) (*mod.APICredentials, error)
+
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (*mod.APICredentials, error) {
+	return f(ctx)
+}
+
+// ChainCredentials returns a provider that asks each of providers in turn and returns the first
+// credentials found. Errors other than ErrNoCredentials stop the chain, so a broken source is
+// reported rather than silently skipped.
+func ChainCredentials(providers ...CredentialsProvider) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		for _, provider := range providers {
+			if provider == nil {
+				continue
+			}
+			credentials, err := provider.Credentials(ctx)
+			if errors.Is(err, ErrNoCredentials) {
+				continue
+			}
+			return credentials, err
+		}
+		return nil, ErrNoCredentials
+	})
+}
+
+// DefaultCredentialsChain returns the provider used when none is configured. It looks for credentials in order:
+//
+//  1. explicit, e.g. from WithCredentials
+//  2. the ONELOGIN_CLIENT_ID, ONELOGIN_CLIENT_SECRET and ONELOGIN_SUBDOMAIN environment variables
+//  3. the "default" profile of the profiles file, see ProfileCredentials
+//  4. the output of the command in ONELOGIN_CREDENTIALS_COMMAND, see CommandCredentials
+//  5. secrets mounted in ONELOGIN_SECRETS_DIR or DefaultSecretsDir, see FileCredentials
+//
+// When ONELOGIN_PROFILE selects a profile, only explicit credentials and that profile are used, so that
+// credentials left in the environment for another tenant are never sent with the profile's subdomain.
+func DefaultCredentialsChain(explicit *mod.APICredentials) CredentialsProvider {
+	chain := ChainCredentials(
+		StaticCredentials(explicit),
+		EnvCredentials(),
+		ProfileCredentials("", DefaultProfile),
+		CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+			args := strings.Fields(os.Getenv("ONELOGIN_CREDENTIALS_COMMAND"))
+			if len(args) == 0 {
+				return nil, ErrNoCredentials
+			}
+			return CommandCredentials(args[0], args[1:]...).Credentials(ctx)
+		}),
+		FileCredentials(""),
+	)
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		if name := os.Getenv("ONELOGIN_PROFILE"); name != "" {
+			return ProfileChain(explicit, name).Credentials(ctx)
+		}
+		return chain.Credentials(ctx)
+	})
+}
+
+// ProfileChain returns the provider used when a profile is selected: explicit credentials if given,
+// otherwise those of the named profile.
+func ProfileChain(explicit *mod.APICredentials, name string) CredentialsProvider {
+	return ChainCredentials(StaticCredentials(explicit), ProfileCredentials("", name))
+}
+
+// StaticCredentials returns a provider that always returns credentials, or ErrNoCredentials when it is nil.
+func StaticCredentials(credentials *mod.APICredentials) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		if credentials == nil {
+			return nil, ErrNoCredentials
+		}
+		return credentials, nil
+	})
+}
+
+// EnvCredentials returns a provider that reads the ONELOGIN_CLIENT_ID, ONELOGIN_CLIENT_SECRET and
+// ONELOGIN_SUBDOMAIN environment variables each time it is asked.
+func EnvCredentials() CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		credentials := &mod.APICredentials{
+			ClientID:     os.Getenv("ONELOGIN_CLIENT_ID"),
+			ClientSecret: os.Getenv("ONELOGIN_CLIENT_SECRET"),
+			Subdomain:    os.Getenv("ONELOGIN_SUBDOMAIN"),
+		}
+		if credentials.ClientID == "" && credentials.ClientSecret == "" {
+			return nil, ErrNoCredentials
+		}
+		return credentials, nil
+	})
+}
+
+// ProfileCredentials returns a provider that loads a profile from the profiles file at path and
+// resolves its credentials with Profile.Credentials. The file is read again on every call. An empty
+// path selects ProfilesPath and an empty name selects DefaultProfile. When the default profile is
+// used, a missing file or profile yields ErrNoCredentials; a profile selected by name must exist.
+func ProfileCredentials(path, name string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		profile, err := LoadProfile(path, name)
+		if errors.Is(err, ErrProfileNotFound) && (name == "" || name == DefaultProfile) {
+			return nil, ErrNoCredentials
+		}
+		if err != nil {
+			return nil, err
+		}
+		return profile.Credentials(ctx)
+	})
+}
+
+// CommandCredentials returns a provider that runs a command and reads credentials from its standard
+// output, a JSON object with client_id, client_secret and optionally subdomain, e.g. a wrapper around a
+// password manager or secrets vault CLI. The command is run again for every token request.
+func CommandCredentials(name string, args ...string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("credentials command %s failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+		}
+
+		var output struct {
+			ClientID     string `json:"client_id"`
+			ClientSecret string `json:"client_secret"`
+			Subdomain    string `json:"subdomain"`
+		}
+		if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+			return nil, fmt.Errorf("credentials command %s returned invalid JSON: %w", name, err)
+		}
+		return &mod.APICredentials{ClientID: output.ClientID, ClientSecret: output.ClientSecret, Subdomain: output.Subdomain}, nil
+	})
+}
+
+// FileCredentials returns a provider that reads credentials from the files client_id, client_secret and
+// optionally subdomain in dir, as mounted by Kubernetes or Docker secrets. The files are read again for
+// every token request, so rotated secrets are picked up. An empty dir selects ONELOGIN_SECRETS_DIR or
+// DefaultSecretsDir. A missing client_id file yields ErrNoCredentials.
+func FileCredentials(dir string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (*mod.APICredentials, error) {
+		d := dir
+		if d == "" {
+			d = os.Getenv("ONELOGIN_SECRETS_DIR")
+		}
+		if d == "" {
+			d = DefaultSecretsDir
+		}
+
+		read := func(name string, required bool) (string, error) {
+			data, err := ioutil.ReadFile(filepath.Join(d, name))
+			if os.IsNotExist(err) && !required {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+			return strings.TrimSpace(string(data)), nil
+		}
+
+		clientID, err := read("client_id", true)
+		if os.IsNotExist(err) {
+			return nil, ErrNoCredentials
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mounted credentials: %w", err)
+		}
+		clientSecret, err := read("client_secret", true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mounted credentials: %w", err)
+		}
+		subdomain, err := read("subdomain", false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mounted credentials: %w", err)
+		}
+		return &mod.APICredentials{ClientID: clientID, ClientSecret: clientSecret, Subdomain: subdomain}, nil
+	})
+}
@@ -0,0 +1,67 @@
+package authentication
+
+import (
+	"net/http"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/logging"
+)
+
+// HTTPClient is an interface that defines the Do method for making HTTP requests.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Option configures an Authenticator.
+type Option func(a *Authenticator)
+
+// WithHTTPClient sends token requests through client instead of a default http.Client.
+func WithHTTPClient(client HTTPClient) Option {
+	return func(a *Authenticator) {
+		a.httpClient = client
+	}
+}
+
+// WithBaseURL sends token requests to baseURL instead of https://<subdomain>.onelogin.com,
+// e.g. a custom domain or a local test server. It takes precedence over WithRegion.
+func WithBaseURL(baseURL string) Option {
+	return func(a *Authenticator) {
+		a.baseURL = baseURL
+	}
+}
+
+// WithRegion sends token requests to the regional API host, e.g. https://api.eu.onelogin.com for "eu",
+// instead of https://<subdomain>.onelogin.com.
+func WithRegion(region string) Option {
+	return func(a *Authenticator) {
+		a.region = region
+	}
+}
+
+// WithUserAgent sets the User-Agent header on token requests.
+func WithUserAgent(userAgent string) Option {
+	return func(a *Authenticator) {
+		a.userAgent = userAgent
+	}
+}
+
+// WithLogger sends the authenticator's diagnostic messages to logger, with secrets redacted.
+func WithLogger(logger logging.Logger) Option {
+	return func(a *Authenticator) {
+		a.logger = logging.WithRedaction(logger)
+	}
+}
+
+// WithTokenStore shares access tokens through store, so that an unexpired token saved by another
+// Authenticator or process with the same subdomain and client ID is reused instead of requesting a new one.
+func WithTokenStore(store TokenStore) Option {
+	return func(a *Authenticator) {
+		a.store = store
+	}
+}
+
+// WithCredentialsProvider obtains the client ID and secret from provider instead of DefaultCredentialsChain.
+func WithCredentialsProvider(provider CredentialsProvider) Option {
+	return func(a *Authenticator) {
+		a.provider = provider
+	}
+}
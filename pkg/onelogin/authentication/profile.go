@@ -0,0 +1,165 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// ErrProfileNotFound is returned by LoadProfile when the profiles file or the profile doesn't exist.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile is a named entry of the profiles file. Secrets can be written inline, but are better
+// referenced through an environment variable, a file, a command or a directory of mounted secrets:
+//
+//	profiles:
+//	  staging:
+//	    subdomain: acme-staging
+//	    timeout: 30s
+//	    client_id: 0123456789abcdef
+//	    client_secret_env: ONELOGIN_STAGING_SECRET
+//	  production:
+//	    subdomain: acme
+//	    region: eu
+//	    credentials_command: ["vault-onelogin", "--tenant", "acme"]
+type Profile struct {
+	Subdomain string `yaml:"subdomain"`
+	Region    string `yaml:"region"`
+	Timeout   string `yaml:"timeout"` // seconds, or a duration such as "1m30s"
+
+	ClientID           string   `yaml:"client_id"`
+	ClientSecret       string   `yaml:"client_secret"`
+	ClientSecretEnv    string   `yaml:"client_secret_env"`   // environment variable holding the secret
+	ClientSecretFile   string   `yaml:"client_secret_file"`  // file holding the secret
+	CredentialsCommand []string `yaml:"credentials_command"` // command printing credentials, see CommandCredentials
+	SecretsDir         string   `yaml:"secrets_dir"`         // directory of mounted secrets, see FileCredentials
+}
+
+type profilesFile struct {
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// ProfilesPath returns the path of the profiles file, ONELOGIN_CONFIG_FILE or ~/.onelogin/config.yml.
+func ProfilesPath() (string, error) {
+	if path := os.Getenv("ONELOGIN_CONFIG_FILE"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the home directory: %w", err)
+	}
+	return filepath.Join(home, ".onelogin", "config.yml"), nil
+}
+
+// LoadProfile reads the profile called name from the profiles file at path. An empty path selects
+// ProfilesPath and an empty name selects DefaultProfile. ErrProfileNotFound is returned when the
+// file or the profile doesn't exist.
+func LoadProfile(path, name string) (*Profile, error) {
+	if path == "" {
+		var err error
+		if path, err = ProfilesPath(); err != nil {
+			return nil, err
+		}
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s does not exist", ErrProfileNotFound, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles file: %w", err)
+	}
+
+	var file profilesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles file %s: %w", path, err)
+	}
+	profile, ok := file.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not defined in %s", ErrProfileNotFound, name, path)
+	}
+	if _, err := profile.TimeoutDuration(); err != nil {
+		return nil, fmt.Errorf("invalid profile %q in %s: %w", name, path, err)
+	}
+	return &profile, nil
+}
+
+// TimeoutDuration returns the profile's timeout, or 0 when it has none.
+func (p *Profile) TimeoutDuration() (time.Duration, error) {
+	if p.Timeout == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(p.Timeout); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	d, err := time.ParseDuration(p.Timeout)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("timeout %q is not a positive number of seconds or a duration", p.Timeout)
+	}
+	return d, nil
+}
+
+// Credentials resolves the profile's credentials, following its references each time so that rotated
+// secrets are picked up. credentials_command takes precedence over secrets_dir, which takes precedence
+// over client_id with client_secret, client_secret_env or client_secret_file. The profile's subdomain is
+// used when the credentials don't name one.
+func (p *Profile) Credentials(ctx context.Context) (*mod.APICredentials, error) {
+	var credentials *mod.APICredentials
+	var err error
+	switch {
+	case len(p.CredentialsCommand) > 0:
+		credentials, err = CommandCredentials(p.CredentialsCommand[0], p.CredentialsCommand[1:]...).Credentials(ctx)
+	case p.SecretsDir != "":
+		credentials, err = FileCredentials(expandHome(p.SecretsDir)).Credentials(ctx)
+	default:
+		credentials = &mod.APICredentials{ClientID: p.ClientID, ClientSecret: p.ClientSecret}
+		if p.ClientSecretEnv != "" {
+			credentials.ClientSecret = os.Getenv(p.ClientSecretEnv)
+		}
+		if p.ClientSecretFile != "" {
+			data, err := ioutil.ReadFile(expandHome(p.ClientSecretFile))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read client_secret_file: %w", err)
+			}
+			credentials.ClientSecret = strings.TrimSpace(string(data))
+		}
+		if credentials.ClientID == "" && credentials.ClientSecret == "" {
+			return nil, ErrNoCredentials
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials.Subdomain == "" {
+		credentials.Subdomain = p.Subdomain
+	}
+	return credentials, nil
+}
+
+// expandHome replaces a leading ~ with the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
@@ -0,0 +1,286 @@
+package authentication
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Token is an access token together with what a TokenStore needs to decide whether it can be reused.
+type Token struct {
+	AccessToken string    `json:"access_token"`
+	AccountID   string    `json:"account_id,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// TokenStore keeps access tokens outside an Authenticator, so that processes using the same
+// credentials can reuse an unexpired token instead of each requesting their own. Keys are built
+// with TokenKey. Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Load returns the token saved under key, or nil when there is none.
+	Load(ctx context.Context, key string) (*Token, error)
+	// Save replaces the token saved under key.
+	Save(ctx context.Context, key string, token *Token) error
+	// Delete removes the token saved under key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// TokenLocker is implemented by TokenStores that can serialize token requests. An Authenticator using
+// such a store holds the lock for key while it checks the store, requests a token and saves it, so that
+// processes starting together request a single token and the others load it.
+type TokenLocker interface {
+	// Lock waits until the lock for key is free or ctx is done, takes it, and returns the function releasing it.
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
+
+// TokenKey returns the key tokens for subdomain and clientID are stored under.
+func TokenKey(subdomain, clientID string) string {
+	return subdomain + "/" + clientID
+}
+
+// MemoryTokenStore is a TokenStore that shares tokens between the Authenticators of one process.
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]Token
+	locks  map[string]chan struct{}
+}
+
+// NewMemoryTokenStore returns an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: map[string]Token{}, locks: map[string]chan struct{}{}}
+}
+
+func (s *MemoryTokenStore) Lock(ctx context.Context, key string) (func(), error) {
+	s.mu.Lock()
+	lock, ok := s.locks[key]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		s.locks[key] = lock
+	}
+	s.mu.Unlock()
+
+	select {
+	case lock <- struct{}{}:
+		return func() { <-lock }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *MemoryTokenStore) Load(ctx context.Context, key string) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (s *MemoryTokenStore) Save(ctx context.Context, key string, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[key] = *token
+	return nil
+}
+
+func (s *MemoryTokenStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, key)
+	return nil
+}
+
+// Lock files older than these are considered abandoned by a crashed process. The request lock is held
+// during a token request, however long that takes, so its holder refreshes it every requestLockRefresh.
+const (
+	staleLockAge        = 10 * time.Second
+	staleRequestLockAge = 10 * time.Second
+	requestLockRefresh  = time.Second
+)
+
+// FileTokenStore is a TokenStore that shares tokens between processes through files in a directory.
+// The directory must be accessible only to its owner and every token file is created with mode 0600.
+// Writers take a lock file so concurrent processes don't interleave, and files are replaced
+// atomically so readers never see a partial token. As a TokenLocker it also serializes the token
+// requests of processes using the same key.
+type FileTokenStore struct {
+	dir string
+}
+
+// NewFileTokenStore returns a FileTokenStore keeping its files in dir, creating it with mode 0700 if needed.
+// An existing dir that other users can access, such as /tmp, is rejected rather than having its mode
+// changed. An empty dir selects DefaultTokenStoreDir.
+func NewFileTokenStore(dir string) (*FileTokenStore, error) {
+	if dir == "" {
+		var err error
+		if dir, err = DefaultTokenStoreDir(); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create token store directory: %w", err)
+	}
+	// MkdirAll leaves the mode of an existing directory alone
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token store directory: %w", err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("token store directory %s must be accessible only to its owner, got mode %v", dir, info.Mode().Perm())
+	}
+	return &FileTokenStore{dir: dir}, nil
+}
+
+// DefaultTokenStoreDir returns the onelogin/tokens directory inside the user's cache directory.
+func DefaultTokenStoreDir() (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the user cache directory: %w", err)
+	}
+	return filepath.Join(cache, "onelogin", "tokens"), nil
+}
+
+// storedToken is the content of a token file. The key guards against reading another key's token.
+type storedToken struct {
+	Key string `json:"key"`
+	Token
+}
+
+// path returns the file for key. Keys are hashed since they contain characters that aren't valid in file names.
+func (s *FileTokenStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+func (s *FileTokenStore) Load(ctx context.Context, key string) (*Token, error) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	var stored storedToken
+	if err := json.Unmarshal(data, &stored); err != nil || stored.Key != key {
+		// a corrupt or foreign file is treated as missing and replaced on the next Save
+		return nil, nil
+	}
+	return &stored.Token, nil
+}
+
+func (s *FileTokenStore) Save(ctx context.Context, key string, token *Token) error {
+	data, err := json.Marshal(storedToken{Key: key, Token: *token})
+	if err != nil {
+		return fmt.Errorf("failed to encode token: %w", err)
+	}
+
+	path := s.path(key)
+	unlock, err := lockFile(ctx, path+".lock", staleLockAge)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// write a temporary file, which TempFile creates with mode 0600, and move it into place so that
+	// readers see either the old or the new token
+	tmp, err := ioutil.TempFile(s.dir, ".token-*")
+	if err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileTokenStore) Delete(ctx context.Context, key string) error {
+	path := s.path(key)
+	unlock, err := lockFile(ctx, path+".lock", staleLockAge)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete token file: %w", err)
+	}
+	return nil
+}
+
+// Lock takes the request lock for key, which is separate from the lock taken by Save and Delete. Until it
+// is released, the lock file is touched every requestLockRefresh so other processes don't take it as stale.
+func (s *FileTokenStore) Lock(ctx context.Context, key string) (func(), error) {
+	lockPath := s.path(key) + ".request.lock"
+	unlock, err := lockFile(ctx, lockPath, staleRequestLockAge)
+	if err != nil {
+		return nil, err
+	}
+
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(requestLockRefresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				os.Chtimes(lockPath, now, now)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			// stop touching the file before removing it, so a lock taken next by another process is left alone
+			close(done)
+			<-stopped
+			unlock()
+		})
+	}, nil
+}
+
+// lockFile creates the lock file at lockPath, waiting while another process or goroutine holds it.
+// A lock file older than staleAge is removed, as its owner is assumed to have crashed.
+func lockFile(ctx context.Context, lockPath string, staleAge time.Duration) (func(), error) {
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to lock token file: %w", err)
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleAge {
+			os.Remove(lockPath)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
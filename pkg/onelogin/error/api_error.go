@@ -1,14 +1,76 @@
 package error
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors matched by APIError through errors.Is.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServerError  = errors.New("server error")
+)
+
+// FieldError describes a problem with a single field of the request.
+type FieldError struct {
+	Field   string
+	Message string
+}
 
 type APIError struct {
 	Message string
-	Code    int
+	Code    int // HTTP status code
+
+	Type        string       // OneLogin error name or type, e.g. "NotFound"
+	ErrorCode   int          // OneLogin error code from the response body, when it differs from the status
+	FieldErrors []FieldError // Per-field problems reported by OneLogin
+	RequestID   string       // Value of the X-Request-Id response header
+	Header      http.Header  // Response headers
+	Body        []byte       // Raw response body
+
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         time.Duration // How long the server asked to wait before retrying, if it did
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("API error: %s", e.Message)
+	msg := fmt.Sprintf("API error: %s (status %d)", e.Message, e.Code)
+	for _, fe := range e.FieldErrors {
+		msg += fmt.Sprintf("; %s: %s", fe.Field, fe.Message)
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" [request %s]", e.RequestID)
+	}
+	return msg
+}
+
+// Is allows errors.Is(err, ErrNotFound) and the other sentinels to match on the status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Code == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.Code == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Code == http.StatusForbidden
+	case ErrNotFound:
+		return e.Code == http.StatusNotFound
+	case ErrConflict:
+		return e.Code == http.StatusConflict
+	case ErrRateLimited:
+		return e.Code == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.Code >= http.StatusInternalServerError
+	}
+	return false
 }
 
 func NewAPIError(message string, code int) *APIError {
@@ -17,3 +79,25 @@ func NewAPIError(message string, code int) *APIError {
 		Code:    code,
 	}
 }
+
+// IsNotFound reports whether err is an API error caused by a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict reports whether err is an API error caused by a conflicting resource.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsUnauthorized reports whether err is an API error caused by a rejected access token.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsRateLimited reports whether err was caused by OneLogin's rate limit or by the
+// client-side rate limiter.
+func IsRateLimited(err error) bool {
+	var rateLimitErr *RateLimitError
+	return errors.Is(err, ErrRateLimited) || errors.As(err, &rateLimitErr)
+}
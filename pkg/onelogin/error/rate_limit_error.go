@@ -0,0 +1,22 @@
+package error
+
+import (
+	"fmt"
+	"time"
+)
+
+type RateLimitError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("Rate limit error: %s (retry after %v)", e.Message, e.RetryAfter)
+}
+
+func NewRateLimitError(message string, retryAfter time.Duration) *RateLimitError {
+	return &RateLimitError{
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
@@ -0,0 +1,39 @@
+package error
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrValidation is matched through errors.Is by every ValidationError.
+var ErrValidation = errors.New("validation failed")
+
+// ValidationError lists every field of a query or request model that failed validation before the
+// request was sent.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, fe := range e.Fields {
+		msgs[i] = fmt.Sprintf("%s %s", fe.Field, fe.Message)
+	}
+	return fmt.Sprintf("Validation error: %s", strings.Join(msgs, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+func NewValidationError(fields []FieldError) *ValidationError {
+	return &ValidationError{
+		Fields: fields,
+	}
+}
+
+// IsValidation reports whether err was caused by a query or request model that failed validation.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
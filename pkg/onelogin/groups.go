@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) GetGroupByID(groupID int) (interface{}, error) {
+	return sdk.GetGroupByIDWithContext(context.Background(), groupID)
+}
+
+func (sdk *OneloginSDK) GetGroupByIDWithContext(ctx context.Context, groupID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(GroupsPath, groupID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -22,13 +27,43 @@ func (sdk *OneloginSDK) GetGroupByID(groupID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetGroups(queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetGroupsWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetGroupsWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(GroupsPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) GetGroupByIDTyped(groupID int) (*mod.Response[mod.Group], error) {
+	return sdk.GetGroupByIDTypedWithContext(context.Background(), groupID)
+}
+
+func (sdk *OneloginSDK) GetGroupByIDTypedWithContext(ctx context.Context, groupID int) (*mod.Response[mod.Group], error) {
+	p, err := utl.BuildAPIPath(GroupsPath, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.Group](sdk.Client.GetWithContext(ctx, &p, nil))
+}
+
+func (sdk *OneloginSDK) GetGroupsTyped(queryParams mod.Queryable) (*mod.Response[[]mod.Group], error) {
+	return sdk.GetGroupsTypedWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetGroupsTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.Group], error) {
+	p, err := utl.BuildAPIPath(GroupsPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.Group](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
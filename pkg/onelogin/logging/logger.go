@@ -0,0 +1,113 @@
+package logging
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Level is the severity of a log entry.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%d)", int(l))
+	}
+}
+
+// Logger receives the SDK's diagnostic messages. keysAndValues holds alternating keys and values,
+// e.g. Debug("sending request", "method", "GET", "path", "/api/2/users").
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// NopLogger discards every message. It is the default logger of the SDK.
+type NopLogger struct{}
+
+func (NopLogger) Debug(string, ...interface{}) {}
+func (NopLogger) Info(string, ...interface{})  {}
+func (NopLogger) Warn(string, ...interface{})  {}
+func (NopLogger) Error(string, ...interface{}) {}
+
+// StdLogger writes messages at or above a minimum level to a standard library logger
+// in key=value form.
+type StdLogger struct {
+	out      *log.Logger
+	minLevel Level
+}
+
+// NewStdLogger creates a StdLogger that writes to out, or to the standard logger when out is nil.
+func NewStdLogger(out *log.Logger, minLevel Level) *StdLogger {
+	if out == nil {
+		out = log.Default()
+	}
+	return &StdLogger{out: out, minLevel: minLevel}
+}
+
+func (l *StdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(LevelDebug, msg, keysAndValues)
+}
+
+func (l *StdLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(LevelInfo, msg, keysAndValues)
+}
+
+func (l *StdLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log(LevelWarn, msg, keysAndValues)
+}
+
+func (l *StdLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log(LevelError, msg, keysAndValues)
+}
+
+func (l *StdLogger) log(level Level, msg string, keysAndValues []interface{}) {
+	if level < l.minLevel {
+		return
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "level=%s msg=%q", level, msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		var value interface{} = "(MISSING)"
+		if i+1 < len(keysAndValues) {
+			value = keysAndValues[i+1]
+		}
+		fmt.Fprintf(&b, " %s=%s", key, formatValue(value))
+	}
+	l.out.Println(b.String())
+}
+
+func formatValue(value interface{}) string {
+	s := fmt.Sprint(value)
+	if strings.ContainsAny(s, " \t\n\"=") {
+		return fmt.Sprintf("%q", s)
+	}
+	return s
+}
+
+// OrNop returns logger, or a NopLogger when logger is nil.
+func OrNop(logger Logger) Logger {
+	if logger == nil {
+		return NopLogger{}
+	}
+	return logger
+}
@@ -0,0 +1,152 @@
+package logging
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secret values in log entries.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are field, header and parameter names whose values are never logged.
+var sensitiveKeys = map[string]bool{
+	"authorization":         true,
+	"access_token":          true,
+	"refresh_token":         true,
+	"token":                 true,
+	"client_secret":         true,
+	"clientsecret":          true,
+	"secret":                true,
+	"password":              true,
+	"password_confirmation": true,
+	"salt":                  true,
+	"otp":                   true,
+}
+
+var (
+	authSchemePattern = regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9\-._~+/=]+`)
+	jsonFieldPattern  = regexp.MustCompile(`(?i)("(?:access_token|refresh_token|token|client_secret|secret|password|password_confirmation|salt|otp)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	formFieldPattern  = regexp.MustCompile(`(?i)\b((?:access_token|refresh_token|client_secret|password|password_confirmation)=)[^&\s]+`)
+)
+
+// IsSensitiveKey reports whether values stored under key must be redacted.
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[strings.ToLower(strings.TrimSpace(key))]
+}
+
+// RedactString masks bearer and basic credentials and secret JSON or form fields in s.
+func RedactString(s string) string {
+	s = authSchemePattern.ReplaceAllString(s, "$1 "+Redacted)
+	s = jsonFieldPattern.ReplaceAllString(s, `$1"`+Redacted+`"`)
+	s = formFieldPattern.ReplaceAllString(s, "${1}"+Redacted)
+	return s
+}
+
+// RedactValue returns a copy of value with secrets masked. key is the name the value is logged under.
+func RedactValue(key string, value interface{}) interface{} {
+	if IsSensitiveKey(key) {
+		return Redacted
+	}
+	switch v := value.(type) {
+	case string:
+		return RedactString(v)
+	case []byte:
+		return RedactString(string(v))
+	case error:
+		return RedactString(v.Error())
+	case http.Header:
+		return redactHeader(v)
+	case url.Values:
+		return redactValues(v)
+	case map[string]string:
+		redacted := make(map[string]string, len(v))
+		for k, val := range v {
+			if IsSensitiveKey(k) {
+				redacted[k] = Redacted
+			} else {
+				redacted[k] = RedactString(val)
+			}
+		}
+		return redacted
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			redacted[k] = RedactValue(k, val)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+func redactHeader(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for k, values := range h {
+		if IsSensitiveKey(k) {
+			redacted[k] = []string{Redacted}
+			continue
+		}
+		redacted[k] = make([]string, len(values))
+		for i, val := range values {
+			redacted[k][i] = RedactString(val)
+		}
+	}
+	return redacted
+}
+
+func redactValues(v url.Values) url.Values {
+	redacted := make(url.Values, len(v))
+	for k, values := range v {
+		if IsSensitiveKey(k) {
+			redacted[k] = []string{Redacted}
+			continue
+		}
+		redacted[k] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// redactingLogger masks secrets before handing entries to the wrapped Logger.
+type redactingLogger struct {
+	next Logger
+}
+
+// WithRedaction wraps logger so that secrets in messages and fields are masked. A nil logger
+// yields a NopLogger.
+func WithRedaction(logger Logger) Logger {
+	switch logger.(type) {
+	case nil:
+		return NopLogger{}
+	case NopLogger, *redactingLogger:
+		return logger
+	}
+	return &redactingLogger{next: logger}
+}
+
+func (l *redactingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.next.Debug(RedactString(msg), redactFields(keysAndValues)...)
+}
+
+func (l *redactingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.next.Info(RedactString(msg), redactFields(keysAndValues)...)
+}
+
+func (l *redactingLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.next.Warn(RedactString(msg), redactFields(keysAndValues)...)
+}
+
+func (l *redactingLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.next.Error(RedactString(msg), redactFields(keysAndValues)...)
+}
+
+func redactFields(keysAndValues []interface{}) []interface{} {
+	redacted := make([]interface{}, len(keysAndValues))
+	copy(redacted, keysAndValues)
+	for i := 1; i < len(redacted); i += 2 {
+		key, _ := redacted[i-1].(string)
+		redacted[i] = RedactValue(key, redacted[i])
+	}
+	return redacted
+}
@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -11,11 +12,15 @@ const (
 
 // https://<subdomain>/api/2/mfa/users/<user_id>/factors
 func (sdk *OneloginSDK) GetAvailableMFAFactors(userID int) (interface{}, error) {
+	return sdk.GetAvailableMFAFactorsWithContext(context.Background(), userID)
+}
+
+func (sdk *OneloginSDK) GetAvailableMFAFactorsWithContext(ctx context.Context, userID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "factors")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -24,11 +29,15 @@ func (sdk *OneloginSDK) GetAvailableMFAFactors(userID int) (interface{}, error)
 
 // https://<subdomain>/api/2/mfa/users/<user_id>/registrations
 func (sdk *OneloginSDK) EnrollMFAFactor(factor models.EnrollFactorRequest, userID int) (interface{}, error) {
+	return sdk.EnrollMFAFactorWithContext(context.Background(), factor, userID)
+}
+
+func (sdk *OneloginSDK) EnrollMFAFactorWithContext(ctx context.Context, factor models.EnrollFactorRequest, userID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "registrations")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, factor)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, factor)
 	if err != nil {
 		return nil, err
 	}
@@ -37,11 +46,15 @@ func (sdk *OneloginSDK) EnrollMFAFactor(factor models.EnrollFactorRequest, userI
 
 // https://<subdomain>/api/2/mfa/users/<user_id>/registrations/<registration_id>
 func (sdk *OneloginSDK) VerifyMFAEnrollment(userID, registrationID, otp int) (interface{}, error) {
+	return sdk.VerifyMFAEnrollmentWithContext(context.Background(), userID, registrationID, otp)
+}
+
+func (sdk *OneloginSDK) VerifyMFAEnrollmentWithContext(ctx context.Context, userID, registrationID, otp int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "registrations", registrationID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, otp)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, otp)
 	if err != nil {
 		return nil, err
 	}
@@ -50,11 +63,15 @@ func (sdk *OneloginSDK) VerifyMFAEnrollment(userID, registrationID, otp int) (in
 
 // https://<subdomain>/api/2/mfa/users/<user_id>/verifications
 func (sdk *OneloginSDK) ActivateMFAFactor(userID int, request models.ActivateFactorRequest) (interface{}, error) {
+	return sdk.ActivateMFAFactorWithContext(context.Background(), userID, request)
+}
+
+func (sdk *OneloginSDK) ActivateMFAFactorWithContext(ctx context.Context, userID int, request models.ActivateFactorRequest) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "verifications")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, request)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, request)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +80,15 @@ func (sdk *OneloginSDK) ActivateMFAFactor(userID int, request models.ActivateFac
 
 // https://<subdomain>/api/2/mfa/users/<user_id>/devices/<device_id>
 func (sdk *OneloginSDK) RemoveMFAFactor(userID, deviceID int) (interface{}, error) {
+	return sdk.RemoveMFAFactorWithContext(context.Background(), userID, deviceID)
+}
+
+func (sdk *OneloginSDK) RemoveMFAFactorWithContext(ctx context.Context, userID, deviceID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "devices", deviceID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -76,11 +97,15 @@ func (sdk *OneloginSDK) RemoveMFAFactor(userID, deviceID int) (interface{}, erro
 
 // https://<subdomain>/api/2/mfa/users/<user_id>/factors
 func (sdk *OneloginSDK) GetEnrolledMFAFactors(userID int) (interface{}, error) {
+	return sdk.GetEnrolledMFAFactorsWithContext(context.Background(), userID)
+}
+
+func (sdk *OneloginSDK) GetEnrolledMFAFactorsWithContext(ctx context.Context, userID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "factors")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -89,11 +114,15 @@ func (sdk *OneloginSDK) GetEnrolledMFAFactors(userID int) (interface{}, error) {
 
 // https://<subdomain>/api/2/mfa/users/:user_id/mfa_token
 func (sdk *OneloginSDK) GenerateMFAToken(userID int, request models.GenerateMFATokenRequest) (interface{}, error) {
+	return sdk.GenerateMFATokenWithContext(context.Background(), userID, request)
+}
+
+func (sdk *OneloginSDK) GenerateMFATokenWithContext(ctx context.Context, userID int, request models.GenerateMFATokenRequest) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MFAPath, userID, "mfa_token")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, request)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, request)
 	if err != nil {
 		return nil, err
 	}
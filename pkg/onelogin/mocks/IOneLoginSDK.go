@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	models "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	mock "github.com/stretchr/testify/mock"
+
+	onelogin "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
 )
 
 // IOneLoginSDK is an autogenerated mock type for the IOneLoginSDK type
@@ -79,6 +83,66 @@ func (_c *IOneLoginSDK_ActivateMFAFactor_Call) RunAndReturn(run func(int, models
 	return _c
 }
 
+// ActivateMFAFactorWithContext provides a mock function with given fields: ctx, userID, request
+func (_m *IOneLoginSDK) ActivateMFAFactorWithContext(ctx context.Context, userID int, request models.ActivateFactorRequest) (interface{}, error) {
+	ret := _m.Called(ctx, userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActivateMFAFactorWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.ActivateFactorRequest) (interface{}, error)); ok {
+		return rf(ctx, userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.ActivateFactorRequest) interface{}); ok {
+		r0 = rf(ctx, userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.ActivateFactorRequest) error); ok {
+		r1 = rf(ctx, userID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ActivateMFAFactorWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActivateMFAFactorWithContext'
+type IOneLoginSDK_ActivateMFAFactorWithContext_Call struct {
+	*mock.Call
+}
+
+// ActivateMFAFactorWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - request models.ActivateFactorRequest
+func (_e *IOneLoginSDK_Expecter) ActivateMFAFactorWithContext(ctx interface{}, userID interface{}, request interface{}) *IOneLoginSDK_ActivateMFAFactorWithContext_Call {
+	return &IOneLoginSDK_ActivateMFAFactorWithContext_Call{Call: _e.mock.On("ActivateMFAFactorWithContext", ctx, userID, request)}
+}
+
+func (_c *IOneLoginSDK_ActivateMFAFactorWithContext_Call) Run(run func(ctx context.Context, userID int, request models.ActivateFactorRequest)) *IOneLoginSDK_ActivateMFAFactorWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.ActivateFactorRequest))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ActivateMFAFactorWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ActivateMFAFactorWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ActivateMFAFactorWithContext_Call) RunAndReturn(run func(context.Context, int, models.ActivateFactorRequest) (interface{}, error)) *IOneLoginSDK_ActivateMFAFactorWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddPrivilegeToRole provides a mock function with given fields: privilegeID, roleID
 func (_m *IOneLoginSDK) AddPrivilegeToRole(privilegeID string, roleID int) (interface{}, error) {
 	ret := _m.Called(privilegeID, roleID)
@@ -138,6 +202,66 @@ func (_c *IOneLoginSDK_AddPrivilegeToRole_Call) RunAndReturn(run func(string, in
 	return _c
 }
 
+// AddPrivilegeToRoleWithContext provides a mock function with given fields: ctx, privilegeID, roleID
+func (_m *IOneLoginSDK) AddPrivilegeToRoleWithContext(ctx context.Context, privilegeID string, roleID int) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPrivilegeToRoleWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (interface{}, error)); ok {
+		return rf(ctx, privilegeID, roleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) interface{}); ok {
+		r0 = rf(ctx, privilegeID, roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, privilegeID, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_AddPrivilegeToRoleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPrivilegeToRoleWithContext'
+type IOneLoginSDK_AddPrivilegeToRoleWithContext_Call struct {
+	*mock.Call
+}
+
+// AddPrivilegeToRoleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) AddPrivilegeToRoleWithContext(ctx interface{}, privilegeID interface{}, roleID interface{}) *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call {
+	return &IOneLoginSDK_AddPrivilegeToRoleWithContext_Call{Call: _e.mock.On("AddPrivilegeToRoleWithContext", ctx, privilegeID, roleID)}
+}
+
+func (_c *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call) Run(run func(ctx context.Context, privilegeID string, roleID int)) *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call) RunAndReturn(run func(context.Context, string, int) (interface{}, error)) *IOneLoginSDK_AddPrivilegeToRoleWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddRoleAdmins provides a mock function with given fields: roleID
 func (_m *IOneLoginSDK) AddRoleAdmins(roleID int) (interface{}, error) {
 	ret := _m.Called(roleID)
@@ -196,6 +320,65 @@ func (_c *IOneLoginSDK_AddRoleAdmins_Call) RunAndReturn(run func(int) (interface
 	return _c
 }
 
+// AddRoleAdminsWithContext provides a mock function with given fields: ctx, roleID
+func (_m *IOneLoginSDK) AddRoleAdminsWithContext(ctx context.Context, roleID int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoleAdminsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, roleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_AddRoleAdminsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRoleAdminsWithContext'
+type IOneLoginSDK_AddRoleAdminsWithContext_Call struct {
+	*mock.Call
+}
+
+// AddRoleAdminsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) AddRoleAdminsWithContext(ctx interface{}, roleID interface{}) *IOneLoginSDK_AddRoleAdminsWithContext_Call {
+	return &IOneLoginSDK_AddRoleAdminsWithContext_Call{Call: _e.mock.On("AddRoleAdminsWithContext", ctx, roleID)}
+}
+
+func (_c *IOneLoginSDK_AddRoleAdminsWithContext_Call) Run(run func(ctx context.Context, roleID int)) *IOneLoginSDK_AddRoleAdminsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_AddRoleAdminsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_AddRoleAdminsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_AddRoleAdminsWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_AddRoleAdminsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddRoleUsers provides a mock function with given fields: roleID, users
 func (_m *IOneLoginSDK) AddRoleUsers(roleID int, users []int) (interface{}, error) {
 	ret := _m.Called(roleID, users)
@@ -255,6 +438,66 @@ func (_c *IOneLoginSDK_AddRoleUsers_Call) RunAndReturn(run func(int, []int) (int
 	return _c
 }
 
+// AddRoleUsersWithContext provides a mock function with given fields: ctx, roleID, users
+func (_m *IOneLoginSDK) AddRoleUsersWithContext(ctx context.Context, roleID int, users []int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID, users)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRoleUsersWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (interface{}, error)); ok {
+		return rf(ctx, roleID, users)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) interface{}); ok {
+		r0 = rf(ctx, roleID, users)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, roleID, users)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_AddRoleUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRoleUsersWithContext'
+type IOneLoginSDK_AddRoleUsersWithContext_Call struct {
+	*mock.Call
+}
+
+// AddRoleUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+//   - users []int
+func (_e *IOneLoginSDK_Expecter) AddRoleUsersWithContext(ctx interface{}, roleID interface{}, users interface{}) *IOneLoginSDK_AddRoleUsersWithContext_Call {
+	return &IOneLoginSDK_AddRoleUsersWithContext_Call{Call: _e.mock.On("AddRoleUsersWithContext", ctx, roleID, users)}
+}
+
+func (_c *IOneLoginSDK_AddRoleUsersWithContext_Call) Run(run func(ctx context.Context, roleID int, users []int)) *IOneLoginSDK_AddRoleUsersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_AddRoleUsersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_AddRoleUsersWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_AddRoleUsersWithContext_Call) RunAndReturn(run func(context.Context, int, []int) (interface{}, error)) *IOneLoginSDK_AddRoleUsersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssignRolesToUser provides a mock function with given fields: userID, roles
 func (_m *IOneLoginSDK) AssignRolesToUser(userID int, roles []int) (interface{}, error) {
 	ret := _m.Called(userID, roles)
@@ -314,6 +557,66 @@ func (_c *IOneLoginSDK_AssignRolesToUser_Call) RunAndReturn(run func(int, []int)
 	return _c
 }
 
+// AssignRolesToUserWithContext provides a mock function with given fields: ctx, userID, roles
+func (_m *IOneLoginSDK) AssignRolesToUserWithContext(ctx context.Context, userID int, roles []int) (interface{}, error) {
+	ret := _m.Called(ctx, userID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignRolesToUserWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (interface{}, error)); ok {
+		return rf(ctx, userID, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) interface{}); ok {
+		r0 = rf(ctx, userID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, userID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_AssignRolesToUserWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignRolesToUserWithContext'
+type IOneLoginSDK_AssignRolesToUserWithContext_Call struct {
+	*mock.Call
+}
+
+// AssignRolesToUserWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - roles []int
+func (_e *IOneLoginSDK_Expecter) AssignRolesToUserWithContext(ctx interface{}, userID interface{}, roles interface{}) *IOneLoginSDK_AssignRolesToUserWithContext_Call {
+	return &IOneLoginSDK_AssignRolesToUserWithContext_Call{Call: _e.mock.On("AssignRolesToUserWithContext", ctx, userID, roles)}
+}
+
+func (_c *IOneLoginSDK_AssignRolesToUserWithContext_Call) Run(run func(ctx context.Context, userID int, roles []int)) *IOneLoginSDK_AssignRolesToUserWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_AssignRolesToUserWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_AssignRolesToUserWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_AssignRolesToUserWithContext_Call) RunAndReturn(run func(context.Context, int, []int) (interface{}, error)) *IOneLoginSDK_AssignRolesToUserWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AssignUsersToPrivilege provides a mock function with given fields: privilegeID, userIds
 func (_m *IOneLoginSDK) AssignUsersToPrivilege(privilegeID string, userIds []int) (interface{}, error) {
 	ret := _m.Called(privilegeID, userIds)
@@ -373,6 +676,66 @@ func (_c *IOneLoginSDK_AssignUsersToPrivilege_Call) RunAndReturn(run func(string
 	return _c
 }
 
+// AssignUsersToPrivilegeWithContext provides a mock function with given fields: ctx, privilegeID, userIds
+func (_m *IOneLoginSDK) AssignUsersToPrivilegeWithContext(ctx context.Context, privilegeID string, userIds []int) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignUsersToPrivilegeWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int) (interface{}, error)); ok {
+		return rf(ctx, privilegeID, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []int) interface{}); ok {
+		r0 = rf(ctx, privilegeID, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []int) error); ok {
+		r1 = rf(ctx, privilegeID, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignUsersToPrivilegeWithContext'
+type IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call struct {
+	*mock.Call
+}
+
+// AssignUsersToPrivilegeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+//   - userIds []int
+func (_e *IOneLoginSDK_Expecter) AssignUsersToPrivilegeWithContext(ctx interface{}, privilegeID interface{}, userIds interface{}) *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call {
+	return &IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call{Call: _e.mock.On("AssignUsersToPrivilegeWithContext", ctx, privilegeID, userIds)}
+}
+
+func (_c *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call) Run(run func(ctx context.Context, privilegeID string, userIds []int)) *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call) RunAndReturn(run func(context.Context, string, []int) (interface{}, error)) *IOneLoginSDK_AssignUsersToPrivilegeWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BulkSortMappings provides a mock function with given fields: mappingIDs
 func (_m *IOneLoginSDK) BulkSortMappings(mappingIDs []int) (interface{}, error) {
 	ret := _m.Called(mappingIDs)
@@ -431,29 +794,29 @@ func (_c *IOneLoginSDK_BulkSortMappings_Call) RunAndReturn(run func([]int) (inte
 	return _c
 }
 
-// CreateApp provides a mock function with given fields: app
-func (_m *IOneLoginSDK) CreateApp(app models.App) (interface{}, error) {
-	ret := _m.Called(app)
+// BulkSortMappingsWithContext provides a mock function with given fields: ctx, mappingIDs
+func (_m *IOneLoginSDK) BulkSortMappingsWithContext(ctx context.Context, mappingIDs []int) (interface{}, error) {
+	ret := _m.Called(ctx, mappingIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateApp")
+		panic("no return value specified for BulkSortMappingsWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.App) (interface{}, error)); ok {
-		return rf(app)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (interface{}, error)); ok {
+		return rf(ctx, mappingIDs)
 	}
-	if rf, ok := ret.Get(0).(func(models.App) interface{}); ok {
-		r0 = rf(app)
+	if rf, ok := ret.Get(0).(func(context.Context, []int) interface{}); ok {
+		r0 = rf(ctx, mappingIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.App) error); ok {
-		r1 = rf(app)
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, mappingIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -461,116 +824,104 @@ func (_m *IOneLoginSDK) CreateApp(app models.App) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateApp'
-type IOneLoginSDK_CreateApp_Call struct {
+// IOneLoginSDK_BulkSortMappingsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkSortMappingsWithContext'
+type IOneLoginSDK_BulkSortMappingsWithContext_Call struct {
 	*mock.Call
 }
 
-// CreateApp is a helper method to define mock.On call
-//   - app models.App
-func (_e *IOneLoginSDK_Expecter) CreateApp(app interface{}) *IOneLoginSDK_CreateApp_Call {
-	return &IOneLoginSDK_CreateApp_Call{Call: _e.mock.On("CreateApp", app)}
+// BulkSortMappingsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingIDs []int
+func (_e *IOneLoginSDK_Expecter) BulkSortMappingsWithContext(ctx interface{}, mappingIDs interface{}) *IOneLoginSDK_BulkSortMappingsWithContext_Call {
+	return &IOneLoginSDK_BulkSortMappingsWithContext_Call{Call: _e.mock.On("BulkSortMappingsWithContext", ctx, mappingIDs)}
 }
 
-func (_c *IOneLoginSDK_CreateApp_Call) Run(run func(app models.App)) *IOneLoginSDK_CreateApp_Call {
+func (_c *IOneLoginSDK_BulkSortMappingsWithContext_Call) Run(run func(ctx context.Context, mappingIDs []int)) *IOneLoginSDK_BulkSortMappingsWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.App))
+		run(args[0].(context.Context), args[1].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateApp_Call {
+func (_c *IOneLoginSDK_BulkSortMappingsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_BulkSortMappingsWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateApp_Call) RunAndReturn(run func(models.App) (interface{}, error)) *IOneLoginSDK_CreateApp_Call {
+func (_c *IOneLoginSDK_BulkSortMappingsWithContext_Call) RunAndReturn(run func(context.Context, []int) (interface{}, error)) *IOneLoginSDK_BulkSortMappingsWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateAppRule provides a mock function with given fields: id, appRule
-func (_m *IOneLoginSDK) CreateAppRule(id int, appRule models.AppRule) (interface{}, error) {
-	ret := _m.Called(id, appRule)
+// Close provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAppRule")
-	}
-
-	var r0 interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.AppRule) (interface{}, error)); ok {
-		return rf(id, appRule)
-	}
-	if rf, ok := ret.Get(0).(func(int, models.AppRule) interface{}); ok {
-		r0 = rf(id, appRule)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
-		}
+		panic("no return value specified for Close")
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.AppRule) error); ok {
-		r1 = rf(id, appRule)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// IOneLoginSDK_CreateAppRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAppRule'
-type IOneLoginSDK_CreateAppRule_Call struct {
+// IOneLoginSDK_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type IOneLoginSDK_Close_Call struct {
 	*mock.Call
 }
 
-// CreateAppRule is a helper method to define mock.On call
-//   - id int
-//   - appRule models.AppRule
-func (_e *IOneLoginSDK_Expecter) CreateAppRule(id interface{}, appRule interface{}) *IOneLoginSDK_CreateAppRule_Call {
-	return &IOneLoginSDK_CreateAppRule_Call{Call: _e.mock.On("CreateAppRule", id, appRule)}
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) Close(ctx interface{}) *IOneLoginSDK_Close_Call {
+	return &IOneLoginSDK_Close_Call{Call: _e.mock.On("Close", ctx)}
 }
 
-func (_c *IOneLoginSDK_CreateAppRule_Call) Run(run func(id int, appRule models.AppRule)) *IOneLoginSDK_CreateAppRule_Call {
+func (_c *IOneLoginSDK_Close_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_Close_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.AppRule))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAppRule_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAppRule_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *IOneLoginSDK_Close_Call) Return(_a0 error) *IOneLoginSDK_Close_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAppRule_Call) RunAndReturn(run func(int, models.AppRule) (interface{}, error)) *IOneLoginSDK_CreateAppRule_Call {
+func (_c *IOneLoginSDK_Close_Call) RunAndReturn(run func(context.Context) error) *IOneLoginSDK_Close_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateAuthServer provides a mock function with given fields: authServer
-func (_m *IOneLoginSDK) CreateAuthServer(authServer *models.AuthServer) (interface{}, error) {
-	ret := _m.Called(authServer)
+// CreateApp provides a mock function with given fields: app
+func (_m *IOneLoginSDK) CreateApp(app models.App) (interface{}, error) {
+	ret := _m.Called(app)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAuthServer")
+		panic("no return value specified for CreateApp")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*models.AuthServer) (interface{}, error)); ok {
-		return rf(authServer)
+	if rf, ok := ret.Get(0).(func(models.App) (interface{}, error)); ok {
+		return rf(app)
 	}
-	if rf, ok := ret.Get(0).(func(*models.AuthServer) interface{}); ok {
-		r0 = rf(authServer)
+	if rf, ok := ret.Get(0).(func(models.App) interface{}); ok {
+		r0 = rf(app)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*models.AuthServer) error); ok {
-		r1 = rf(authServer)
+	if rf, ok := ret.Get(1).(func(models.App) error); ok {
+		r1 = rf(app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -578,57 +929,57 @@ func (_m *IOneLoginSDK) CreateAuthServer(authServer *models.AuthServer) (interfa
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateAuthServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServer'
-type IOneLoginSDK_CreateAuthServer_Call struct {
+// IOneLoginSDK_CreateApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateApp'
+type IOneLoginSDK_CreateApp_Call struct {
 	*mock.Call
 }
 
-// CreateAuthServer is a helper method to define mock.On call
-//   - authServer *models.AuthServer
-func (_e *IOneLoginSDK_Expecter) CreateAuthServer(authServer interface{}) *IOneLoginSDK_CreateAuthServer_Call {
-	return &IOneLoginSDK_CreateAuthServer_Call{Call: _e.mock.On("CreateAuthServer", authServer)}
+// CreateApp is a helper method to define mock.On call
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) CreateApp(app interface{}) *IOneLoginSDK_CreateApp_Call {
+	return &IOneLoginSDK_CreateApp_Call{Call: _e.mock.On("CreateApp", app)}
 }
 
-func (_c *IOneLoginSDK_CreateAuthServer_Call) Run(run func(authServer *models.AuthServer)) *IOneLoginSDK_CreateAuthServer_Call {
+func (_c *IOneLoginSDK_CreateApp_Call) Run(run func(app models.App)) *IOneLoginSDK_CreateApp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*models.AuthServer))
+		run(args[0].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAuthServer_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServer_Call {
+func (_c *IOneLoginSDK_CreateApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateApp_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAuthServer_Call) RunAndReturn(run func(*models.AuthServer) (interface{}, error)) *IOneLoginSDK_CreateAuthServer_Call {
+func (_c *IOneLoginSDK_CreateApp_Call) RunAndReturn(run func(models.App) (interface{}, error)) *IOneLoginSDK_CreateApp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateAuthServerClaim provides a mock function with given fields: id, claim
-func (_m *IOneLoginSDK) CreateAuthServerClaim(id int, claim models.AccessTokenClaim) (interface{}, error) {
-	ret := _m.Called(id, claim)
+// CreateAppRule provides a mock function with given fields: id, appRule
+func (_m *IOneLoginSDK) CreateAppRule(id int, appRule models.AppRule) (interface{}, error) {
+	ret := _m.Called(id, appRule)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAuthServerClaim")
+		panic("no return value specified for CreateAppRule")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.AccessTokenClaim) (interface{}, error)); ok {
-		return rf(id, claim)
+	if rf, ok := ret.Get(0).(func(int, models.AppRule) (interface{}, error)); ok {
+		return rf(id, appRule)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.AccessTokenClaim) interface{}); ok {
-		r0 = rf(id, claim)
+	if rf, ok := ret.Get(0).(func(int, models.AppRule) interface{}); ok {
+		r0 = rf(id, appRule)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.AccessTokenClaim) error); ok {
-		r1 = rf(id, claim)
+	if rf, ok := ret.Get(1).(func(int, models.AppRule) error); ok {
+		r1 = rf(id, appRule)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -636,58 +987,58 @@ func (_m *IOneLoginSDK) CreateAuthServerClaim(id int, claim models.AccessTokenCl
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateAuthServerClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerClaim'
-type IOneLoginSDK_CreateAuthServerClaim_Call struct {
+// IOneLoginSDK_CreateAppRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAppRule'
+type IOneLoginSDK_CreateAppRule_Call struct {
 	*mock.Call
 }
 
-// CreateAuthServerClaim is a helper method to define mock.On call
+// CreateAppRule is a helper method to define mock.On call
 //   - id int
-//   - claim models.AccessTokenClaim
-func (_e *IOneLoginSDK_Expecter) CreateAuthServerClaim(id interface{}, claim interface{}) *IOneLoginSDK_CreateAuthServerClaim_Call {
-	return &IOneLoginSDK_CreateAuthServerClaim_Call{Call: _e.mock.On("CreateAuthServerClaim", id, claim)}
+//   - appRule models.AppRule
+func (_e *IOneLoginSDK_Expecter) CreateAppRule(id interface{}, appRule interface{}) *IOneLoginSDK_CreateAppRule_Call {
+	return &IOneLoginSDK_CreateAppRule_Call{Call: _e.mock.On("CreateAppRule", id, appRule)}
 }
 
-func (_c *IOneLoginSDK_CreateAuthServerClaim_Call) Run(run func(id int, claim models.AccessTokenClaim)) *IOneLoginSDK_CreateAuthServerClaim_Call {
+func (_c *IOneLoginSDK_CreateAppRule_Call) Run(run func(id int, appRule models.AppRule)) *IOneLoginSDK_CreateAppRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.AccessTokenClaim))
+		run(args[0].(int), args[1].(models.AppRule))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAuthServerClaim_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerClaim_Call {
+func (_c *IOneLoginSDK_CreateAppRule_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAppRule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAuthServerClaim_Call) RunAndReturn(run func(int, models.AccessTokenClaim) (interface{}, error)) *IOneLoginSDK_CreateAuthServerClaim_Call {
+func (_c *IOneLoginSDK_CreateAppRule_Call) RunAndReturn(run func(int, models.AppRule) (interface{}, error)) *IOneLoginSDK_CreateAppRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateAuthServerScope provides a mock function with given fields: id, scope
-func (_m *IOneLoginSDK) CreateAuthServerScope(id int, scope models.Scope) (interface{}, error) {
-	ret := _m.Called(id, scope)
+// CreateAppRuleWithContext provides a mock function with given fields: ctx, id, appRule
+func (_m *IOneLoginSDK) CreateAppRuleWithContext(ctx context.Context, id int, appRule models.AppRule) (interface{}, error) {
+	ret := _m.Called(ctx, id, appRule)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAuthServerScope")
+		panic("no return value specified for CreateAppRuleWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Scope) (interface{}, error)); ok {
-		return rf(id, scope)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.AppRule) (interface{}, error)); ok {
+		return rf(ctx, id, appRule)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Scope) interface{}); ok {
-		r0 = rf(id, scope)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.AppRule) interface{}); ok {
+		r0 = rf(ctx, id, appRule)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Scope) error); ok {
-		r1 = rf(id, scope)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.AppRule) error); ok {
+		r1 = rf(ctx, id, appRule)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -695,58 +1046,59 @@ func (_m *IOneLoginSDK) CreateAuthServerScope(id int, scope models.Scope) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateAuthServerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerScope'
-type IOneLoginSDK_CreateAuthServerScope_Call struct {
+// IOneLoginSDK_CreateAppRuleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAppRuleWithContext'
+type IOneLoginSDK_CreateAppRuleWithContext_Call struct {
 	*mock.Call
 }
 
-// CreateAuthServerScope is a helper method to define mock.On call
+// CreateAppRuleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-//   - scope models.Scope
-func (_e *IOneLoginSDK_Expecter) CreateAuthServerScope(id interface{}, scope interface{}) *IOneLoginSDK_CreateAuthServerScope_Call {
-	return &IOneLoginSDK_CreateAuthServerScope_Call{Call: _e.mock.On("CreateAuthServerScope", id, scope)}
+//   - appRule models.AppRule
+func (_e *IOneLoginSDK_Expecter) CreateAppRuleWithContext(ctx interface{}, id interface{}, appRule interface{}) *IOneLoginSDK_CreateAppRuleWithContext_Call {
+	return &IOneLoginSDK_CreateAppRuleWithContext_Call{Call: _e.mock.On("CreateAppRuleWithContext", ctx, id, appRule)}
 }
 
-func (_c *IOneLoginSDK_CreateAuthServerScope_Call) Run(run func(id int, scope models.Scope)) *IOneLoginSDK_CreateAuthServerScope_Call {
+func (_c *IOneLoginSDK_CreateAppRuleWithContext_Call) Run(run func(ctx context.Context, id int, appRule models.AppRule)) *IOneLoginSDK_CreateAppRuleWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Scope))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.AppRule))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAuthServerScope_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerScope_Call {
+func (_c *IOneLoginSDK_CreateAppRuleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAppRuleWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateAuthServerScope_Call) RunAndReturn(run func(int, models.Scope) (interface{}, error)) *IOneLoginSDK_CreateAuthServerScope_Call {
+func (_c *IOneLoginSDK_CreateAppRuleWithContext_Call) RunAndReturn(run func(context.Context, int, models.AppRule) (interface{}, error)) *IOneLoginSDK_CreateAppRuleWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateClientApp provides a mock function with given fields: id, clientApp
-func (_m *IOneLoginSDK) CreateClientApp(id int, clientApp models.ClientApp) (interface{}, error) {
-	ret := _m.Called(id, clientApp)
+// CreateAppTyped provides a mock function with given fields: app
+func (_m *IOneLoginSDK) CreateAppTyped(app models.App) (*models.Response[models.App], error) {
+	ret := _m.Called(app)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateClientApp")
+		panic("no return value specified for CreateAppTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.App]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.ClientApp) (interface{}, error)); ok {
-		return rf(id, clientApp)
+	if rf, ok := ret.Get(0).(func(models.App) (*models.Response[models.App], error)); ok {
+		return rf(app)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.ClientApp) interface{}); ok {
-		r0 = rf(id, clientApp)
+	if rf, ok := ret.Get(0).(func(models.App) *models.Response[models.App]); ok {
+		r0 = rf(app)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.App])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.ClientApp) error); ok {
-		r1 = rf(id, clientApp)
+	if rf, ok := ret.Get(1).(func(models.App) error); ok {
+		r1 = rf(app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -754,58 +1106,57 @@ func (_m *IOneLoginSDK) CreateClientApp(id int, clientApp models.ClientApp) (int
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateClientApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClientApp'
-type IOneLoginSDK_CreateClientApp_Call struct {
+// IOneLoginSDK_CreateAppTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAppTyped'
+type IOneLoginSDK_CreateAppTyped_Call struct {
 	*mock.Call
 }
 
-// CreateClientApp is a helper method to define mock.On call
-//   - id int
-//   - clientApp models.ClientApp
-func (_e *IOneLoginSDK_Expecter) CreateClientApp(id interface{}, clientApp interface{}) *IOneLoginSDK_CreateClientApp_Call {
-	return &IOneLoginSDK_CreateClientApp_Call{Call: _e.mock.On("CreateClientApp", id, clientApp)}
+// CreateAppTyped is a helper method to define mock.On call
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) CreateAppTyped(app interface{}) *IOneLoginSDK_CreateAppTyped_Call {
+	return &IOneLoginSDK_CreateAppTyped_Call{Call: _e.mock.On("CreateAppTyped", app)}
 }
 
-func (_c *IOneLoginSDK_CreateClientApp_Call) Run(run func(id int, clientApp models.ClientApp)) *IOneLoginSDK_CreateClientApp_Call {
+func (_c *IOneLoginSDK_CreateAppTyped_Call) Run(run func(app models.App)) *IOneLoginSDK_CreateAppTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.ClientApp))
+		run(args[0].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateClientApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateClientApp_Call {
+func (_c *IOneLoginSDK_CreateAppTyped_Call) Return(_a0 *models.Response[models.App], _a1 error) *IOneLoginSDK_CreateAppTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateClientApp_Call) RunAndReturn(run func(int, models.ClientApp) (interface{}, error)) *IOneLoginSDK_CreateClientApp_Call {
+func (_c *IOneLoginSDK_CreateAppTyped_Call) RunAndReturn(run func(models.App) (*models.Response[models.App], error)) *IOneLoginSDK_CreateAppTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEnvironmentVariable provides a mock function with given fields: name, value
-func (_m *IOneLoginSDK) CreateEnvironmentVariable(name string, value string) (interface{}, error) {
-	ret := _m.Called(name, value)
+// CreateAppTypedWithContext provides a mock function with given fields: ctx, app
+func (_m *IOneLoginSDK) CreateAppTypedWithContext(ctx context.Context, app models.App) (*models.Response[models.App], error) {
+	ret := _m.Called(ctx, app)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateEnvironmentVariable")
+		panic("no return value specified for CreateAppTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.App]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (interface{}, error)); ok {
-		return rf(name, value)
+	if rf, ok := ret.Get(0).(func(context.Context, models.App) (*models.Response[models.App], error)); ok {
+		return rf(ctx, app)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) interface{}); ok {
-		r0 = rf(name, value)
+	if rf, ok := ret.Get(0).(func(context.Context, models.App) *models.Response[models.App]); ok {
+		r0 = rf(ctx, app)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.App])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(name, value)
+	if rf, ok := ret.Get(1).(func(context.Context, models.App) error); ok {
+		r1 = rf(ctx, app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -813,58 +1164,58 @@ func (_m *IOneLoginSDK) CreateEnvironmentVariable(name string, value string) (in
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnvironmentVariable'
-type IOneLoginSDK_CreateEnvironmentVariable_Call struct {
+// IOneLoginSDK_CreateAppTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAppTypedWithContext'
+type IOneLoginSDK_CreateAppTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// CreateEnvironmentVariable is a helper method to define mock.On call
-//   - name string
-//   - value string
-func (_e *IOneLoginSDK_Expecter) CreateEnvironmentVariable(name interface{}, value interface{}) *IOneLoginSDK_CreateEnvironmentVariable_Call {
-	return &IOneLoginSDK_CreateEnvironmentVariable_Call{Call: _e.mock.On("CreateEnvironmentVariable", name, value)}
+// CreateAppTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) CreateAppTypedWithContext(ctx interface{}, app interface{}) *IOneLoginSDK_CreateAppTypedWithContext_Call {
+	return &IOneLoginSDK_CreateAppTypedWithContext_Call{Call: _e.mock.On("CreateAppTypedWithContext", ctx, app)}
 }
 
-func (_c *IOneLoginSDK_CreateEnvironmentVariable_Call) Run(run func(name string, value string)) *IOneLoginSDK_CreateEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_CreateAppTypedWithContext_Call) Run(run func(ctx context.Context, app models.App)) *IOneLoginSDK_CreateAppTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(context.Context), args[1].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_CreateAppTypedWithContext_Call) Return(_a0 *models.Response[models.App], _a1 error) *IOneLoginSDK_CreateAppTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateEnvironmentVariable_Call) RunAndReturn(run func(string, string) (interface{}, error)) *IOneLoginSDK_CreateEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_CreateAppTypedWithContext_Call) RunAndReturn(run func(context.Context, models.App) (*models.Response[models.App], error)) *IOneLoginSDK_CreateAppTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateHook provides a mock function with given fields: hook
-func (_m *IOneLoginSDK) CreateHook(hook models.SmartHook) (interface{}, error) {
-	ret := _m.Called(hook)
+// CreateAppWithContext provides a mock function with given fields: ctx, app
+func (_m *IOneLoginSDK) CreateAppWithContext(ctx context.Context, app models.App) (interface{}, error) {
+	ret := _m.Called(ctx, app)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateHook")
+		panic("no return value specified for CreateAppWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.SmartHook) (interface{}, error)); ok {
-		return rf(hook)
+	if rf, ok := ret.Get(0).(func(context.Context, models.App) (interface{}, error)); ok {
+		return rf(ctx, app)
 	}
-	if rf, ok := ret.Get(0).(func(models.SmartHook) interface{}); ok {
-		r0 = rf(hook)
+	if rf, ok := ret.Get(0).(func(context.Context, models.App) interface{}); ok {
+		r0 = rf(ctx, app)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.SmartHook) error); ok {
-		r1 = rf(hook)
+	if rf, ok := ret.Get(1).(func(context.Context, models.App) error); ok {
+		r1 = rf(ctx, app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -872,57 +1223,58 @@ func (_m *IOneLoginSDK) CreateHook(hook models.SmartHook) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHook'
-type IOneLoginSDK_CreateHook_Call struct {
+// IOneLoginSDK_CreateAppWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAppWithContext'
+type IOneLoginSDK_CreateAppWithContext_Call struct {
 	*mock.Call
 }
 
-// CreateHook is a helper method to define mock.On call
-//   - hook models.SmartHook
-func (_e *IOneLoginSDK_Expecter) CreateHook(hook interface{}) *IOneLoginSDK_CreateHook_Call {
-	return &IOneLoginSDK_CreateHook_Call{Call: _e.mock.On("CreateHook", hook)}
+// CreateAppWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) CreateAppWithContext(ctx interface{}, app interface{}) *IOneLoginSDK_CreateAppWithContext_Call {
+	return &IOneLoginSDK_CreateAppWithContext_Call{Call: _e.mock.On("CreateAppWithContext", ctx, app)}
 }
 
-func (_c *IOneLoginSDK_CreateHook_Call) Run(run func(hook models.SmartHook)) *IOneLoginSDK_CreateHook_Call {
+func (_c *IOneLoginSDK_CreateAppWithContext_Call) Run(run func(ctx context.Context, app models.App)) *IOneLoginSDK_CreateAppWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.SmartHook))
+		run(args[0].(context.Context), args[1].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateHook_Call {
+func (_c *IOneLoginSDK_CreateAppWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAppWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateHook_Call) RunAndReturn(run func(models.SmartHook) (interface{}, error)) *IOneLoginSDK_CreateHook_Call {
+func (_c *IOneLoginSDK_CreateAppWithContext_Call) RunAndReturn(run func(context.Context, models.App) (interface{}, error)) *IOneLoginSDK_CreateAppWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateMapping provides a mock function with given fields: mapping
-func (_m *IOneLoginSDK) CreateMapping(mapping models.UserMapping) (interface{}, error) {
-	ret := _m.Called(mapping)
+// CreateAuthServer provides a mock function with given fields: authServer
+func (_m *IOneLoginSDK) CreateAuthServer(authServer *models.AuthServer) (interface{}, error) {
+	ret := _m.Called(authServer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMapping")
+		panic("no return value specified for CreateAuthServer")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.UserMapping) (interface{}, error)); ok {
-		return rf(mapping)
+	if rf, ok := ret.Get(0).(func(*models.AuthServer) (interface{}, error)); ok {
+		return rf(authServer)
 	}
-	if rf, ok := ret.Get(0).(func(models.UserMapping) interface{}); ok {
-		r0 = rf(mapping)
+	if rf, ok := ret.Get(0).(func(*models.AuthServer) interface{}); ok {
+		r0 = rf(authServer)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.UserMapping) error); ok {
-		r1 = rf(mapping)
+	if rf, ok := ret.Get(1).(func(*models.AuthServer) error); ok {
+		r1 = rf(authServer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -930,57 +1282,57 @@ func (_m *IOneLoginSDK) CreateMapping(mapping models.UserMapping) (interface{},
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMapping'
-type IOneLoginSDK_CreateMapping_Call struct {
+// IOneLoginSDK_CreateAuthServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServer'
+type IOneLoginSDK_CreateAuthServer_Call struct {
 	*mock.Call
 }
 
-// CreateMapping is a helper method to define mock.On call
-//   - mapping models.UserMapping
-func (_e *IOneLoginSDK_Expecter) CreateMapping(mapping interface{}) *IOneLoginSDK_CreateMapping_Call {
-	return &IOneLoginSDK_CreateMapping_Call{Call: _e.mock.On("CreateMapping", mapping)}
+// CreateAuthServer is a helper method to define mock.On call
+//   - authServer *models.AuthServer
+func (_e *IOneLoginSDK_Expecter) CreateAuthServer(authServer interface{}) *IOneLoginSDK_CreateAuthServer_Call {
+	return &IOneLoginSDK_CreateAuthServer_Call{Call: _e.mock.On("CreateAuthServer", authServer)}
 }
 
-func (_c *IOneLoginSDK_CreateMapping_Call) Run(run func(mapping models.UserMapping)) *IOneLoginSDK_CreateMapping_Call {
+func (_c *IOneLoginSDK_CreateAuthServer_Call) Run(run func(authServer *models.AuthServer)) *IOneLoginSDK_CreateAuthServer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.UserMapping))
+		run(args[0].(*models.AuthServer))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateMapping_Call {
+func (_c *IOneLoginSDK_CreateAuthServer_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServer_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateMapping_Call) RunAndReturn(run func(models.UserMapping) (interface{}, error)) *IOneLoginSDK_CreateMapping_Call {
+func (_c *IOneLoginSDK_CreateAuthServer_Call) RunAndReturn(run func(*models.AuthServer) (interface{}, error)) *IOneLoginSDK_CreateAuthServer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePrivilege provides a mock function with given fields: privilege
-func (_m *IOneLoginSDK) CreatePrivilege(privilege models.Privilege) (interface{}, error) {
-	ret := _m.Called(privilege)
+// CreateAuthServerClaim provides a mock function with given fields: id, claim
+func (_m *IOneLoginSDK) CreateAuthServerClaim(id int, claim models.AccessTokenClaim) (interface{}, error) {
+	ret := _m.Called(id, claim)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePrivilege")
+		panic("no return value specified for CreateAuthServerClaim")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Privilege) (interface{}, error)); ok {
-		return rf(privilege)
+	if rf, ok := ret.Get(0).(func(int, models.AccessTokenClaim) (interface{}, error)); ok {
+		return rf(id, claim)
 	}
-	if rf, ok := ret.Get(0).(func(models.Privilege) interface{}); ok {
-		r0 = rf(privilege)
+	if rf, ok := ret.Get(0).(func(int, models.AccessTokenClaim) interface{}); ok {
+		r0 = rf(id, claim)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Privilege) error); ok {
-		r1 = rf(privilege)
+	if rf, ok := ret.Get(1).(func(int, models.AccessTokenClaim) error); ok {
+		r1 = rf(id, claim)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -988,57 +1340,58 @@ func (_m *IOneLoginSDK) CreatePrivilege(privilege models.Privilege) (interface{}
 	return r0, r1
 }
 
-// IOneLoginSDK_CreatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePrivilege'
-type IOneLoginSDK_CreatePrivilege_Call struct {
+// IOneLoginSDK_CreateAuthServerClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerClaim'
+type IOneLoginSDK_CreateAuthServerClaim_Call struct {
 	*mock.Call
 }
 
-// CreatePrivilege is a helper method to define mock.On call
-//   - privilege models.Privilege
-func (_e *IOneLoginSDK_Expecter) CreatePrivilege(privilege interface{}) *IOneLoginSDK_CreatePrivilege_Call {
-	return &IOneLoginSDK_CreatePrivilege_Call{Call: _e.mock.On("CreatePrivilege", privilege)}
+// CreateAuthServerClaim is a helper method to define mock.On call
+//   - id int
+//   - claim models.AccessTokenClaim
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerClaim(id interface{}, claim interface{}) *IOneLoginSDK_CreateAuthServerClaim_Call {
+	return &IOneLoginSDK_CreateAuthServerClaim_Call{Call: _e.mock.On("CreateAuthServerClaim", id, claim)}
 }
 
-func (_c *IOneLoginSDK_CreatePrivilege_Call) Run(run func(privilege models.Privilege)) *IOneLoginSDK_CreatePrivilege_Call {
+func (_c *IOneLoginSDK_CreateAuthServerClaim_Call) Run(run func(id int, claim models.AccessTokenClaim)) *IOneLoginSDK_CreateAuthServerClaim_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Privilege))
+		run(args[0].(int), args[1].(models.AccessTokenClaim))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreatePrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreatePrivilege_Call {
+func (_c *IOneLoginSDK_CreateAuthServerClaim_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerClaim_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreatePrivilege_Call) RunAndReturn(run func(models.Privilege) (interface{}, error)) *IOneLoginSDK_CreatePrivilege_Call {
+func (_c *IOneLoginSDK_CreateAuthServerClaim_Call) RunAndReturn(run func(int, models.AccessTokenClaim) (interface{}, error)) *IOneLoginSDK_CreateAuthServerClaim_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRole provides a mock function with given fields: role
-func (_m *IOneLoginSDK) CreateRole(role *models.Role) (interface{}, error) {
-	ret := _m.Called(role)
+// CreateAuthServerClaimWithContext provides a mock function with given fields: ctx, id, claim
+func (_m *IOneLoginSDK) CreateAuthServerClaimWithContext(ctx context.Context, id int, claim models.AccessTokenClaim) (interface{}, error) {
+	ret := _m.Called(ctx, id, claim)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRole")
+		panic("no return value specified for CreateAuthServerClaimWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*models.Role) (interface{}, error)); ok {
-		return rf(role)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.AccessTokenClaim) (interface{}, error)); ok {
+		return rf(ctx, id, claim)
 	}
-	if rf, ok := ret.Get(0).(func(*models.Role) interface{}); ok {
-		r0 = rf(role)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.AccessTokenClaim) interface{}); ok {
+		r0 = rf(ctx, id, claim)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*models.Role) error); ok {
-		r1 = rf(role)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.AccessTokenClaim) error); ok {
+		r1 = rf(ctx, id, claim)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1046,57 +1399,59 @@ func (_m *IOneLoginSDK) CreateRole(role *models.Role) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
-type IOneLoginSDK_CreateRole_Call struct {
+// IOneLoginSDK_CreateAuthServerClaimWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerClaimWithContext'
+type IOneLoginSDK_CreateAuthServerClaimWithContext_Call struct {
 	*mock.Call
 }
 
-// CreateRole is a helper method to define mock.On call
-//   - role *models.Role
-func (_e *IOneLoginSDK_Expecter) CreateRole(role interface{}) *IOneLoginSDK_CreateRole_Call {
-	return &IOneLoginSDK_CreateRole_Call{Call: _e.mock.On("CreateRole", role)}
+// CreateAuthServerClaimWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - claim models.AccessTokenClaim
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerClaimWithContext(ctx interface{}, id interface{}, claim interface{}) *IOneLoginSDK_CreateAuthServerClaimWithContext_Call {
+	return &IOneLoginSDK_CreateAuthServerClaimWithContext_Call{Call: _e.mock.On("CreateAuthServerClaimWithContext", ctx, id, claim)}
 }
 
-func (_c *IOneLoginSDK_CreateRole_Call) Run(run func(role *models.Role)) *IOneLoginSDK_CreateRole_Call {
+func (_c *IOneLoginSDK_CreateAuthServerClaimWithContext_Call) Run(run func(ctx context.Context, id int, claim models.AccessTokenClaim)) *IOneLoginSDK_CreateAuthServerClaimWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*models.Role))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.AccessTokenClaim))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateRole_Call {
+func (_c *IOneLoginSDK_CreateAuthServerClaimWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerClaimWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateRole_Call) RunAndReturn(run func(*models.Role) (interface{}, error)) *IOneLoginSDK_CreateRole_Call {
+func (_c *IOneLoginSDK_CreateAuthServerClaimWithContext_Call) RunAndReturn(run func(context.Context, int, models.AccessTokenClaim) (interface{}, error)) *IOneLoginSDK_CreateAuthServerClaimWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: user
-func (_m *IOneLoginSDK) CreateUser(user models.User) (interface{}, error) {
-	ret := _m.Called(user)
+// CreateAuthServerScope provides a mock function with given fields: id, scope
+func (_m *IOneLoginSDK) CreateAuthServerScope(id int, scope models.Scope) (interface{}, error) {
+	ret := _m.Called(id, scope)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for CreateAuthServerScope")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.User) (interface{}, error)); ok {
-		return rf(user)
+	if rf, ok := ret.Get(0).(func(int, models.Scope) (interface{}, error)); ok {
+		return rf(id, scope)
 	}
-	if rf, ok := ret.Get(0).(func(models.User) interface{}); ok {
-		r0 = rf(user)
+	if rf, ok := ret.Get(0).(func(int, models.Scope) interface{}); ok {
+		r0 = rf(id, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.User) error); ok {
-		r1 = rf(user)
+	if rf, ok := ret.Get(1).(func(int, models.Scope) error); ok {
+		r1 = rf(id, scope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1104,57 +1459,58 @@ func (_m *IOneLoginSDK) CreateUser(user models.User) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type IOneLoginSDK_CreateUser_Call struct {
+// IOneLoginSDK_CreateAuthServerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerScope'
+type IOneLoginSDK_CreateAuthServerScope_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
-//   - user models.User
-func (_e *IOneLoginSDK_Expecter) CreateUser(user interface{}) *IOneLoginSDK_CreateUser_Call {
-	return &IOneLoginSDK_CreateUser_Call{Call: _e.mock.On("CreateUser", user)}
+// CreateAuthServerScope is a helper method to define mock.On call
+//   - id int
+//   - scope models.Scope
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerScope(id interface{}, scope interface{}) *IOneLoginSDK_CreateAuthServerScope_Call {
+	return &IOneLoginSDK_CreateAuthServerScope_Call{Call: _e.mock.On("CreateAuthServerScope", id, scope)}
 }
 
-func (_c *IOneLoginSDK_CreateUser_Call) Run(run func(user models.User)) *IOneLoginSDK_CreateUser_Call {
+func (_c *IOneLoginSDK_CreateAuthServerScope_Call) Run(run func(id int, scope models.Scope)) *IOneLoginSDK_CreateAuthServerScope_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.User))
+		run(args[0].(int), args[1].(models.Scope))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateUser_Call {
+func (_c *IOneLoginSDK_CreateAuthServerScope_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerScope_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_CreateUser_Call) RunAndReturn(run func(models.User) (interface{}, error)) *IOneLoginSDK_CreateUser_Call {
+func (_c *IOneLoginSDK_CreateAuthServerScope_Call) RunAndReturn(run func(int, models.Scope) (interface{}, error)) *IOneLoginSDK_CreateAuthServerScope_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteApp provides a mock function with given fields: id
-func (_m *IOneLoginSDK) DeleteApp(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// CreateAuthServerScopeWithContext provides a mock function with given fields: ctx, id, scope
+func (_m *IOneLoginSDK) CreateAuthServerScopeWithContext(ctx context.Context, id int, scope models.Scope) (interface{}, error) {
+	ret := _m.Called(ctx, id, scope)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteApp")
+		panic("no return value specified for CreateAuthServerScopeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Scope) (interface{}, error)); ok {
+		return rf(ctx, id, scope)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Scope) interface{}); ok {
+		r0 = rf(ctx, id, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Scope) error); ok {
+		r1 = rf(ctx, id, scope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1162,57 +1518,59 @@ func (_m *IOneLoginSDK) DeleteApp(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApp'
-type IOneLoginSDK_DeleteApp_Call struct {
+// IOneLoginSDK_CreateAuthServerScopeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerScopeWithContext'
+type IOneLoginSDK_CreateAuthServerScopeWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteApp is a helper method to define mock.On call
+// CreateAuthServerScopeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-func (_e *IOneLoginSDK_Expecter) DeleteApp(id interface{}) *IOneLoginSDK_DeleteApp_Call {
-	return &IOneLoginSDK_DeleteApp_Call{Call: _e.mock.On("DeleteApp", id)}
+//   - scope models.Scope
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerScopeWithContext(ctx interface{}, id interface{}, scope interface{}) *IOneLoginSDK_CreateAuthServerScopeWithContext_Call {
+	return &IOneLoginSDK_CreateAuthServerScopeWithContext_Call{Call: _e.mock.On("CreateAuthServerScopeWithContext", ctx, id, scope)}
 }
 
-func (_c *IOneLoginSDK_DeleteApp_Call) Run(run func(id int)) *IOneLoginSDK_DeleteApp_Call {
+func (_c *IOneLoginSDK_CreateAuthServerScopeWithContext_Call) Run(run func(ctx context.Context, id int, scope models.Scope)) *IOneLoginSDK_CreateAuthServerScopeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Scope))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteApp_Call {
+func (_c *IOneLoginSDK_CreateAuthServerScopeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerScopeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteApp_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteApp_Call {
+func (_c *IOneLoginSDK_CreateAuthServerScopeWithContext_Call) RunAndReturn(run func(context.Context, int, models.Scope) (interface{}, error)) *IOneLoginSDK_CreateAuthServerScopeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAppRule provides a mock function with given fields: id, ruleID, queryParams
-func (_m *IOneLoginSDK) DeleteAppRule(id int, ruleID int, queryParams map[string]string) (interface{}, error) {
-	ret := _m.Called(id, ruleID, queryParams)
+// CreateAuthServerTyped provides a mock function with given fields: authServer
+func (_m *IOneLoginSDK) CreateAuthServerTyped(authServer *models.AuthServer) (*models.Response[models.AuthServer], error) {
+	ret := _m.Called(authServer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAppRule")
+		panic("no return value specified for CreateAuthServerTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.AuthServer]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, map[string]string) (interface{}, error)); ok {
-		return rf(id, ruleID, queryParams)
+	if rf, ok := ret.Get(0).(func(*models.AuthServer) (*models.Response[models.AuthServer], error)); ok {
+		return rf(authServer)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, map[string]string) interface{}); ok {
-		r0 = rf(id, ruleID, queryParams)
+	if rf, ok := ret.Get(0).(func(*models.AuthServer) *models.Response[models.AuthServer]); ok {
+		r0 = rf(authServer)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.AuthServer])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, map[string]string) error); ok {
-		r1 = rf(id, ruleID, queryParams)
+	if rf, ok := ret.Get(1).(func(*models.AuthServer) error); ok {
+		r1 = rf(authServer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1220,59 +1578,57 @@ func (_m *IOneLoginSDK) DeleteAppRule(id int, ruleID int, queryParams map[string
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteAppRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAppRule'
-type IOneLoginSDK_DeleteAppRule_Call struct {
+// IOneLoginSDK_CreateAuthServerTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerTyped'
+type IOneLoginSDK_CreateAuthServerTyped_Call struct {
 	*mock.Call
 }
 
-// DeleteAppRule is a helper method to define mock.On call
-//   - id int
-//   - ruleID int
-//   - queryParams map[string]string
-func (_e *IOneLoginSDK_Expecter) DeleteAppRule(id interface{}, ruleID interface{}, queryParams interface{}) *IOneLoginSDK_DeleteAppRule_Call {
-	return &IOneLoginSDK_DeleteAppRule_Call{Call: _e.mock.On("DeleteAppRule", id, ruleID, queryParams)}
+// CreateAuthServerTyped is a helper method to define mock.On call
+//   - authServer *models.AuthServer
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerTyped(authServer interface{}) *IOneLoginSDK_CreateAuthServerTyped_Call {
+	return &IOneLoginSDK_CreateAuthServerTyped_Call{Call: _e.mock.On("CreateAuthServerTyped", authServer)}
 }
 
-func (_c *IOneLoginSDK_DeleteAppRule_Call) Run(run func(id int, ruleID int, queryParams map[string]string)) *IOneLoginSDK_DeleteAppRule_Call {
+func (_c *IOneLoginSDK_CreateAuthServerTyped_Call) Run(run func(authServer *models.AuthServer)) *IOneLoginSDK_CreateAuthServerTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(map[string]string))
+		run(args[0].(*models.AuthServer))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAppRule_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAppRule_Call {
+func (_c *IOneLoginSDK_CreateAuthServerTyped_Call) Return(_a0 *models.Response[models.AuthServer], _a1 error) *IOneLoginSDK_CreateAuthServerTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAppRule_Call) RunAndReturn(run func(int, int, map[string]string) (interface{}, error)) *IOneLoginSDK_DeleteAppRule_Call {
+func (_c *IOneLoginSDK_CreateAuthServerTyped_Call) RunAndReturn(run func(*models.AuthServer) (*models.Response[models.AuthServer], error)) *IOneLoginSDK_CreateAuthServerTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAuthClaim provides a mock function with given fields: id, claimID
-func (_m *IOneLoginSDK) DeleteAuthClaim(id int, claimID int) (interface{}, error) {
-	ret := _m.Called(id, claimID)
+// CreateAuthServerTypedWithContext provides a mock function with given fields: ctx, authServer
+func (_m *IOneLoginSDK) CreateAuthServerTypedWithContext(ctx context.Context, authServer *models.AuthServer) (*models.Response[models.AuthServer], error) {
+	ret := _m.Called(ctx, authServer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAuthClaim")
+		panic("no return value specified for CreateAuthServerTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.AuthServer]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
-		return rf(id, claimID)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AuthServer) (*models.Response[models.AuthServer], error)); ok {
+		return rf(ctx, authServer)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
-		r0 = rf(id, claimID)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AuthServer) *models.Response[models.AuthServer]); ok {
+		r0 = rf(ctx, authServer)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.AuthServer])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(id, claimID)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.AuthServer) error); ok {
+		r1 = rf(ctx, authServer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1280,58 +1636,58 @@ func (_m *IOneLoginSDK) DeleteAuthClaim(id int, claimID int) (interface{}, error
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteAuthClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthClaim'
-type IOneLoginSDK_DeleteAuthClaim_Call struct {
+// IOneLoginSDK_CreateAuthServerTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerTypedWithContext'
+type IOneLoginSDK_CreateAuthServerTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteAuthClaim is a helper method to define mock.On call
-//   - id int
-//   - claimID int
-func (_e *IOneLoginSDK_Expecter) DeleteAuthClaim(id interface{}, claimID interface{}) *IOneLoginSDK_DeleteAuthClaim_Call {
-	return &IOneLoginSDK_DeleteAuthClaim_Call{Call: _e.mock.On("DeleteAuthClaim", id, claimID)}
+// CreateAuthServerTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authServer *models.AuthServer
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerTypedWithContext(ctx interface{}, authServer interface{}) *IOneLoginSDK_CreateAuthServerTypedWithContext_Call {
+	return &IOneLoginSDK_CreateAuthServerTypedWithContext_Call{Call: _e.mock.On("CreateAuthServerTypedWithContext", ctx, authServer)}
 }
 
-func (_c *IOneLoginSDK_DeleteAuthClaim_Call) Run(run func(id int, claimID int)) *IOneLoginSDK_DeleteAuthClaim_Call {
+func (_c *IOneLoginSDK_CreateAuthServerTypedWithContext_Call) Run(run func(ctx context.Context, authServer *models.AuthServer)) *IOneLoginSDK_CreateAuthServerTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(context.Context), args[1].(*models.AuthServer))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAuthClaim_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthClaim_Call {
+func (_c *IOneLoginSDK_CreateAuthServerTypedWithContext_Call) Return(_a0 *models.Response[models.AuthServer], _a1 error) *IOneLoginSDK_CreateAuthServerTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAuthClaim_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthClaim_Call {
+func (_c *IOneLoginSDK_CreateAuthServerTypedWithContext_Call) RunAndReturn(run func(context.Context, *models.AuthServer) (*models.Response[models.AuthServer], error)) *IOneLoginSDK_CreateAuthServerTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAuthServer provides a mock function with given fields: id
-func (_m *IOneLoginSDK) DeleteAuthServer(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// CreateAuthServerWithContext provides a mock function with given fields: ctx, authServer
+func (_m *IOneLoginSDK) CreateAuthServerWithContext(ctx context.Context, authServer *models.AuthServer) (interface{}, error) {
+	ret := _m.Called(ctx, authServer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAuthServer")
+		panic("no return value specified for CreateAuthServerWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AuthServer) (interface{}, error)); ok {
+		return rf(ctx, authServer)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.AuthServer) interface{}); ok {
+		r0 = rf(ctx, authServer)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.AuthServer) error); ok {
+		r1 = rf(ctx, authServer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1339,57 +1695,58 @@ func (_m *IOneLoginSDK) DeleteAuthServer(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteAuthServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthServer'
-type IOneLoginSDK_DeleteAuthServer_Call struct {
+// IOneLoginSDK_CreateAuthServerWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuthServerWithContext'
+type IOneLoginSDK_CreateAuthServerWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteAuthServer is a helper method to define mock.On call
-//   - id int
-func (_e *IOneLoginSDK_Expecter) DeleteAuthServer(id interface{}) *IOneLoginSDK_DeleteAuthServer_Call {
-	return &IOneLoginSDK_DeleteAuthServer_Call{Call: _e.mock.On("DeleteAuthServer", id)}
+// CreateAuthServerWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authServer *models.AuthServer
+func (_e *IOneLoginSDK_Expecter) CreateAuthServerWithContext(ctx interface{}, authServer interface{}) *IOneLoginSDK_CreateAuthServerWithContext_Call {
+	return &IOneLoginSDK_CreateAuthServerWithContext_Call{Call: _e.mock.On("CreateAuthServerWithContext", ctx, authServer)}
 }
 
-func (_c *IOneLoginSDK_DeleteAuthServer_Call) Run(run func(id int)) *IOneLoginSDK_DeleteAuthServer_Call {
+func (_c *IOneLoginSDK_CreateAuthServerWithContext_Call) Run(run func(ctx context.Context, authServer *models.AuthServer)) *IOneLoginSDK_CreateAuthServerWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(*models.AuthServer))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAuthServer_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthServer_Call {
+func (_c *IOneLoginSDK_CreateAuthServerWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateAuthServerWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAuthServer_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteAuthServer_Call {
+func (_c *IOneLoginSDK_CreateAuthServerWithContext_Call) RunAndReturn(run func(context.Context, *models.AuthServer) (interface{}, error)) *IOneLoginSDK_CreateAuthServerWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAuthServerScope provides a mock function with given fields: id, scopeID
-func (_m *IOneLoginSDK) DeleteAuthServerScope(id int, scopeID int) (interface{}, error) {
-	ret := _m.Called(id, scopeID)
+// CreateClientApp provides a mock function with given fields: id, clientApp
+func (_m *IOneLoginSDK) CreateClientApp(id int, clientApp models.ClientApp) (interface{}, error) {
+	ret := _m.Called(id, clientApp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAuthServerScope")
+		panic("no return value specified for CreateClientApp")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
-		return rf(id, scopeID)
+	if rf, ok := ret.Get(0).(func(int, models.ClientApp) (interface{}, error)); ok {
+		return rf(id, clientApp)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
-		r0 = rf(id, scopeID)
+	if rf, ok := ret.Get(0).(func(int, models.ClientApp) interface{}); ok {
+		r0 = rf(id, clientApp)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(id, scopeID)
+	if rf, ok := ret.Get(1).(func(int, models.ClientApp) error); ok {
+		r1 = rf(id, clientApp)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1397,58 +1754,58 @@ func (_m *IOneLoginSDK) DeleteAuthServerScope(id int, scopeID int) (interface{},
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteAuthServerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthServerScope'
-type IOneLoginSDK_DeleteAuthServerScope_Call struct {
+// IOneLoginSDK_CreateClientApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClientApp'
+type IOneLoginSDK_CreateClientApp_Call struct {
 	*mock.Call
 }
 
-// DeleteAuthServerScope is a helper method to define mock.On call
+// CreateClientApp is a helper method to define mock.On call
 //   - id int
-//   - scopeID int
-func (_e *IOneLoginSDK_Expecter) DeleteAuthServerScope(id interface{}, scopeID interface{}) *IOneLoginSDK_DeleteAuthServerScope_Call {
-	return &IOneLoginSDK_DeleteAuthServerScope_Call{Call: _e.mock.On("DeleteAuthServerScope", id, scopeID)}
+//   - clientApp models.ClientApp
+func (_e *IOneLoginSDK_Expecter) CreateClientApp(id interface{}, clientApp interface{}) *IOneLoginSDK_CreateClientApp_Call {
+	return &IOneLoginSDK_CreateClientApp_Call{Call: _e.mock.On("CreateClientApp", id, clientApp)}
 }
 
-func (_c *IOneLoginSDK_DeleteAuthServerScope_Call) Run(run func(id int, scopeID int)) *IOneLoginSDK_DeleteAuthServerScope_Call {
+func (_c *IOneLoginSDK_CreateClientApp_Call) Run(run func(id int, clientApp models.ClientApp)) *IOneLoginSDK_CreateClientApp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(models.ClientApp))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAuthServerScope_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthServerScope_Call {
+func (_c *IOneLoginSDK_CreateClientApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateClientApp_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteAuthServerScope_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthServerScope_Call {
+func (_c *IOneLoginSDK_CreateClientApp_Call) RunAndReturn(run func(int, models.ClientApp) (interface{}, error)) *IOneLoginSDK_CreateClientApp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteClientApp provides a mock function with given fields: id, clientID
-func (_m *IOneLoginSDK) DeleteClientApp(id int, clientID int) (interface{}, error) {
-	ret := _m.Called(id, clientID)
+// CreateClientAppWithContext provides a mock function with given fields: ctx, id, clientApp
+func (_m *IOneLoginSDK) CreateClientAppWithContext(ctx context.Context, id int, clientApp models.ClientApp) (interface{}, error) {
+	ret := _m.Called(ctx, id, clientApp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteClientApp")
+		panic("no return value specified for CreateClientAppWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
-		return rf(id, clientID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.ClientApp) (interface{}, error)); ok {
+		return rf(ctx, id, clientApp)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
-		r0 = rf(id, clientID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.ClientApp) interface{}); ok {
+		r0 = rf(ctx, id, clientApp)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(id, clientID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.ClientApp) error); ok {
+		r1 = rf(ctx, id, clientApp)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1456,58 +1813,59 @@ func (_m *IOneLoginSDK) DeleteClientApp(id int, clientID int) (interface{}, erro
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteClientApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClientApp'
-type IOneLoginSDK_DeleteClientApp_Call struct {
+// IOneLoginSDK_CreateClientAppWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClientAppWithContext'
+type IOneLoginSDK_CreateClientAppWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteClientApp is a helper method to define mock.On call
+// CreateClientAppWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-//   - clientID int
-func (_e *IOneLoginSDK_Expecter) DeleteClientApp(id interface{}, clientID interface{}) *IOneLoginSDK_DeleteClientApp_Call {
-	return &IOneLoginSDK_DeleteClientApp_Call{Call: _e.mock.On("DeleteClientApp", id, clientID)}
+//   - clientApp models.ClientApp
+func (_e *IOneLoginSDK_Expecter) CreateClientAppWithContext(ctx interface{}, id interface{}, clientApp interface{}) *IOneLoginSDK_CreateClientAppWithContext_Call {
+	return &IOneLoginSDK_CreateClientAppWithContext_Call{Call: _e.mock.On("CreateClientAppWithContext", ctx, id, clientApp)}
 }
 
-func (_c *IOneLoginSDK_DeleteClientApp_Call) Run(run func(id int, clientID int)) *IOneLoginSDK_DeleteClientApp_Call {
+func (_c *IOneLoginSDK_CreateClientAppWithContext_Call) Run(run func(ctx context.Context, id int, clientApp models.ClientApp)) *IOneLoginSDK_CreateClientAppWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.ClientApp))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteClientApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteClientApp_Call {
+func (_c *IOneLoginSDK_CreateClientAppWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateClientAppWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteClientApp_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_DeleteClientApp_Call {
+func (_c *IOneLoginSDK_CreateClientAppWithContext_Call) RunAndReturn(run func(context.Context, int, models.ClientApp) (interface{}, error)) *IOneLoginSDK_CreateClientAppWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteEnvironmentVariable provides a mock function with given fields: envVarID
-func (_m *IOneLoginSDK) DeleteEnvironmentVariable(envVarID int) (interface{}, error) {
-	ret := _m.Called(envVarID)
+// CreateEnvironmentVariable provides a mock function with given fields: name, value
+func (_m *IOneLoginSDK) CreateEnvironmentVariable(name string, value string) (interface{}, error) {
+	ret := _m.Called(name, value)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteEnvironmentVariable")
+		panic("no return value specified for CreateEnvironmentVariable")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(envVarID)
+	if rf, ok := ret.Get(0).(func(string, string) (interface{}, error)); ok {
+		return rf(name, value)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(envVarID)
+	if rf, ok := ret.Get(0).(func(string, string) interface{}); ok {
+		r0 = rf(name, value)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(envVarID)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, value)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1515,57 +1873,58 @@ func (_m *IOneLoginSDK) DeleteEnvironmentVariable(envVarID int) (interface{}, er
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvironmentVariable'
-type IOneLoginSDK_DeleteEnvironmentVariable_Call struct {
+// IOneLoginSDK_CreateEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnvironmentVariable'
+type IOneLoginSDK_CreateEnvironmentVariable_Call struct {
 	*mock.Call
 }
 
-// DeleteEnvironmentVariable is a helper method to define mock.On call
-//   - envVarID int
-func (_e *IOneLoginSDK_Expecter) DeleteEnvironmentVariable(envVarID interface{}) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
-	return &IOneLoginSDK_DeleteEnvironmentVariable_Call{Call: _e.mock.On("DeleteEnvironmentVariable", envVarID)}
+// CreateEnvironmentVariable is a helper method to define mock.On call
+//   - name string
+//   - value string
+func (_e *IOneLoginSDK_Expecter) CreateEnvironmentVariable(name interface{}, value interface{}) *IOneLoginSDK_CreateEnvironmentVariable_Call {
+	return &IOneLoginSDK_CreateEnvironmentVariable_Call{Call: _e.mock.On("CreateEnvironmentVariable", name, value)}
 }
 
-func (_c *IOneLoginSDK_DeleteEnvironmentVariable_Call) Run(run func(envVarID int)) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_CreateEnvironmentVariable_Call) Run(run func(name string, value string)) *IOneLoginSDK_CreateEnvironmentVariable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_CreateEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateEnvironmentVariable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteEnvironmentVariable_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_CreateEnvironmentVariable_Call) RunAndReturn(run func(string, string) (interface{}, error)) *IOneLoginSDK_CreateEnvironmentVariable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteHook provides a mock function with given fields: hookID
-func (_m *IOneLoginSDK) DeleteHook(hookID int) (interface{}, error) {
-	ret := _m.Called(hookID)
+// CreateEnvironmentVariableWithContext provides a mock function with given fields: ctx, name, value
+func (_m *IOneLoginSDK) CreateEnvironmentVariableWithContext(ctx context.Context, name string, value string) (interface{}, error) {
+	ret := _m.Called(ctx, name, value)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteHook")
+		panic("no return value specified for CreateEnvironmentVariableWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(hookID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (interface{}, error)); ok {
+		return rf(ctx, name, value)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(hookID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) interface{}); ok {
+		r0 = rf(ctx, name, value)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(hookID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, value)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1573,57 +1932,59 @@ func (_m *IOneLoginSDK) DeleteHook(hookID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHook'
-type IOneLoginSDK_DeleteHook_Call struct {
+// IOneLoginSDK_CreateEnvironmentVariableWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnvironmentVariableWithContext'
+type IOneLoginSDK_CreateEnvironmentVariableWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteHook is a helper method to define mock.On call
-//   - hookID int
-func (_e *IOneLoginSDK_Expecter) DeleteHook(hookID interface{}) *IOneLoginSDK_DeleteHook_Call {
-	return &IOneLoginSDK_DeleteHook_Call{Call: _e.mock.On("DeleteHook", hookID)}
+// CreateEnvironmentVariableWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - value string
+func (_e *IOneLoginSDK_Expecter) CreateEnvironmentVariableWithContext(ctx interface{}, name interface{}, value interface{}) *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call {
+	return &IOneLoginSDK_CreateEnvironmentVariableWithContext_Call{Call: _e.mock.On("CreateEnvironmentVariableWithContext", ctx, name, value)}
 }
 
-func (_c *IOneLoginSDK_DeleteHook_Call) Run(run func(hookID int)) *IOneLoginSDK_DeleteHook_Call {
+func (_c *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call) Run(run func(ctx context.Context, name string, value string)) *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteHook_Call {
+func (_c *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteHook_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteHook_Call {
+func (_c *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call) RunAndReturn(run func(context.Context, string, string) (interface{}, error)) *IOneLoginSDK_CreateEnvironmentVariableWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteMapping provides a mock function with given fields: mappingID
-func (_m *IOneLoginSDK) DeleteMapping(mappingID int) (interface{}, error) {
-	ret := _m.Called(mappingID)
+// CreateHook provides a mock function with given fields: hook
+func (_m *IOneLoginSDK) CreateHook(hook models.SmartHook) (interface{}, error) {
+	ret := _m.Called(hook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMapping")
+		panic("no return value specified for CreateHook")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(mappingID)
+	if rf, ok := ret.Get(0).(func(models.SmartHook) (interface{}, error)); ok {
+		return rf(hook)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(mappingID)
+	if rf, ok := ret.Get(0).(func(models.SmartHook) interface{}); ok {
+		r0 = rf(hook)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(mappingID)
+	if rf, ok := ret.Get(1).(func(models.SmartHook) error); ok {
+		r1 = rf(hook)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1631,57 +1992,57 @@ func (_m *IOneLoginSDK) DeleteMapping(mappingID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMapping'
-type IOneLoginSDK_DeleteMapping_Call struct {
+// IOneLoginSDK_CreateHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHook'
+type IOneLoginSDK_CreateHook_Call struct {
 	*mock.Call
 }
 
-// DeleteMapping is a helper method to define mock.On call
-//   - mappingID int
-func (_e *IOneLoginSDK_Expecter) DeleteMapping(mappingID interface{}) *IOneLoginSDK_DeleteMapping_Call {
-	return &IOneLoginSDK_DeleteMapping_Call{Call: _e.mock.On("DeleteMapping", mappingID)}
+// CreateHook is a helper method to define mock.On call
+//   - hook models.SmartHook
+func (_e *IOneLoginSDK_Expecter) CreateHook(hook interface{}) *IOneLoginSDK_CreateHook_Call {
+	return &IOneLoginSDK_CreateHook_Call{Call: _e.mock.On("CreateHook", hook)}
 }
 
-func (_c *IOneLoginSDK_DeleteMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_DeleteMapping_Call {
+func (_c *IOneLoginSDK_CreateHook_Call) Run(run func(hook models.SmartHook)) *IOneLoginSDK_CreateHook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(models.SmartHook))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteMapping_Call {
+func (_c *IOneLoginSDK_CreateHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateHook_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteMapping_Call {
+func (_c *IOneLoginSDK_CreateHook_Call) RunAndReturn(run func(models.SmartHook) (interface{}, error)) *IOneLoginSDK_CreateHook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePrivilege provides a mock function with given fields: privilegeID
-func (_m *IOneLoginSDK) DeletePrivilege(privilegeID string) (interface{}, error) {
-	ret := _m.Called(privilegeID)
+// CreateHookTyped provides a mock function with given fields: hook
+func (_m *IOneLoginSDK) CreateHookTyped(hook models.SmartHook) (*models.Response[models.SmartHook], error) {
+	ret := _m.Called(hook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePrivilege")
+		panic("no return value specified for CreateHookTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.SmartHook]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(models.SmartHook) (*models.Response[models.SmartHook], error)); ok {
+		return rf(hook)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(models.SmartHook) *models.Response[models.SmartHook]); ok {
+		r0 = rf(hook)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.SmartHook])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(privilegeID)
+	if rf, ok := ret.Get(1).(func(models.SmartHook) error); ok {
+		r1 = rf(hook)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1689,57 +2050,57 @@ func (_m *IOneLoginSDK) DeletePrivilege(privilegeID string) (interface{}, error)
 	return r0, r1
 }
 
-// IOneLoginSDK_DeletePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePrivilege'
-type IOneLoginSDK_DeletePrivilege_Call struct {
+// IOneLoginSDK_CreateHookTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHookTyped'
+type IOneLoginSDK_CreateHookTyped_Call struct {
 	*mock.Call
 }
 
-// DeletePrivilege is a helper method to define mock.On call
-//   - privilegeID string
-func (_e *IOneLoginSDK_Expecter) DeletePrivilege(privilegeID interface{}) *IOneLoginSDK_DeletePrivilege_Call {
-	return &IOneLoginSDK_DeletePrivilege_Call{Call: _e.mock.On("DeletePrivilege", privilegeID)}
+// CreateHookTyped is a helper method to define mock.On call
+//   - hook models.SmartHook
+func (_e *IOneLoginSDK_Expecter) CreateHookTyped(hook interface{}) *IOneLoginSDK_CreateHookTyped_Call {
+	return &IOneLoginSDK_CreateHookTyped_Call{Call: _e.mock.On("CreateHookTyped", hook)}
 }
 
-func (_c *IOneLoginSDK_DeletePrivilege_Call) Run(run func(privilegeID string)) *IOneLoginSDK_DeletePrivilege_Call {
+func (_c *IOneLoginSDK_CreateHookTyped_Call) Run(run func(hook models.SmartHook)) *IOneLoginSDK_CreateHookTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(models.SmartHook))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeletePrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeletePrivilege_Call {
+func (_c *IOneLoginSDK_CreateHookTyped_Call) Return(_a0 *models.Response[models.SmartHook], _a1 error) *IOneLoginSDK_CreateHookTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeletePrivilege_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_DeletePrivilege_Call {
+func (_c *IOneLoginSDK_CreateHookTyped_Call) RunAndReturn(run func(models.SmartHook) (*models.Response[models.SmartHook], error)) *IOneLoginSDK_CreateHookTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRole provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) DeleteRole(id int, queryParams map[string]string) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// CreateHookTypedWithContext provides a mock function with given fields: ctx, hook
+func (_m *IOneLoginSDK) CreateHookTypedWithContext(ctx context.Context, hook models.SmartHook) (*models.Response[models.SmartHook], error) {
+	ret := _m.Called(ctx, hook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRole")
+		panic("no return value specified for CreateHookTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.SmartHook]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, map[string]string) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SmartHook) (*models.Response[models.SmartHook], error)); ok {
+		return rf(ctx, hook)
 	}
-	if rf, ok := ret.Get(0).(func(int, map[string]string) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SmartHook) *models.Response[models.SmartHook]); ok {
+		r0 = rf(ctx, hook)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.SmartHook])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, map[string]string) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, models.SmartHook) error); ok {
+		r1 = rf(ctx, hook)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1747,58 +2108,58 @@ func (_m *IOneLoginSDK) DeleteRole(id int, queryParams map[string]string) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
-type IOneLoginSDK_DeleteRole_Call struct {
+// IOneLoginSDK_CreateHookTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHookTypedWithContext'
+type IOneLoginSDK_CreateHookTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteRole is a helper method to define mock.On call
-//   - id int
-//   - queryParams map[string]string
-func (_e *IOneLoginSDK_Expecter) DeleteRole(id interface{}, queryParams interface{}) *IOneLoginSDK_DeleteRole_Call {
-	return &IOneLoginSDK_DeleteRole_Call{Call: _e.mock.On("DeleteRole", id, queryParams)}
+// CreateHookTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hook models.SmartHook
+func (_e *IOneLoginSDK_Expecter) CreateHookTypedWithContext(ctx interface{}, hook interface{}) *IOneLoginSDK_CreateHookTypedWithContext_Call {
+	return &IOneLoginSDK_CreateHookTypedWithContext_Call{Call: _e.mock.On("CreateHookTypedWithContext", ctx, hook)}
 }
 
-func (_c *IOneLoginSDK_DeleteRole_Call) Run(run func(id int, queryParams map[string]string)) *IOneLoginSDK_DeleteRole_Call {
+func (_c *IOneLoginSDK_CreateHookTypedWithContext_Call) Run(run func(ctx context.Context, hook models.SmartHook)) *IOneLoginSDK_CreateHookTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(map[string]string))
+		run(args[0].(context.Context), args[1].(models.SmartHook))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRole_Call {
+func (_c *IOneLoginSDK_CreateHookTypedWithContext_Call) Return(_a0 *models.Response[models.SmartHook], _a1 error) *IOneLoginSDK_CreateHookTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRole_Call) RunAndReturn(run func(int, map[string]string) (interface{}, error)) *IOneLoginSDK_DeleteRole_Call {
+func (_c *IOneLoginSDK_CreateHookTypedWithContext_Call) RunAndReturn(run func(context.Context, models.SmartHook) (*models.Response[models.SmartHook], error)) *IOneLoginSDK_CreateHookTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRoleAdmins provides a mock function with given fields: roleID, admins
-func (_m *IOneLoginSDK) DeleteRoleAdmins(roleID int, admins []int) (interface{}, error) {
-	ret := _m.Called(roleID, admins)
+// CreateHookWithContext provides a mock function with given fields: ctx, hook
+func (_m *IOneLoginSDK) CreateHookWithContext(ctx context.Context, hook models.SmartHook) (interface{}, error) {
+	ret := _m.Called(ctx, hook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRoleAdmins")
+		panic("no return value specified for CreateHookWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, []int) (interface{}, error)); ok {
-		return rf(roleID, admins)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SmartHook) (interface{}, error)); ok {
+		return rf(ctx, hook)
 	}
-	if rf, ok := ret.Get(0).(func(int, []int) interface{}); ok {
-		r0 = rf(roleID, admins)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SmartHook) interface{}); ok {
+		r0 = rf(ctx, hook)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
-		r1 = rf(roleID, admins)
+	if rf, ok := ret.Get(1).(func(context.Context, models.SmartHook) error); ok {
+		r1 = rf(ctx, hook)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1806,58 +2167,58 @@ func (_m *IOneLoginSDK) DeleteRoleAdmins(roleID int, admins []int) (interface{},
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteRoleAdmins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleAdmins'
-type IOneLoginSDK_DeleteRoleAdmins_Call struct {
+// IOneLoginSDK_CreateHookWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHookWithContext'
+type IOneLoginSDK_CreateHookWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteRoleAdmins is a helper method to define mock.On call
-//   - roleID int
-//   - admins []int
-func (_e *IOneLoginSDK_Expecter) DeleteRoleAdmins(roleID interface{}, admins interface{}) *IOneLoginSDK_DeleteRoleAdmins_Call {
-	return &IOneLoginSDK_DeleteRoleAdmins_Call{Call: _e.mock.On("DeleteRoleAdmins", roleID, admins)}
+// CreateHookWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hook models.SmartHook
+func (_e *IOneLoginSDK_Expecter) CreateHookWithContext(ctx interface{}, hook interface{}) *IOneLoginSDK_CreateHookWithContext_Call {
+	return &IOneLoginSDK_CreateHookWithContext_Call{Call: _e.mock.On("CreateHookWithContext", ctx, hook)}
 }
 
-func (_c *IOneLoginSDK_DeleteRoleAdmins_Call) Run(run func(roleID int, admins []int)) *IOneLoginSDK_DeleteRoleAdmins_Call {
+func (_c *IOneLoginSDK_CreateHookWithContext_Call) Run(run func(ctx context.Context, hook models.SmartHook)) *IOneLoginSDK_CreateHookWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].([]int))
+		run(args[0].(context.Context), args[1].(models.SmartHook))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRoleAdmins_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleAdmins_Call {
+func (_c *IOneLoginSDK_CreateHookWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateHookWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRoleAdmins_Call) RunAndReturn(run func(int, []int) (interface{}, error)) *IOneLoginSDK_DeleteRoleAdmins_Call {
+func (_c *IOneLoginSDK_CreateHookWithContext_Call) RunAndReturn(run func(context.Context, models.SmartHook) (interface{}, error)) *IOneLoginSDK_CreateHookWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRoleFromPrivilege provides a mock function with given fields: privilegeID, roleID
-func (_m *IOneLoginSDK) DeleteRoleFromPrivilege(privilegeID string, roleID int) (interface{}, error) {
-	ret := _m.Called(privilegeID, roleID)
+// CreateMapping provides a mock function with given fields: mapping
+func (_m *IOneLoginSDK) CreateMapping(mapping models.UserMapping) (interface{}, error) {
+	ret := _m.Called(mapping)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRoleFromPrivilege")
+		panic("no return value specified for CreateMapping")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) (interface{}, error)); ok {
-		return rf(privilegeID, roleID)
+	if rf, ok := ret.Get(0).(func(models.UserMapping) (interface{}, error)); ok {
+		return rf(mapping)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) interface{}); ok {
-		r0 = rf(privilegeID, roleID)
+	if rf, ok := ret.Get(0).(func(models.UserMapping) interface{}); ok {
+		r0 = rf(mapping)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(privilegeID, roleID)
+	if rf, ok := ret.Get(1).(func(models.UserMapping) error); ok {
+		r1 = rf(mapping)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1865,58 +2226,57 @@ func (_m *IOneLoginSDK) DeleteRoleFromPrivilege(privilegeID string, roleID int)
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteRoleFromPrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleFromPrivilege'
-type IOneLoginSDK_DeleteRoleFromPrivilege_Call struct {
+// IOneLoginSDK_CreateMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMapping'
+type IOneLoginSDK_CreateMapping_Call struct {
 	*mock.Call
 }
 
-// DeleteRoleFromPrivilege is a helper method to define mock.On call
-//   - privilegeID string
-//   - roleID int
-func (_e *IOneLoginSDK_Expecter) DeleteRoleFromPrivilege(privilegeID interface{}, roleID interface{}) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
-	return &IOneLoginSDK_DeleteRoleFromPrivilege_Call{Call: _e.mock.On("DeleteRoleFromPrivilege", privilegeID, roleID)}
+// CreateMapping is a helper method to define mock.On call
+//   - mapping models.UserMapping
+func (_e *IOneLoginSDK_Expecter) CreateMapping(mapping interface{}) *IOneLoginSDK_CreateMapping_Call {
+	return &IOneLoginSDK_CreateMapping_Call{Call: _e.mock.On("CreateMapping", mapping)}
 }
 
-func (_c *IOneLoginSDK_DeleteRoleFromPrivilege_Call) Run(run func(privilegeID string, roleID int)) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
+func (_c *IOneLoginSDK_CreateMapping_Call) Run(run func(mapping models.UserMapping)) *IOneLoginSDK_CreateMapping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(models.UserMapping))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRoleFromPrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
+func (_c *IOneLoginSDK_CreateMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateMapping_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRoleFromPrivilege_Call) RunAndReturn(run func(string, int) (interface{}, error)) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
+func (_c *IOneLoginSDK_CreateMapping_Call) RunAndReturn(run func(models.UserMapping) (interface{}, error)) *IOneLoginSDK_CreateMapping_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRoleUsers provides a mock function with given fields: roleID, users
-func (_m *IOneLoginSDK) DeleteRoleUsers(roleID int, users []int) (interface{}, error) {
-	ret := _m.Called(roleID, users)
+// CreateMappingTyped provides a mock function with given fields: mapping
+func (_m *IOneLoginSDK) CreateMappingTyped(mapping models.UserMapping) (*models.Response[models.UserMapping], error) {
+	ret := _m.Called(mapping)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRoleUsers")
+		panic("no return value specified for CreateMappingTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.UserMapping]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, []int) (interface{}, error)); ok {
-		return rf(roleID, users)
+	if rf, ok := ret.Get(0).(func(models.UserMapping) (*models.Response[models.UserMapping], error)); ok {
+		return rf(mapping)
 	}
-	if rf, ok := ret.Get(0).(func(int, []int) interface{}); ok {
-		r0 = rf(roleID, users)
+	if rf, ok := ret.Get(0).(func(models.UserMapping) *models.Response[models.UserMapping]); ok {
+		r0 = rf(mapping)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.UserMapping])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
-		r1 = rf(roleID, users)
+	if rf, ok := ret.Get(1).(func(models.UserMapping) error); ok {
+		r1 = rf(mapping)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1924,58 +2284,57 @@ func (_m *IOneLoginSDK) DeleteRoleUsers(roleID int, users []int) (interface{}, e
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteRoleUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleUsers'
-type IOneLoginSDK_DeleteRoleUsers_Call struct {
+// IOneLoginSDK_CreateMappingTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMappingTyped'
+type IOneLoginSDK_CreateMappingTyped_Call struct {
 	*mock.Call
 }
 
-// DeleteRoleUsers is a helper method to define mock.On call
-//   - roleID int
-//   - users []int
-func (_e *IOneLoginSDK_Expecter) DeleteRoleUsers(roleID interface{}, users interface{}) *IOneLoginSDK_DeleteRoleUsers_Call {
-	return &IOneLoginSDK_DeleteRoleUsers_Call{Call: _e.mock.On("DeleteRoleUsers", roleID, users)}
+// CreateMappingTyped is a helper method to define mock.On call
+//   - mapping models.UserMapping
+func (_e *IOneLoginSDK_Expecter) CreateMappingTyped(mapping interface{}) *IOneLoginSDK_CreateMappingTyped_Call {
+	return &IOneLoginSDK_CreateMappingTyped_Call{Call: _e.mock.On("CreateMappingTyped", mapping)}
 }
 
-func (_c *IOneLoginSDK_DeleteRoleUsers_Call) Run(run func(roleID int, users []int)) *IOneLoginSDK_DeleteRoleUsers_Call {
+func (_c *IOneLoginSDK_CreateMappingTyped_Call) Run(run func(mapping models.UserMapping)) *IOneLoginSDK_CreateMappingTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].([]int))
+		run(args[0].(models.UserMapping))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRoleUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleUsers_Call {
+func (_c *IOneLoginSDK_CreateMappingTyped_Call) Return(_a0 *models.Response[models.UserMapping], _a1 error) *IOneLoginSDK_CreateMappingTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteRoleUsers_Call) RunAndReturn(run func(int, []int) (interface{}, error)) *IOneLoginSDK_DeleteRoleUsers_Call {
+func (_c *IOneLoginSDK_CreateMappingTyped_Call) RunAndReturn(run func(models.UserMapping) (*models.Response[models.UserMapping], error)) *IOneLoginSDK_CreateMappingTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function with given fields: id
-func (_m *IOneLoginSDK) DeleteUser(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// CreateMappingTypedWithContext provides a mock function with given fields: ctx, mapping
+func (_m *IOneLoginSDK) CreateMappingTypedWithContext(ctx context.Context, mapping models.UserMapping) (*models.Response[models.UserMapping], error) {
+	ret := _m.Called(ctx, mapping)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for CreateMappingTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.UserMapping]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserMapping) (*models.Response[models.UserMapping], error)); ok {
+		return rf(ctx, mapping)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserMapping) *models.Response[models.UserMapping]); ok {
+		r0 = rf(ctx, mapping)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.UserMapping])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, models.UserMapping) error); ok {
+		r1 = rf(ctx, mapping)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1983,57 +2342,58 @@ func (_m *IOneLoginSDK) DeleteUser(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type IOneLoginSDK_DeleteUser_Call struct {
+// IOneLoginSDK_CreateMappingTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMappingTypedWithContext'
+type IOneLoginSDK_CreateMappingTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
-//   - id int
-func (_e *IOneLoginSDK_Expecter) DeleteUser(id interface{}) *IOneLoginSDK_DeleteUser_Call {
-	return &IOneLoginSDK_DeleteUser_Call{Call: _e.mock.On("DeleteUser", id)}
+// CreateMappingTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapping models.UserMapping
+func (_e *IOneLoginSDK_Expecter) CreateMappingTypedWithContext(ctx interface{}, mapping interface{}) *IOneLoginSDK_CreateMappingTypedWithContext_Call {
+	return &IOneLoginSDK_CreateMappingTypedWithContext_Call{Call: _e.mock.On("CreateMappingTypedWithContext", ctx, mapping)}
 }
 
-func (_c *IOneLoginSDK_DeleteUser_Call) Run(run func(id int)) *IOneLoginSDK_DeleteUser_Call {
+func (_c *IOneLoginSDK_CreateMappingTypedWithContext_Call) Run(run func(ctx context.Context, mapping models.UserMapping)) *IOneLoginSDK_CreateMappingTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(models.UserMapping))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteUser_Call {
+func (_c *IOneLoginSDK_CreateMappingTypedWithContext_Call) Return(_a0 *models.Response[models.UserMapping], _a1 error) *IOneLoginSDK_CreateMappingTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DeleteUser_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteUser_Call {
+func (_c *IOneLoginSDK_CreateMappingTypedWithContext_Call) RunAndReturn(run func(context.Context, models.UserMapping) (*models.Response[models.UserMapping], error)) *IOneLoginSDK_CreateMappingTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DryrunMapping provides a mock function with given fields: mappingID
-func (_m *IOneLoginSDK) DryrunMapping(mappingID int) (interface{}, error) {
-	ret := _m.Called(mappingID)
+// CreateMappingWithContext provides a mock function with given fields: ctx, mapping
+func (_m *IOneLoginSDK) CreateMappingWithContext(ctx context.Context, mapping models.UserMapping) (interface{}, error) {
+	ret := _m.Called(ctx, mapping)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DryrunMapping")
+		panic("no return value specified for CreateMappingWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(mappingID)
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserMapping) (interface{}, error)); ok {
+		return rf(ctx, mapping)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(mappingID)
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserMapping) interface{}); ok {
+		r0 = rf(ctx, mapping)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(mappingID)
+	if rf, ok := ret.Get(1).(func(context.Context, models.UserMapping) error); ok {
+		r1 = rf(ctx, mapping)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2041,57 +2401,58 @@ func (_m *IOneLoginSDK) DryrunMapping(mappingID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_DryrunMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryrunMapping'
-type IOneLoginSDK_DryrunMapping_Call struct {
+// IOneLoginSDK_CreateMappingWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMappingWithContext'
+type IOneLoginSDK_CreateMappingWithContext_Call struct {
 	*mock.Call
 }
 
-// DryrunMapping is a helper method to define mock.On call
-//   - mappingID int
-func (_e *IOneLoginSDK_Expecter) DryrunMapping(mappingID interface{}) *IOneLoginSDK_DryrunMapping_Call {
-	return &IOneLoginSDK_DryrunMapping_Call{Call: _e.mock.On("DryrunMapping", mappingID)}
+// CreateMappingWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapping models.UserMapping
+func (_e *IOneLoginSDK_Expecter) CreateMappingWithContext(ctx interface{}, mapping interface{}) *IOneLoginSDK_CreateMappingWithContext_Call {
+	return &IOneLoginSDK_CreateMappingWithContext_Call{Call: _e.mock.On("CreateMappingWithContext", ctx, mapping)}
 }
 
-func (_c *IOneLoginSDK_DryrunMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_DryrunMapping_Call {
+func (_c *IOneLoginSDK_CreateMappingWithContext_Call) Run(run func(ctx context.Context, mapping models.UserMapping)) *IOneLoginSDK_CreateMappingWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(models.UserMapping))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_DryrunMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DryrunMapping_Call {
+func (_c *IOneLoginSDK_CreateMappingWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateMappingWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_DryrunMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DryrunMapping_Call {
+func (_c *IOneLoginSDK_CreateMappingWithContext_Call) RunAndReturn(run func(context.Context, models.UserMapping) (interface{}, error)) *IOneLoginSDK_CreateMappingWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// EnrollMFAFactor provides a mock function with given fields: factor, userID
-func (_m *IOneLoginSDK) EnrollMFAFactor(factor models.EnrollFactorRequest, userID int) (interface{}, error) {
-	ret := _m.Called(factor, userID)
+// CreatePrivilege provides a mock function with given fields: privilege
+func (_m *IOneLoginSDK) CreatePrivilege(privilege models.Privilege) (interface{}, error) {
+	ret := _m.Called(privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for EnrollMFAFactor")
+		panic("no return value specified for CreatePrivilege")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.EnrollFactorRequest, int) (interface{}, error)); ok {
-		return rf(factor, userID)
+	if rf, ok := ret.Get(0).(func(models.Privilege) (interface{}, error)); ok {
+		return rf(privilege)
 	}
-	if rf, ok := ret.Get(0).(func(models.EnrollFactorRequest, int) interface{}); ok {
-		r0 = rf(factor, userID)
+	if rf, ok := ret.Get(0).(func(models.Privilege) interface{}); ok {
+		r0 = rf(privilege)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.EnrollFactorRequest, int) error); ok {
-		r1 = rf(factor, userID)
+	if rf, ok := ret.Get(1).(func(models.Privilege) error); ok {
+		r1 = rf(privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2099,58 +2460,57 @@ func (_m *IOneLoginSDK) EnrollMFAFactor(factor models.EnrollFactorRequest, userI
 	return r0, r1
 }
 
-// IOneLoginSDK_EnrollMFAFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFAFactor'
-type IOneLoginSDK_EnrollMFAFactor_Call struct {
+// IOneLoginSDK_CreatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePrivilege'
+type IOneLoginSDK_CreatePrivilege_Call struct {
 	*mock.Call
 }
 
-// EnrollMFAFactor is a helper method to define mock.On call
-//   - factor models.EnrollFactorRequest
-//   - userID int
-func (_e *IOneLoginSDK_Expecter) EnrollMFAFactor(factor interface{}, userID interface{}) *IOneLoginSDK_EnrollMFAFactor_Call {
-	return &IOneLoginSDK_EnrollMFAFactor_Call{Call: _e.mock.On("EnrollMFAFactor", factor, userID)}
+// CreatePrivilege is a helper method to define mock.On call
+//   - privilege models.Privilege
+func (_e *IOneLoginSDK_Expecter) CreatePrivilege(privilege interface{}) *IOneLoginSDK_CreatePrivilege_Call {
+	return &IOneLoginSDK_CreatePrivilege_Call{Call: _e.mock.On("CreatePrivilege", privilege)}
 }
 
-func (_c *IOneLoginSDK_EnrollMFAFactor_Call) Run(run func(factor models.EnrollFactorRequest, userID int)) *IOneLoginSDK_EnrollMFAFactor_Call {
+func (_c *IOneLoginSDK_CreatePrivilege_Call) Run(run func(privilege models.Privilege)) *IOneLoginSDK_CreatePrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.EnrollFactorRequest), args[1].(int))
+		run(args[0].(models.Privilege))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_EnrollMFAFactor_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_EnrollMFAFactor_Call {
+func (_c *IOneLoginSDK_CreatePrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreatePrivilege_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_EnrollMFAFactor_Call) RunAndReturn(run func(models.EnrollFactorRequest, int) (interface{}, error)) *IOneLoginSDK_EnrollMFAFactor_Call {
+func (_c *IOneLoginSDK_CreatePrivilege_Call) RunAndReturn(run func(models.Privilege) (interface{}, error)) *IOneLoginSDK_CreatePrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenerateInviteLink provides a mock function with given fields: email
-func (_m *IOneLoginSDK) GenerateInviteLink(email string) (interface{}, error) {
-	ret := _m.Called(email)
+// CreatePrivilegeWithContext provides a mock function with given fields: ctx, privilege
+func (_m *IOneLoginSDK) CreatePrivilegeWithContext(ctx context.Context, privilege models.Privilege) (interface{}, error) {
+	ret := _m.Called(ctx, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateInviteLink")
+		panic("no return value specified for CreatePrivilegeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Privilege) (interface{}, error)); ok {
+		return rf(ctx, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Privilege) interface{}); ok {
+		r0 = rf(ctx, privilege)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(context.Context, models.Privilege) error); ok {
+		r1 = rf(ctx, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2158,57 +2518,58 @@ func (_m *IOneLoginSDK) GenerateInviteLink(email string) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GenerateInviteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateInviteLink'
-type IOneLoginSDK_GenerateInviteLink_Call struct {
+// IOneLoginSDK_CreatePrivilegeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePrivilegeWithContext'
+type IOneLoginSDK_CreatePrivilegeWithContext_Call struct {
 	*mock.Call
 }
 
-// GenerateInviteLink is a helper method to define mock.On call
-//   - email string
-func (_e *IOneLoginSDK_Expecter) GenerateInviteLink(email interface{}) *IOneLoginSDK_GenerateInviteLink_Call {
-	return &IOneLoginSDK_GenerateInviteLink_Call{Call: _e.mock.On("GenerateInviteLink", email)}
+// CreatePrivilegeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilege models.Privilege
+func (_e *IOneLoginSDK_Expecter) CreatePrivilegeWithContext(ctx interface{}, privilege interface{}) *IOneLoginSDK_CreatePrivilegeWithContext_Call {
+	return &IOneLoginSDK_CreatePrivilegeWithContext_Call{Call: _e.mock.On("CreatePrivilegeWithContext", ctx, privilege)}
 }
 
-func (_c *IOneLoginSDK_GenerateInviteLink_Call) Run(run func(email string)) *IOneLoginSDK_GenerateInviteLink_Call {
+func (_c *IOneLoginSDK_CreatePrivilegeWithContext_Call) Run(run func(ctx context.Context, privilege models.Privilege)) *IOneLoginSDK_CreatePrivilegeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(models.Privilege))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GenerateInviteLink_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateInviteLink_Call {
+func (_c *IOneLoginSDK_CreatePrivilegeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreatePrivilegeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GenerateInviteLink_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GenerateInviteLink_Call {
+func (_c *IOneLoginSDK_CreatePrivilegeWithContext_Call) RunAndReturn(run func(context.Context, models.Privilege) (interface{}, error)) *IOneLoginSDK_CreatePrivilegeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenerateMFAToken provides a mock function with given fields: userID, request
-func (_m *IOneLoginSDK) GenerateMFAToken(userID int, request models.GenerateMFATokenRequest) (interface{}, error) {
-	ret := _m.Called(userID, request)
+// CreateRole provides a mock function with given fields: role
+func (_m *IOneLoginSDK) CreateRole(role *models.Role) (interface{}, error) {
+	ret := _m.Called(role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateMFAToken")
+		panic("no return value specified for CreateRole")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.GenerateMFATokenRequest) (interface{}, error)); ok {
-		return rf(userID, request)
+	if rf, ok := ret.Get(0).(func(*models.Role) (interface{}, error)); ok {
+		return rf(role)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.GenerateMFATokenRequest) interface{}); ok {
-		r0 = rf(userID, request)
+	if rf, ok := ret.Get(0).(func(*models.Role) interface{}); ok {
+		r0 = rf(role)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.GenerateMFATokenRequest) error); ok {
-		r1 = rf(userID, request)
+	if rf, ok := ret.Get(1).(func(*models.Role) error); ok {
+		r1 = rf(role)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2216,58 +2577,57 @@ func (_m *IOneLoginSDK) GenerateMFAToken(userID int, request models.GenerateMFAT
 	return r0, r1
 }
 
-// IOneLoginSDK_GenerateMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateMFAToken'
-type IOneLoginSDK_GenerateMFAToken_Call struct {
+// IOneLoginSDK_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type IOneLoginSDK_CreateRole_Call struct {
 	*mock.Call
 }
 
-// GenerateMFAToken is a helper method to define mock.On call
-//   - userID int
-//   - request models.GenerateMFATokenRequest
-func (_e *IOneLoginSDK_Expecter) GenerateMFAToken(userID interface{}, request interface{}) *IOneLoginSDK_GenerateMFAToken_Call {
-	return &IOneLoginSDK_GenerateMFAToken_Call{Call: _e.mock.On("GenerateMFAToken", userID, request)}
+// CreateRole is a helper method to define mock.On call
+//   - role *models.Role
+func (_e *IOneLoginSDK_Expecter) CreateRole(role interface{}) *IOneLoginSDK_CreateRole_Call {
+	return &IOneLoginSDK_CreateRole_Call{Call: _e.mock.On("CreateRole", role)}
 }
 
-func (_c *IOneLoginSDK_GenerateMFAToken_Call) Run(run func(userID int, request models.GenerateMFATokenRequest)) *IOneLoginSDK_GenerateMFAToken_Call {
+func (_c *IOneLoginSDK_CreateRole_Call) Run(run func(role *models.Role)) *IOneLoginSDK_CreateRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.GenerateMFATokenRequest))
+		run(args[0].(*models.Role))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GenerateMFAToken_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateMFAToken_Call {
+func (_c *IOneLoginSDK_CreateRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GenerateMFAToken_Call) RunAndReturn(run func(int, models.GenerateMFATokenRequest) (interface{}, error)) *IOneLoginSDK_GenerateMFAToken_Call {
+func (_c *IOneLoginSDK_CreateRole_Call) RunAndReturn(run func(*models.Role) (interface{}, error)) *IOneLoginSDK_CreateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenerateSAMLAssertion provides a mock function with given fields: request
-func (_m *IOneLoginSDK) GenerateSAMLAssertion(request models.GenerateSAMLTokenRequest) (interface{}, error) {
-	ret := _m.Called(request)
+// CreateRoleTyped provides a mock function with given fields: role
+func (_m *IOneLoginSDK) CreateRoleTyped(role *models.Role) (*models.Response[models.Role], error) {
+	ret := _m.Called(role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateSAMLAssertion")
+		panic("no return value specified for CreateRoleTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.Role]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.GenerateSAMLTokenRequest) (interface{}, error)); ok {
-		return rf(request)
+	if rf, ok := ret.Get(0).(func(*models.Role) (*models.Response[models.Role], error)); ok {
+		return rf(role)
 	}
-	if rf, ok := ret.Get(0).(func(models.GenerateSAMLTokenRequest) interface{}); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(*models.Role) *models.Response[models.Role]); ok {
+		r0 = rf(role)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.Role])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.GenerateSAMLTokenRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(*models.Role) error); ok {
+		r1 = rf(role)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2275,102 +2635,116 @@ func (_m *IOneLoginSDK) GenerateSAMLAssertion(request models.GenerateSAMLTokenRe
 	return r0, r1
 }
 
-// IOneLoginSDK_GenerateSAMLAssertion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSAMLAssertion'
-type IOneLoginSDK_GenerateSAMLAssertion_Call struct {
+// IOneLoginSDK_CreateRoleTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoleTyped'
+type IOneLoginSDK_CreateRoleTyped_Call struct {
 	*mock.Call
 }
 
-// GenerateSAMLAssertion is a helper method to define mock.On call
-//   - request models.GenerateSAMLTokenRequest
-func (_e *IOneLoginSDK_Expecter) GenerateSAMLAssertion(request interface{}) *IOneLoginSDK_GenerateSAMLAssertion_Call {
-	return &IOneLoginSDK_GenerateSAMLAssertion_Call{Call: _e.mock.On("GenerateSAMLAssertion", request)}
+// CreateRoleTyped is a helper method to define mock.On call
+//   - role *models.Role
+func (_e *IOneLoginSDK_Expecter) CreateRoleTyped(role interface{}) *IOneLoginSDK_CreateRoleTyped_Call {
+	return &IOneLoginSDK_CreateRoleTyped_Call{Call: _e.mock.On("CreateRoleTyped", role)}
 }
 
-func (_c *IOneLoginSDK_GenerateSAMLAssertion_Call) Run(run func(request models.GenerateSAMLTokenRequest)) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+func (_c *IOneLoginSDK_CreateRoleTyped_Call) Run(run func(role *models.Role)) *IOneLoginSDK_CreateRoleTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.GenerateSAMLTokenRequest))
+		run(args[0].(*models.Role))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GenerateSAMLAssertion_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+func (_c *IOneLoginSDK_CreateRoleTyped_Call) Return(_a0 *models.Response[models.Role], _a1 error) *IOneLoginSDK_CreateRoleTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GenerateSAMLAssertion_Call) RunAndReturn(run func(models.GenerateSAMLTokenRequest) (interface{}, error)) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+func (_c *IOneLoginSDK_CreateRoleTyped_Call) RunAndReturn(run func(*models.Role) (*models.Response[models.Role], error)) *IOneLoginSDK_CreateRoleTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccountId provides a mock function with given fields:
-func (_m *IOneLoginSDK) GetAccountId() string {
-	ret := _m.Called()
+// CreateRoleTypedWithContext provides a mock function with given fields: ctx, role
+func (_m *IOneLoginSDK) CreateRoleTypedWithContext(ctx context.Context, role *models.Role) (*models.Response[models.Role], error) {
+	ret := _m.Called(ctx, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAccountId")
+		panic("no return value specified for CreateRoleTypedWithContext")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 *models.Response[models.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Role) (*models.Response[models.Role], error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Role) *models.Response[models.Role]); ok {
+		r0 = rf(ctx, role)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Role])
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Role) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// IOneLoginSDK_GetAccountId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountId'
-type IOneLoginSDK_GetAccountId_Call struct {
+// IOneLoginSDK_CreateRoleTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoleTypedWithContext'
+type IOneLoginSDK_CreateRoleTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// GetAccountId is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) GetAccountId() *IOneLoginSDK_GetAccountId_Call {
-	return &IOneLoginSDK_GetAccountId_Call{Call: _e.mock.On("GetAccountId")}
+// CreateRoleTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *models.Role
+func (_e *IOneLoginSDK_Expecter) CreateRoleTypedWithContext(ctx interface{}, role interface{}) *IOneLoginSDK_CreateRoleTypedWithContext_Call {
+	return &IOneLoginSDK_CreateRoleTypedWithContext_Call{Call: _e.mock.On("CreateRoleTypedWithContext", ctx, role)}
 }
 
-func (_c *IOneLoginSDK_GetAccountId_Call) Run(run func()) *IOneLoginSDK_GetAccountId_Call {
+func (_c *IOneLoginSDK_CreateRoleTypedWithContext_Call) Run(run func(ctx context.Context, role *models.Role)) *IOneLoginSDK_CreateRoleTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*models.Role))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAccountId_Call) Return(_a0 string) *IOneLoginSDK_GetAccountId_Call {
-	_c.Call.Return(_a0)
+func (_c *IOneLoginSDK_CreateRoleTypedWithContext_Call) Return(_a0 *models.Response[models.Role], _a1 error) *IOneLoginSDK_CreateRoleTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAccountId_Call) RunAndReturn(run func() string) *IOneLoginSDK_GetAccountId_Call {
+func (_c *IOneLoginSDK_CreateRoleTypedWithContext_Call) RunAndReturn(run func(context.Context, *models.Role) (*models.Response[models.Role], error)) *IOneLoginSDK_CreateRoleTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAppByID provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetAppByID(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// CreateRoleWithContext provides a mock function with given fields: ctx, role
+func (_m *IOneLoginSDK) CreateRoleWithContext(ctx context.Context, role *models.Role) (interface{}, error) {
+	ret := _m.Called(ctx, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAppByID")
+		panic("no return value specified for CreateRoleWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Role) (interface{}, error)); ok {
+		return rf(ctx, role)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Role) interface{}); ok {
+		r0 = rf(ctx, role)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Role) error); ok {
+		r1 = rf(ctx, role)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2378,58 +2752,58 @@ func (_m *IOneLoginSDK) GetAppByID(id int, queryParams models.Queryable) (interf
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAppByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppByID'
-type IOneLoginSDK_GetAppByID_Call struct {
+// IOneLoginSDK_CreateRoleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoleWithContext'
+type IOneLoginSDK_CreateRoleWithContext_Call struct {
 	*mock.Call
 }
 
-// GetAppByID is a helper method to define mock.On call
-//   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAppByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppByID_Call {
-	return &IOneLoginSDK_GetAppByID_Call{Call: _e.mock.On("GetAppByID", id, queryParams)}
+// CreateRoleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *models.Role
+func (_e *IOneLoginSDK_Expecter) CreateRoleWithContext(ctx interface{}, role interface{}) *IOneLoginSDK_CreateRoleWithContext_Call {
+	return &IOneLoginSDK_CreateRoleWithContext_Call{Call: _e.mock.On("CreateRoleWithContext", ctx, role)}
 }
 
-func (_c *IOneLoginSDK_GetAppByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppByID_Call {
+func (_c *IOneLoginSDK_CreateRoleWithContext_Call) Run(run func(ctx context.Context, role *models.Role)) *IOneLoginSDK_CreateRoleWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(context.Context), args[1].(*models.Role))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppByID_Call {
+func (_c *IOneLoginSDK_CreateRoleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateRoleWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppByID_Call {
+func (_c *IOneLoginSDK_CreateRoleWithContext_Call) RunAndReturn(run func(context.Context, *models.Role) (interface{}, error)) *IOneLoginSDK_CreateRoleWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAppRuleByID provides a mock function with given fields: id, ruleID, queryParams
-func (_m *IOneLoginSDK) GetAppRuleByID(id int, ruleID int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, ruleID, queryParams)
+// CreateUser provides a mock function with given fields: user
+func (_m *IOneLoginSDK) CreateUser(user models.User) (interface{}, error) {
+	ret := _m.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAppRuleByID")
+		panic("no return value specified for CreateUser")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, ruleID, queryParams)
+	if rf, ok := ret.Get(0).(func(models.User) (interface{}, error)); ok {
+		return rf(user)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, models.Queryable) interface{}); ok {
-		r0 = rf(id, ruleID, queryParams)
+	if rf, ok := ret.Get(0).(func(models.User) interface{}); ok {
+		r0 = rf(user)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, models.Queryable) error); ok {
-		r1 = rf(id, ruleID, queryParams)
+	if rf, ok := ret.Get(1).(func(models.User) error); ok {
+		r1 = rf(user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2437,59 +2811,57 @@ func (_m *IOneLoginSDK) GetAppRuleByID(id int, ruleID int, queryParams models.Qu
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAppRuleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppRuleByID'
-type IOneLoginSDK_GetAppRuleByID_Call struct {
+// IOneLoginSDK_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type IOneLoginSDK_CreateUser_Call struct {
 	*mock.Call
 }
 
-// GetAppRuleByID is a helper method to define mock.On call
-//   - id int
-//   - ruleID int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAppRuleByID(id interface{}, ruleID interface{}, queryParams interface{}) *IOneLoginSDK_GetAppRuleByID_Call {
-	return &IOneLoginSDK_GetAppRuleByID_Call{Call: _e.mock.On("GetAppRuleByID", id, ruleID, queryParams)}
+// CreateUser is a helper method to define mock.On call
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) CreateUser(user interface{}) *IOneLoginSDK_CreateUser_Call {
+	return &IOneLoginSDK_CreateUser_Call{Call: _e.mock.On("CreateUser", user)}
 }
 
-func (_c *IOneLoginSDK_GetAppRuleByID_Call) Run(run func(id int, ruleID int, queryParams models.Queryable)) *IOneLoginSDK_GetAppRuleByID_Call {
+func (_c *IOneLoginSDK_CreateUser_Call) Run(run func(user models.User)) *IOneLoginSDK_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(models.Queryable))
+		run(args[0].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppRuleByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppRuleByID_Call {
+func (_c *IOneLoginSDK_CreateUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppRuleByID_Call) RunAndReturn(run func(int, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppRuleByID_Call {
+func (_c *IOneLoginSDK_CreateUser_Call) RunAndReturn(run func(models.User) (interface{}, error)) *IOneLoginSDK_CreateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAppRules provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetAppRules(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// CreateUserTyped provides a mock function with given fields: user
+func (_m *IOneLoginSDK) CreateUserTyped(user models.User) (*models.Response[models.User], error) {
+	ret := _m.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAppRules")
+		panic("no return value specified for CreateUserTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.User]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(models.User) (*models.Response[models.User], error)); ok {
+		return rf(user)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(models.User) *models.Response[models.User]); ok {
+		r0 = rf(user)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.User])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(models.User) error); ok {
+		r1 = rf(user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2497,58 +2869,57 @@ func (_m *IOneLoginSDK) GetAppRules(id int, queryParams models.Queryable) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAppRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppRules'
-type IOneLoginSDK_GetAppRules_Call struct {
+// IOneLoginSDK_CreateUserTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserTyped'
+type IOneLoginSDK_CreateUserTyped_Call struct {
 	*mock.Call
 }
 
-// GetAppRules is a helper method to define mock.On call
-//   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAppRules(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppRules_Call {
-	return &IOneLoginSDK_GetAppRules_Call{Call: _e.mock.On("GetAppRules", id, queryParams)}
+// CreateUserTyped is a helper method to define mock.On call
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) CreateUserTyped(user interface{}) *IOneLoginSDK_CreateUserTyped_Call {
+	return &IOneLoginSDK_CreateUserTyped_Call{Call: _e.mock.On("CreateUserTyped", user)}
 }
 
-func (_c *IOneLoginSDK_GetAppRules_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppRules_Call {
+func (_c *IOneLoginSDK_CreateUserTyped_Call) Run(run func(user models.User)) *IOneLoginSDK_CreateUserTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppRules_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppRules_Call {
+func (_c *IOneLoginSDK_CreateUserTyped_Call) Return(_a0 *models.Response[models.User], _a1 error) *IOneLoginSDK_CreateUserTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppRules_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppRules_Call {
+func (_c *IOneLoginSDK_CreateUserTyped_Call) RunAndReturn(run func(models.User) (*models.Response[models.User], error)) *IOneLoginSDK_CreateUserTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAppUsers provides a mock function with given fields: appID
-func (_m *IOneLoginSDK) GetAppUsers(appID int) (interface{}, error) {
-	ret := _m.Called(appID)
+// CreateUserTypedWithContext provides a mock function with given fields: ctx, user
+func (_m *IOneLoginSDK) CreateUserTypedWithContext(ctx context.Context, user models.User) (*models.Response[models.User], error) {
+	ret := _m.Called(ctx, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAppUsers")
+		panic("no return value specified for CreateUserTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.User]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(appID)
+	if rf, ok := ret.Get(0).(func(context.Context, models.User) (*models.Response[models.User], error)); ok {
+		return rf(ctx, user)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(appID)
+	if rf, ok := ret.Get(0).(func(context.Context, models.User) *models.Response[models.User]); ok {
+		r0 = rf(ctx, user)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.User])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(appID)
+	if rf, ok := ret.Get(1).(func(context.Context, models.User) error); ok {
+		r1 = rf(ctx, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2556,57 +2927,58 @@ func (_m *IOneLoginSDK) GetAppUsers(appID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAppUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppUsers'
-type IOneLoginSDK_GetAppUsers_Call struct {
+// IOneLoginSDK_CreateUserTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserTypedWithContext'
+type IOneLoginSDK_CreateUserTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// GetAppUsers is a helper method to define mock.On call
-//   - appID int
-func (_e *IOneLoginSDK_Expecter) GetAppUsers(appID interface{}) *IOneLoginSDK_GetAppUsers_Call {
-	return &IOneLoginSDK_GetAppUsers_Call{Call: _e.mock.On("GetAppUsers", appID)}
+// CreateUserTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) CreateUserTypedWithContext(ctx interface{}, user interface{}) *IOneLoginSDK_CreateUserTypedWithContext_Call {
+	return &IOneLoginSDK_CreateUserTypedWithContext_Call{Call: _e.mock.On("CreateUserTypedWithContext", ctx, user)}
 }
 
-func (_c *IOneLoginSDK_GetAppUsers_Call) Run(run func(appID int)) *IOneLoginSDK_GetAppUsers_Call {
+func (_c *IOneLoginSDK_CreateUserTypedWithContext_Call) Run(run func(ctx context.Context, user models.User)) *IOneLoginSDK_CreateUserTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppUsers_Call {
+func (_c *IOneLoginSDK_CreateUserTypedWithContext_Call) Return(_a0 *models.Response[models.User], _a1 error) *IOneLoginSDK_CreateUserTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAppUsers_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetAppUsers_Call {
+func (_c *IOneLoginSDK_CreateUserTypedWithContext_Call) RunAndReturn(run func(context.Context, models.User) (*models.Response[models.User], error)) *IOneLoginSDK_CreateUserTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetApps provides a mock function with given fields: queryParams
-func (_m *IOneLoginSDK) GetApps(queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(queryParams)
+// CreateUserWithContext provides a mock function with given fields: ctx, user
+func (_m *IOneLoginSDK) CreateUserWithContext(ctx context.Context, user models.User) (interface{}, error) {
+	ret := _m.Called(ctx, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetApps")
+		panic("no return value specified for CreateUserWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, models.User) (interface{}, error)); ok {
+		return rf(ctx, user)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, models.User) interface{}); ok {
+		r0 = rf(ctx, user)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, models.User) error); ok {
+		r1 = rf(ctx, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2614,57 +2986,58 @@ func (_m *IOneLoginSDK) GetApps(queryParams models.Queryable) (interface{}, erro
 	return r0, r1
 }
 
-// IOneLoginSDK_GetApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApps'
-type IOneLoginSDK_GetApps_Call struct {
+// IOneLoginSDK_CreateUserWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserWithContext'
+type IOneLoginSDK_CreateUserWithContext_Call struct {
 	*mock.Call
 }
 
-// GetApps is a helper method to define mock.On call
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetApps(queryParams interface{}) *IOneLoginSDK_GetApps_Call {
-	return &IOneLoginSDK_GetApps_Call{Call: _e.mock.On("GetApps", queryParams)}
+// CreateUserWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) CreateUserWithContext(ctx interface{}, user interface{}) *IOneLoginSDK_CreateUserWithContext_Call {
+	return &IOneLoginSDK_CreateUserWithContext_Call{Call: _e.mock.On("CreateUserWithContext", ctx, user)}
 }
 
-func (_c *IOneLoginSDK_GetApps_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetApps_Call {
+func (_c *IOneLoginSDK_CreateUserWithContext_Call) Run(run func(ctx context.Context, user models.User)) *IOneLoginSDK_CreateUserWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(context.Context), args[1].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetApps_Call {
+func (_c *IOneLoginSDK_CreateUserWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_CreateUserWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetApps_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetApps_Call {
+func (_c *IOneLoginSDK_CreateUserWithContext_Call) RunAndReturn(run func(context.Context, models.User) (interface{}, error)) *IOneLoginSDK_CreateUserWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthClaims provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetAuthClaims(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// DeleteApp provides a mock function with given fields: id
+func (_m *IOneLoginSDK) DeleteApp(id int) (interface{}, error) {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthClaims")
+		panic("no return value specified for DeleteApp")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2672,58 +3045,57 @@ func (_m *IOneLoginSDK) GetAuthClaims(id int, queryParams models.Queryable) (int
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAuthClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthClaims'
-type IOneLoginSDK_GetAuthClaims_Call struct {
+// IOneLoginSDK_DeleteApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteApp'
+type IOneLoginSDK_DeleteApp_Call struct {
 	*mock.Call
 }
 
-// GetAuthClaims is a helper method to define mock.On call
+// DeleteApp is a helper method to define mock.On call
 //   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAuthClaims(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthClaims_Call {
-	return &IOneLoginSDK_GetAuthClaims_Call{Call: _e.mock.On("GetAuthClaims", id, queryParams)}
+func (_e *IOneLoginSDK_Expecter) DeleteApp(id interface{}) *IOneLoginSDK_DeleteApp_Call {
+	return &IOneLoginSDK_DeleteApp_Call{Call: _e.mock.On("DeleteApp", id)}
 }
 
-func (_c *IOneLoginSDK_GetAuthClaims_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthClaims_Call {
+func (_c *IOneLoginSDK_DeleteApp_Call) Run(run func(id int)) *IOneLoginSDK_DeleteApp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthClaims_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthClaims_Call {
+func (_c *IOneLoginSDK_DeleteApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteApp_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthClaims_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthClaims_Call {
+func (_c *IOneLoginSDK_DeleteApp_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteApp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthServerByID provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetAuthServerByID(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// DeleteAppRule provides a mock function with given fields: id, ruleID, queryParams
+func (_m *IOneLoginSDK) DeleteAppRule(id int, ruleID int, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(id, ruleID, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthServerByID")
+		panic("no return value specified for DeleteAppRule")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(int, int, map[string]string) (interface{}, error)); ok {
+		return rf(id, ruleID, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(int, int, map[string]string) interface{}); ok {
+		r0 = rf(id, ruleID, queryParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(int, int, map[string]string) error); ok {
+		r1 = rf(id, ruleID, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2731,58 +3103,59 @@ func (_m *IOneLoginSDK) GetAuthServerByID(id int, queryParams models.Queryable)
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAuthServerByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServerByID'
-type IOneLoginSDK_GetAuthServerByID_Call struct {
+// IOneLoginSDK_DeleteAppRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAppRule'
+type IOneLoginSDK_DeleteAppRule_Call struct {
 	*mock.Call
 }
 
-// GetAuthServerByID is a helper method to define mock.On call
+// DeleteAppRule is a helper method to define mock.On call
 //   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAuthServerByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServerByID_Call {
-	return &IOneLoginSDK_GetAuthServerByID_Call{Call: _e.mock.On("GetAuthServerByID", id, queryParams)}
+//   - ruleID int
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) DeleteAppRule(id interface{}, ruleID interface{}, queryParams interface{}) *IOneLoginSDK_DeleteAppRule_Call {
+	return &IOneLoginSDK_DeleteAppRule_Call{Call: _e.mock.On("DeleteAppRule", id, ruleID, queryParams)}
 }
 
-func (_c *IOneLoginSDK_GetAuthServerByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServerByID_Call {
+func (_c *IOneLoginSDK_DeleteAppRule_Call) Run(run func(id int, ruleID int, queryParams map[string]string)) *IOneLoginSDK_DeleteAppRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(int), args[1].(int), args[2].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthServerByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServerByID_Call {
+func (_c *IOneLoginSDK_DeleteAppRule_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAppRule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthServerByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServerByID_Call {
+func (_c *IOneLoginSDK_DeleteAppRule_Call) RunAndReturn(run func(int, int, map[string]string) (interface{}, error)) *IOneLoginSDK_DeleteAppRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthServerScopes provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetAuthServerScopes(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// DeleteAppRuleWithContext provides a mock function with given fields: ctx, id, ruleID, queryParams
+func (_m *IOneLoginSDK) DeleteAppRuleWithContext(ctx context.Context, id int, ruleID int, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(ctx, id, ruleID, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthServerScopes")
+		panic("no return value specified for DeleteAppRuleWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, map[string]string) (interface{}, error)); ok {
+		return rf(ctx, id, ruleID, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, map[string]string) interface{}); ok {
+		r0 = rf(ctx, id, ruleID, queryParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, map[string]string) error); ok {
+		r1 = rf(ctx, id, ruleID, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2790,58 +3163,60 @@ func (_m *IOneLoginSDK) GetAuthServerScopes(id int, queryParams models.Queryable
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAuthServerScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServerScopes'
-type IOneLoginSDK_GetAuthServerScopes_Call struct {
+// IOneLoginSDK_DeleteAppRuleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAppRuleWithContext'
+type IOneLoginSDK_DeleteAppRuleWithContext_Call struct {
 	*mock.Call
 }
 
-// GetAuthServerScopes is a helper method to define mock.On call
+// DeleteAppRuleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAuthServerScopes(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServerScopes_Call {
-	return &IOneLoginSDK_GetAuthServerScopes_Call{Call: _e.mock.On("GetAuthServerScopes", id, queryParams)}
+//   - ruleID int
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) DeleteAppRuleWithContext(ctx interface{}, id interface{}, ruleID interface{}, queryParams interface{}) *IOneLoginSDK_DeleteAppRuleWithContext_Call {
+	return &IOneLoginSDK_DeleteAppRuleWithContext_Call{Call: _e.mock.On("DeleteAppRuleWithContext", ctx, id, ruleID, queryParams)}
 }
 
-func (_c *IOneLoginSDK_GetAuthServerScopes_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServerScopes_Call {
+func (_c *IOneLoginSDK_DeleteAppRuleWithContext_Call) Run(run func(ctx context.Context, id int, ruleID int, queryParams map[string]string)) *IOneLoginSDK_DeleteAppRuleWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthServerScopes_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServerScopes_Call {
+func (_c *IOneLoginSDK_DeleteAppRuleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAppRuleWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthServerScopes_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServerScopes_Call {
+func (_c *IOneLoginSDK_DeleteAppRuleWithContext_Call) RunAndReturn(run func(context.Context, int, int, map[string]string) (interface{}, error)) *IOneLoginSDK_DeleteAppRuleWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAuthServers provides a mock function with given fields: queryParams
-func (_m *IOneLoginSDK) GetAuthServers(queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(queryParams)
+// DeleteAppWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) DeleteAppWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAuthServers")
+		panic("no return value specified for DeleteAppWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2849,57 +3224,58 @@ func (_m *IOneLoginSDK) GetAuthServers(queryParams models.Queryable) (interface{
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAuthServers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServers'
-type IOneLoginSDK_GetAuthServers_Call struct {
+// IOneLoginSDK_DeleteAppWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAppWithContext'
+type IOneLoginSDK_DeleteAppWithContext_Call struct {
 	*mock.Call
 }
 
-// GetAuthServers is a helper method to define mock.On call
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetAuthServers(queryParams interface{}) *IOneLoginSDK_GetAuthServers_Call {
-	return &IOneLoginSDK_GetAuthServers_Call{Call: _e.mock.On("GetAuthServers", queryParams)}
+// DeleteAppWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *IOneLoginSDK_Expecter) DeleteAppWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_DeleteAppWithContext_Call {
+	return &IOneLoginSDK_DeleteAppWithContext_Call{Call: _e.mock.On("DeleteAppWithContext", ctx, id)}
 }
 
-func (_c *IOneLoginSDK_GetAuthServers_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetAuthServers_Call {
+func (_c *IOneLoginSDK_DeleteAppWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_DeleteAppWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthServers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServers_Call {
+func (_c *IOneLoginSDK_DeleteAppWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAppWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAuthServers_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServers_Call {
+func (_c *IOneLoginSDK_DeleteAppWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DeleteAppWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAvailableMFAFactors provides a mock function with given fields: userID
-func (_m *IOneLoginSDK) GetAvailableMFAFactors(userID int) (interface{}, error) {
-	ret := _m.Called(userID)
+// DeleteAuthClaim provides a mock function with given fields: id, claimID
+func (_m *IOneLoginSDK) DeleteAuthClaim(id int, claimID int) (interface{}, error) {
+	ret := _m.Called(id, claimID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAvailableMFAFactors")
+		panic("no return value specified for DeleteAuthClaim")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
+		return rf(id, claimID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
+		r0 = rf(id, claimID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(id, claimID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2907,57 +3283,58 @@ func (_m *IOneLoginSDK) GetAvailableMFAFactors(userID int) (interface{}, error)
 	return r0, r1
 }
 
-// IOneLoginSDK_GetAvailableMFAFactors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailableMFAFactors'
-type IOneLoginSDK_GetAvailableMFAFactors_Call struct {
+// IOneLoginSDK_DeleteAuthClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthClaim'
+type IOneLoginSDK_DeleteAuthClaim_Call struct {
 	*mock.Call
 }
 
-// GetAvailableMFAFactors is a helper method to define mock.On call
-//   - userID int
-func (_e *IOneLoginSDK_Expecter) GetAvailableMFAFactors(userID interface{}) *IOneLoginSDK_GetAvailableMFAFactors_Call {
-	return &IOneLoginSDK_GetAvailableMFAFactors_Call{Call: _e.mock.On("GetAvailableMFAFactors", userID)}
+// DeleteAuthClaim is a helper method to define mock.On call
+//   - id int
+//   - claimID int
+func (_e *IOneLoginSDK_Expecter) DeleteAuthClaim(id interface{}, claimID interface{}) *IOneLoginSDK_DeleteAuthClaim_Call {
+	return &IOneLoginSDK_DeleteAuthClaim_Call{Call: _e.mock.On("DeleteAuthClaim", id, claimID)}
 }
 
-func (_c *IOneLoginSDK_GetAvailableMFAFactors_Call) Run(run func(userID int)) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+func (_c *IOneLoginSDK_DeleteAuthClaim_Call) Run(run func(id int, claimID int)) *IOneLoginSDK_DeleteAuthClaim_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(int), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAvailableMFAFactors_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+func (_c *IOneLoginSDK_DeleteAuthClaim_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthClaim_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetAvailableMFAFactors_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+func (_c *IOneLoginSDK_DeleteAuthClaim_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthClaim_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetClientApps provides a mock function with given fields: id
-func (_m *IOneLoginSDK) GetClientApps(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// DeleteAuthClaimWithContext provides a mock function with given fields: ctx, id, claimID
+func (_m *IOneLoginSDK) DeleteAuthClaimWithContext(ctx context.Context, id int, claimID int) (interface{}, error) {
+	ret := _m.Called(ctx, id, claimID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetClientApps")
+		panic("no return value specified for DeleteAuthClaimWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (interface{}, error)); ok {
+		return rf(ctx, id, claimID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) interface{}); ok {
+		r0 = rf(ctx, id, claimID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, id, claimID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2965,57 +3342,59 @@ func (_m *IOneLoginSDK) GetClientApps(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetClientApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientApps'
-type IOneLoginSDK_GetClientApps_Call struct {
+// IOneLoginSDK_DeleteAuthClaimWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthClaimWithContext'
+type IOneLoginSDK_DeleteAuthClaimWithContext_Call struct {
 	*mock.Call
 }
 
-// GetClientApps is a helper method to define mock.On call
+// DeleteAuthClaimWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-func (_e *IOneLoginSDK_Expecter) GetClientApps(id interface{}) *IOneLoginSDK_GetClientApps_Call {
-	return &IOneLoginSDK_GetClientApps_Call{Call: _e.mock.On("GetClientApps", id)}
+//   - claimID int
+func (_e *IOneLoginSDK_Expecter) DeleteAuthClaimWithContext(ctx interface{}, id interface{}, claimID interface{}) *IOneLoginSDK_DeleteAuthClaimWithContext_Call {
+	return &IOneLoginSDK_DeleteAuthClaimWithContext_Call{Call: _e.mock.On("DeleteAuthClaimWithContext", ctx, id, claimID)}
 }
 
-func (_c *IOneLoginSDK_GetClientApps_Call) Run(run func(id int)) *IOneLoginSDK_GetClientApps_Call {
+func (_c *IOneLoginSDK_DeleteAuthClaimWithContext_Call) Run(run func(ctx context.Context, id int, claimID int)) *IOneLoginSDK_DeleteAuthClaimWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetClientApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetClientApps_Call {
+func (_c *IOneLoginSDK_DeleteAuthClaimWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthClaimWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetClientApps_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetClientApps_Call {
+func (_c *IOneLoginSDK_DeleteAuthClaimWithContext_Call) RunAndReturn(run func(context.Context, int, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthClaimWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCustomAttributes provides a mock function with given fields:
-func (_m *IOneLoginSDK) GetCustomAttributes() (interface{}, error) {
-	ret := _m.Called()
+// DeleteAuthServer provides a mock function with given fields: id
+func (_m *IOneLoginSDK) DeleteAuthServer(id int) (interface{}, error) {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCustomAttributes")
+		panic("no return value specified for DeleteAuthServer")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func() interface{}); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3023,56 +3402,57 @@ func (_m *IOneLoginSDK) GetCustomAttributes() (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetCustomAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomAttributes'
-type IOneLoginSDK_GetCustomAttributes_Call struct {
+// IOneLoginSDK_DeleteAuthServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthServer'
+type IOneLoginSDK_DeleteAuthServer_Call struct {
 	*mock.Call
 }
 
-// GetCustomAttributes is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) GetCustomAttributes() *IOneLoginSDK_GetCustomAttributes_Call {
-	return &IOneLoginSDK_GetCustomAttributes_Call{Call: _e.mock.On("GetCustomAttributes")}
+// DeleteAuthServer is a helper method to define mock.On call
+//   - id int
+func (_e *IOneLoginSDK_Expecter) DeleteAuthServer(id interface{}) *IOneLoginSDK_DeleteAuthServer_Call {
+	return &IOneLoginSDK_DeleteAuthServer_Call{Call: _e.mock.On("DeleteAuthServer", id)}
 }
 
-func (_c *IOneLoginSDK_GetCustomAttributes_Call) Run(run func()) *IOneLoginSDK_GetCustomAttributes_Call {
+func (_c *IOneLoginSDK_DeleteAuthServer_Call) Run(run func(id int)) *IOneLoginSDK_DeleteAuthServer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetCustomAttributes_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetCustomAttributes_Call {
+func (_c *IOneLoginSDK_DeleteAuthServer_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthServer_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetCustomAttributes_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_GetCustomAttributes_Call {
+func (_c *IOneLoginSDK_DeleteAuthServer_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteAuthServer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEnrolledMFAFactors provides a mock function with given fields: userID
-func (_m *IOneLoginSDK) GetEnrolledMFAFactors(userID int) (interface{}, error) {
-	ret := _m.Called(userID)
+// DeleteAuthServerScope provides a mock function with given fields: id, scopeID
+func (_m *IOneLoginSDK) DeleteAuthServerScope(id int, scopeID int) (interface{}, error) {
+	ret := _m.Called(id, scopeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEnrolledMFAFactors")
+		panic("no return value specified for DeleteAuthServerScope")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
+		return rf(id, scopeID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
+		r0 = rf(id, scopeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(id, scopeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3080,57 +3460,58 @@ func (_m *IOneLoginSDK) GetEnrolledMFAFactors(userID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetEnrolledMFAFactors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrolledMFAFactors'
-type IOneLoginSDK_GetEnrolledMFAFactors_Call struct {
+// IOneLoginSDK_DeleteAuthServerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthServerScope'
+type IOneLoginSDK_DeleteAuthServerScope_Call struct {
 	*mock.Call
 }
 
-// GetEnrolledMFAFactors is a helper method to define mock.On call
-//   - userID int
-func (_e *IOneLoginSDK_Expecter) GetEnrolledMFAFactors(userID interface{}) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
-	return &IOneLoginSDK_GetEnrolledMFAFactors_Call{Call: _e.mock.On("GetEnrolledMFAFactors", userID)}
+// DeleteAuthServerScope is a helper method to define mock.On call
+//   - id int
+//   - scopeID int
+func (_e *IOneLoginSDK_Expecter) DeleteAuthServerScope(id interface{}, scopeID interface{}) *IOneLoginSDK_DeleteAuthServerScope_Call {
+	return &IOneLoginSDK_DeleteAuthServerScope_Call{Call: _e.mock.On("DeleteAuthServerScope", id, scopeID)}
 }
 
-func (_c *IOneLoginSDK_GetEnrolledMFAFactors_Call) Run(run func(userID int)) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerScope_Call) Run(run func(id int, scopeID int)) *IOneLoginSDK_DeleteAuthServerScope_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(int), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetEnrolledMFAFactors_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerScope_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthServerScope_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetEnrolledMFAFactors_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerScope_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthServerScope_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetEnvironmentVariable provides a mock function with given fields: envVarID
-func (_m *IOneLoginSDK) GetEnvironmentVariable(envVarID int) (interface{}, error) {
-	ret := _m.Called(envVarID)
+// DeleteAuthServerScopeWithContext provides a mock function with given fields: ctx, id, scopeID
+func (_m *IOneLoginSDK) DeleteAuthServerScopeWithContext(ctx context.Context, id int, scopeID int) (interface{}, error) {
+	ret := _m.Called(ctx, id, scopeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetEnvironmentVariable")
+		panic("no return value specified for DeleteAuthServerScopeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(envVarID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (interface{}, error)); ok {
+		return rf(ctx, id, scopeID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(envVarID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) interface{}); ok {
+		r0 = rf(ctx, id, scopeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(envVarID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, id, scopeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3138,57 +3519,59 @@ func (_m *IOneLoginSDK) GetEnvironmentVariable(envVarID int) (interface{}, error
 	return r0, r1
 }
 
-// IOneLoginSDK_GetEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentVariable'
-type IOneLoginSDK_GetEnvironmentVariable_Call struct {
+// IOneLoginSDK_DeleteAuthServerScopeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthServerScopeWithContext'
+type IOneLoginSDK_DeleteAuthServerScopeWithContext_Call struct {
 	*mock.Call
 }
 
-// GetEnvironmentVariable is a helper method to define mock.On call
-//   - envVarID int
-func (_e *IOneLoginSDK_Expecter) GetEnvironmentVariable(envVarID interface{}) *IOneLoginSDK_GetEnvironmentVariable_Call {
-	return &IOneLoginSDK_GetEnvironmentVariable_Call{Call: _e.mock.On("GetEnvironmentVariable", envVarID)}
+// DeleteAuthServerScopeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - scopeID int
+func (_e *IOneLoginSDK_Expecter) DeleteAuthServerScopeWithContext(ctx interface{}, id interface{}, scopeID interface{}) *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call {
+	return &IOneLoginSDK_DeleteAuthServerScopeWithContext_Call{Call: _e.mock.On("DeleteAuthServerScopeWithContext", ctx, id, scopeID)}
 }
 
-func (_c *IOneLoginSDK_GetEnvironmentVariable_Call) Run(run func(envVarID int)) *IOneLoginSDK_GetEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call) Run(run func(ctx context.Context, id int, scopeID int)) *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetEnvironmentVariable_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call) RunAndReturn(run func(context.Context, int, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthServerScopeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGroupByID provides a mock function with given fields: groupID
-func (_m *IOneLoginSDK) GetGroupByID(groupID int) (interface{}, error) {
-	ret := _m.Called(groupID)
+// DeleteAuthServerWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) DeleteAuthServerWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGroupByID")
+		panic("no return value specified for DeleteAuthServerWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(groupID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(groupID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(groupID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3196,57 +3579,58 @@ func (_m *IOneLoginSDK) GetGroupByID(groupID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetGroupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByID'
-type IOneLoginSDK_GetGroupByID_Call struct {
+// IOneLoginSDK_DeleteAuthServerWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAuthServerWithContext'
+type IOneLoginSDK_DeleteAuthServerWithContext_Call struct {
 	*mock.Call
 }
 
-// GetGroupByID is a helper method to define mock.On call
-//   - groupID int
-func (_e *IOneLoginSDK_Expecter) GetGroupByID(groupID interface{}) *IOneLoginSDK_GetGroupByID_Call {
-	return &IOneLoginSDK_GetGroupByID_Call{Call: _e.mock.On("GetGroupByID", groupID)}
+// DeleteAuthServerWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *IOneLoginSDK_Expecter) DeleteAuthServerWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_DeleteAuthServerWithContext_Call {
+	return &IOneLoginSDK_DeleteAuthServerWithContext_Call{Call: _e.mock.On("DeleteAuthServerWithContext", ctx, id)}
 }
 
-func (_c *IOneLoginSDK_GetGroupByID_Call) Run(run func(groupID int)) *IOneLoginSDK_GetGroupByID_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_DeleteAuthServerWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetGroupByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetGroupByID_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteAuthServerWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetGroupByID_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetGroupByID_Call {
+func (_c *IOneLoginSDK_DeleteAuthServerWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DeleteAuthServerWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGroups provides a mock function with given fields: queryParams
-func (_m *IOneLoginSDK) GetGroups(queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(queryParams)
+// DeleteClientApp provides a mock function with given fields: id, clientID
+func (_m *IOneLoginSDK) DeleteClientApp(id int, clientID int) (interface{}, error) {
+	ret := _m.Called(id, clientID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGroups")
+		panic("no return value specified for DeleteClientApp")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(queryParams)
+	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
+		return rf(id, clientID)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(queryParams)
+	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
+		r0 = rf(id, clientID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(queryParams)
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(id, clientID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3254,57 +3638,58 @@ func (_m *IOneLoginSDK) GetGroups(queryParams models.Queryable) (interface{}, er
 	return r0, r1
 }
 
-// IOneLoginSDK_GetGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroups'
-type IOneLoginSDK_GetGroups_Call struct {
+// IOneLoginSDK_DeleteClientApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClientApp'
+type IOneLoginSDK_DeleteClientApp_Call struct {
 	*mock.Call
 }
 
-// GetGroups is a helper method to define mock.On call
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetGroups(queryParams interface{}) *IOneLoginSDK_GetGroups_Call {
-	return &IOneLoginSDK_GetGroups_Call{Call: _e.mock.On("GetGroups", queryParams)}
+// DeleteClientApp is a helper method to define mock.On call
+//   - id int
+//   - clientID int
+func (_e *IOneLoginSDK_Expecter) DeleteClientApp(id interface{}, clientID interface{}) *IOneLoginSDK_DeleteClientApp_Call {
+	return &IOneLoginSDK_DeleteClientApp_Call{Call: _e.mock.On("DeleteClientApp", id, clientID)}
 }
 
-func (_c *IOneLoginSDK_GetGroups_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetGroups_Call {
+func (_c *IOneLoginSDK_DeleteClientApp_Call) Run(run func(id int, clientID int)) *IOneLoginSDK_DeleteClientApp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(int), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetGroups_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetGroups_Call {
+func (_c *IOneLoginSDK_DeleteClientApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteClientApp_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetGroups_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetGroups_Call {
+func (_c *IOneLoginSDK_DeleteClientApp_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_DeleteClientApp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetHook provides a mock function with given fields: hookID, query
-func (_m *IOneLoginSDK) GetHook(hookID int, query models.Queryable) (interface{}, error) {
-	ret := _m.Called(hookID, query)
+// DeleteClientAppWithContext provides a mock function with given fields: ctx, id, clientID
+func (_m *IOneLoginSDK) DeleteClientAppWithContext(ctx context.Context, id int, clientID int) (interface{}, error) {
+	ret := _m.Called(ctx, id, clientID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetHook")
+		panic("no return value specified for DeleteClientAppWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(hookID, query)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (interface{}, error)); ok {
+		return rf(ctx, id, clientID)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(hookID, query)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) interface{}); ok {
+		r0 = rf(ctx, id, clientID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(hookID, query)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, id, clientID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3312,58 +3697,59 @@ func (_m *IOneLoginSDK) GetHook(hookID int, query models.Queryable) (interface{}
 	return r0, r1
 }
 
-// IOneLoginSDK_GetHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHook'
-type IOneLoginSDK_GetHook_Call struct {
+// IOneLoginSDK_DeleteClientAppWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteClientAppWithContext'
+type IOneLoginSDK_DeleteClientAppWithContext_Call struct {
 	*mock.Call
 }
 
-// GetHook is a helper method to define mock.On call
-//   - hookID int
-//   - query models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetHook(hookID interface{}, query interface{}) *IOneLoginSDK_GetHook_Call {
-	return &IOneLoginSDK_GetHook_Call{Call: _e.mock.On("GetHook", hookID, query)}
+// DeleteClientAppWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - clientID int
+func (_e *IOneLoginSDK_Expecter) DeleteClientAppWithContext(ctx interface{}, id interface{}, clientID interface{}) *IOneLoginSDK_DeleteClientAppWithContext_Call {
+	return &IOneLoginSDK_DeleteClientAppWithContext_Call{Call: _e.mock.On("DeleteClientAppWithContext", ctx, id, clientID)}
 }
 
-func (_c *IOneLoginSDK_GetHook_Call) Run(run func(hookID int, query models.Queryable)) *IOneLoginSDK_GetHook_Call {
+func (_c *IOneLoginSDK_DeleteClientAppWithContext_Call) Run(run func(ctx context.Context, id int, clientID int)) *IOneLoginSDK_DeleteClientAppWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetHook_Call {
+func (_c *IOneLoginSDK_DeleteClientAppWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteClientAppWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetHook_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetHook_Call {
+func (_c *IOneLoginSDK_DeleteClientAppWithContext_Call) RunAndReturn(run func(context.Context, int, int) (interface{}, error)) *IOneLoginSDK_DeleteClientAppWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetHookLogs provides a mock function with given fields: hookID, query
-func (_m *IOneLoginSDK) GetHookLogs(hookID int, query models.Queryable) (interface{}, error) {
-	ret := _m.Called(hookID, query)
+// DeleteEnvironmentVariable provides a mock function with given fields: envVarID
+func (_m *IOneLoginSDK) DeleteEnvironmentVariable(envVarID int) (interface{}, error) {
+	ret := _m.Called(envVarID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetHookLogs")
+		panic("no return value specified for DeleteEnvironmentVariable")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(hookID, query)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(envVarID)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(hookID, query)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(envVarID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(hookID, query)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(envVarID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3371,58 +3757,57 @@ func (_m *IOneLoginSDK) GetHookLogs(hookID int, query models.Queryable) (interfa
 	return r0, r1
 }
 
-// IOneLoginSDK_GetHookLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHookLogs'
-type IOneLoginSDK_GetHookLogs_Call struct {
+// IOneLoginSDK_DeleteEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvironmentVariable'
+type IOneLoginSDK_DeleteEnvironmentVariable_Call struct {
 	*mock.Call
 }
 
-// GetHookLogs is a helper method to define mock.On call
-//   - hookID int
-//   - query models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetHookLogs(hookID interface{}, query interface{}) *IOneLoginSDK_GetHookLogs_Call {
-	return &IOneLoginSDK_GetHookLogs_Call{Call: _e.mock.On("GetHookLogs", hookID, query)}
+// DeleteEnvironmentVariable is a helper method to define mock.On call
+//   - envVarID int
+func (_e *IOneLoginSDK_Expecter) DeleteEnvironmentVariable(envVarID interface{}) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
+	return &IOneLoginSDK_DeleteEnvironmentVariable_Call{Call: _e.mock.On("DeleteEnvironmentVariable", envVarID)}
 }
 
-func (_c *IOneLoginSDK_GetHookLogs_Call) Run(run func(hookID int, query models.Queryable)) *IOneLoginSDK_GetHookLogs_Call {
+func (_c *IOneLoginSDK_DeleteEnvironmentVariable_Call) Run(run func(envVarID int)) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetHookLogs_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetHookLogs_Call {
+func (_c *IOneLoginSDK_DeleteEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetHookLogs_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetHookLogs_Call {
+func (_c *IOneLoginSDK_DeleteEnvironmentVariable_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteEnvironmentVariable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMapping provides a mock function with given fields: mappingID
-func (_m *IOneLoginSDK) GetMapping(mappingID int) (interface{}, error) {
-	ret := _m.Called(mappingID)
+// DeleteEnvironmentVariableWithContext provides a mock function with given fields: ctx, envVarID
+func (_m *IOneLoginSDK) DeleteEnvironmentVariableWithContext(ctx context.Context, envVarID int) (interface{}, error) {
+	ret := _m.Called(ctx, envVarID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMapping")
+		panic("no return value specified for DeleteEnvironmentVariableWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(mappingID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, envVarID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(mappingID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, envVarID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(mappingID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, envVarID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3430,57 +3815,58 @@ func (_m *IOneLoginSDK) GetMapping(mappingID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMapping'
-type IOneLoginSDK_GetMapping_Call struct {
+// IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvironmentVariableWithContext'
+type IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call struct {
 	*mock.Call
 }
 
-// GetMapping is a helper method to define mock.On call
-//   - mappingID int
-func (_e *IOneLoginSDK_Expecter) GetMapping(mappingID interface{}) *IOneLoginSDK_GetMapping_Call {
-	return &IOneLoginSDK_GetMapping_Call{Call: _e.mock.On("GetMapping", mappingID)}
+// DeleteEnvironmentVariableWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - envVarID int
+func (_e *IOneLoginSDK_Expecter) DeleteEnvironmentVariableWithContext(ctx interface{}, envVarID interface{}) *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call {
+	return &IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call{Call: _e.mock.On("DeleteEnvironmentVariableWithContext", ctx, envVarID)}
 }
 
-func (_c *IOneLoginSDK_GetMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_GetMapping_Call {
+func (_c *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call) Run(run func(ctx context.Context, envVarID int)) *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetMapping_Call {
+func (_c *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetMapping_Call {
+func (_c *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DeleteEnvironmentVariableWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrivilege provides a mock function with given fields: privilegeID
-func (_m *IOneLoginSDK) GetPrivilege(privilegeID string) (interface{}, error) {
-	ret := _m.Called(privilegeID)
+// DeleteHook provides a mock function with given fields: hookID
+func (_m *IOneLoginSDK) DeleteHook(hookID int) (interface{}, error) {
+	ret := _m.Called(hookID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrivilege")
+		panic("no return value specified for DeleteHook")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(hookID)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(hookID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(privilegeID)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(hookID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3488,57 +3874,57 @@ func (_m *IOneLoginSDK) GetPrivilege(privilegeID string) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetPrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilege'
-type IOneLoginSDK_GetPrivilege_Call struct {
+// IOneLoginSDK_DeleteHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHook'
+type IOneLoginSDK_DeleteHook_Call struct {
 	*mock.Call
 }
 
-// GetPrivilege is a helper method to define mock.On call
-//   - privilegeID string
-func (_e *IOneLoginSDK_Expecter) GetPrivilege(privilegeID interface{}) *IOneLoginSDK_GetPrivilege_Call {
-	return &IOneLoginSDK_GetPrivilege_Call{Call: _e.mock.On("GetPrivilege", privilegeID)}
+// DeleteHook is a helper method to define mock.On call
+//   - hookID int
+func (_e *IOneLoginSDK_Expecter) DeleteHook(hookID interface{}) *IOneLoginSDK_DeleteHook_Call {
+	return &IOneLoginSDK_DeleteHook_Call{Call: _e.mock.On("DeleteHook", hookID)}
 }
 
-func (_c *IOneLoginSDK_GetPrivilege_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilege_Call {
+func (_c *IOneLoginSDK_DeleteHook_Call) Run(run func(hookID int)) *IOneLoginSDK_DeleteHook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetPrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilege_Call {
+func (_c *IOneLoginSDK_DeleteHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteHook_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetPrivilege_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GetPrivilege_Call {
+func (_c *IOneLoginSDK_DeleteHook_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteHook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrivilegeRoles provides a mock function with given fields: privilegeID
-func (_m *IOneLoginSDK) GetPrivilegeRoles(privilegeID string) (interface{}, error) {
-	ret := _m.Called(privilegeID)
+// DeleteHookWithContext provides a mock function with given fields: ctx, hookID
+func (_m *IOneLoginSDK) DeleteHookWithContext(ctx context.Context, hookID int) (interface{}, error) {
+	ret := _m.Called(ctx, hookID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrivilegeRoles")
+		panic("no return value specified for DeleteHookWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, hookID)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, hookID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(privilegeID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, hookID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3546,57 +3932,58 @@ func (_m *IOneLoginSDK) GetPrivilegeRoles(privilegeID string) (interface{}, erro
 	return r0, r1
 }
 
-// IOneLoginSDK_GetPrivilegeRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeRoles'
-type IOneLoginSDK_GetPrivilegeRoles_Call struct {
+// IOneLoginSDK_DeleteHookWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteHookWithContext'
+type IOneLoginSDK_DeleteHookWithContext_Call struct {
 	*mock.Call
 }
 
-// GetPrivilegeRoles is a helper method to define mock.On call
-//   - privilegeID string
-func (_e *IOneLoginSDK_Expecter) GetPrivilegeRoles(privilegeID interface{}) *IOneLoginSDK_GetPrivilegeRoles_Call {
-	return &IOneLoginSDK_GetPrivilegeRoles_Call{Call: _e.mock.On("GetPrivilegeRoles", privilegeID)}
+// DeleteHookWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hookID int
+func (_e *IOneLoginSDK_Expecter) DeleteHookWithContext(ctx interface{}, hookID interface{}) *IOneLoginSDK_DeleteHookWithContext_Call {
+	return &IOneLoginSDK_DeleteHookWithContext_Call{Call: _e.mock.On("DeleteHookWithContext", ctx, hookID)}
 }
 
-func (_c *IOneLoginSDK_GetPrivilegeRoles_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilegeRoles_Call {
+func (_c *IOneLoginSDK_DeleteHookWithContext_Call) Run(run func(ctx context.Context, hookID int)) *IOneLoginSDK_DeleteHookWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetPrivilegeRoles_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeRoles_Call {
+func (_c *IOneLoginSDK_DeleteHookWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteHookWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetPrivilegeRoles_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeRoles_Call {
+func (_c *IOneLoginSDK_DeleteHookWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DeleteHookWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrivilegeUsers provides a mock function with given fields: privilegeID
-func (_m *IOneLoginSDK) GetPrivilegeUsers(privilegeID string) (interface{}, error) {
-	ret := _m.Called(privilegeID)
+// DeleteMapping provides a mock function with given fields: mappingID
+func (_m *IOneLoginSDK) DeleteMapping(mappingID int) (interface{}, error) {
+	ret := _m.Called(mappingID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrivilegeUsers")
+		panic("no return value specified for DeleteMapping")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(mappingID)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(mappingID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(privilegeID)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(mappingID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3604,57 +3991,57 @@ func (_m *IOneLoginSDK) GetPrivilegeUsers(privilegeID string) (interface{}, erro
 	return r0, r1
 }
 
-// IOneLoginSDK_GetPrivilegeUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeUsers'
-type IOneLoginSDK_GetPrivilegeUsers_Call struct {
+// IOneLoginSDK_DeleteMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMapping'
+type IOneLoginSDK_DeleteMapping_Call struct {
 	*mock.Call
 }
 
-// GetPrivilegeUsers is a helper method to define mock.On call
-//   - privilegeID string
-func (_e *IOneLoginSDK_Expecter) GetPrivilegeUsers(privilegeID interface{}) *IOneLoginSDK_GetPrivilegeUsers_Call {
-	return &IOneLoginSDK_GetPrivilegeUsers_Call{Call: _e.mock.On("GetPrivilegeUsers", privilegeID)}
+// DeleteMapping is a helper method to define mock.On call
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) DeleteMapping(mappingID interface{}) *IOneLoginSDK_DeleteMapping_Call {
+	return &IOneLoginSDK_DeleteMapping_Call{Call: _e.mock.On("DeleteMapping", mappingID)}
 }
 
-func (_c *IOneLoginSDK_GetPrivilegeUsers_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilegeUsers_Call {
+func (_c *IOneLoginSDK_DeleteMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_DeleteMapping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetPrivilegeUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeUsers_Call {
+func (_c *IOneLoginSDK_DeleteMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteMapping_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetPrivilegeUsers_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeUsers_Call {
+func (_c *IOneLoginSDK_DeleteMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteMapping_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoleAdmins provides a mock function with given fields: roleID
-func (_m *IOneLoginSDK) GetRoleAdmins(roleID int) (interface{}, error) {
-	ret := _m.Called(roleID)
+// DeleteMappingWithContext provides a mock function with given fields: ctx, mappingID
+func (_m *IOneLoginSDK) DeleteMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
+	ret := _m.Called(ctx, mappingID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoleAdmins")
+		panic("no return value specified for DeleteMappingWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(roleID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, mappingID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(roleID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, mappingID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(roleID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, mappingID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3662,57 +4049,58 @@ func (_m *IOneLoginSDK) GetRoleAdmins(roleID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetRoleAdmins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleAdmins'
-type IOneLoginSDK_GetRoleAdmins_Call struct {
+// IOneLoginSDK_DeleteMappingWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMappingWithContext'
+type IOneLoginSDK_DeleteMappingWithContext_Call struct {
 	*mock.Call
 }
 
-// GetRoleAdmins is a helper method to define mock.On call
-//   - roleID int
-func (_e *IOneLoginSDK_Expecter) GetRoleAdmins(roleID interface{}) *IOneLoginSDK_GetRoleAdmins_Call {
-	return &IOneLoginSDK_GetRoleAdmins_Call{Call: _e.mock.On("GetRoleAdmins", roleID)}
+// DeleteMappingWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) DeleteMappingWithContext(ctx interface{}, mappingID interface{}) *IOneLoginSDK_DeleteMappingWithContext_Call {
+	return &IOneLoginSDK_DeleteMappingWithContext_Call{Call: _e.mock.On("DeleteMappingWithContext", ctx, mappingID)}
 }
 
-func (_c *IOneLoginSDK_GetRoleAdmins_Call) Run(run func(roleID int)) *IOneLoginSDK_GetRoleAdmins_Call {
+func (_c *IOneLoginSDK_DeleteMappingWithContext_Call) Run(run func(ctx context.Context, mappingID int)) *IOneLoginSDK_DeleteMappingWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleAdmins_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleAdmins_Call {
+func (_c *IOneLoginSDK_DeleteMappingWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteMappingWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleAdmins_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetRoleAdmins_Call {
+func (_c *IOneLoginSDK_DeleteMappingWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DeleteMappingWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoleApps provides a mock function with given fields: roleID
-func (_m *IOneLoginSDK) GetRoleApps(roleID int) (interface{}, error) {
-	ret := _m.Called(roleID)
+// DeletePrivilege provides a mock function with given fields: privilegeID
+func (_m *IOneLoginSDK) DeletePrivilege(privilegeID string) (interface{}, error) {
+	ret := _m.Called(privilegeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoleApps")
+		panic("no return value specified for DeletePrivilege")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(roleID)
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(privilegeID)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(roleID)
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(privilegeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(roleID)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privilegeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3720,57 +4108,57 @@ func (_m *IOneLoginSDK) GetRoleApps(roleID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetRoleApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleApps'
-type IOneLoginSDK_GetRoleApps_Call struct {
+// IOneLoginSDK_DeletePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePrivilege'
+type IOneLoginSDK_DeletePrivilege_Call struct {
 	*mock.Call
 }
 
-// GetRoleApps is a helper method to define mock.On call
-//   - roleID int
-func (_e *IOneLoginSDK_Expecter) GetRoleApps(roleID interface{}) *IOneLoginSDK_GetRoleApps_Call {
-	return &IOneLoginSDK_GetRoleApps_Call{Call: _e.mock.On("GetRoleApps", roleID)}
+// DeletePrivilege is a helper method to define mock.On call
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) DeletePrivilege(privilegeID interface{}) *IOneLoginSDK_DeletePrivilege_Call {
+	return &IOneLoginSDK_DeletePrivilege_Call{Call: _e.mock.On("DeletePrivilege", privilegeID)}
 }
 
-func (_c *IOneLoginSDK_GetRoleApps_Call) Run(run func(roleID int)) *IOneLoginSDK_GetRoleApps_Call {
+func (_c *IOneLoginSDK_DeletePrivilege_Call) Run(run func(privilegeID string)) *IOneLoginSDK_DeletePrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleApps_Call {
+func (_c *IOneLoginSDK_DeletePrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeletePrivilege_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleApps_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetRoleApps_Call {
+func (_c *IOneLoginSDK_DeletePrivilege_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_DeletePrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoleByID provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetRoleByID(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// DeletePrivilegeWithContext provides a mock function with given fields: ctx, privilegeID
+func (_m *IOneLoginSDK) DeletePrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoleByID")
+		panic("no return value specified for DeletePrivilegeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, privilegeID)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, privilegeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, privilegeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3778,58 +4166,58 @@ func (_m *IOneLoginSDK) GetRoleByID(id int, queryParams models.Queryable) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_GetRoleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByID'
-type IOneLoginSDK_GetRoleByID_Call struct {
+// IOneLoginSDK_DeletePrivilegeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePrivilegeWithContext'
+type IOneLoginSDK_DeletePrivilegeWithContext_Call struct {
 	*mock.Call
 }
 
-// GetRoleByID is a helper method to define mock.On call
-//   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetRoleByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleByID_Call {
-	return &IOneLoginSDK_GetRoleByID_Call{Call: _e.mock.On("GetRoleByID", id, queryParams)}
+// DeletePrivilegeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) DeletePrivilegeWithContext(ctx interface{}, privilegeID interface{}) *IOneLoginSDK_DeletePrivilegeWithContext_Call {
+	return &IOneLoginSDK_DeletePrivilegeWithContext_Call{Call: _e.mock.On("DeletePrivilegeWithContext", ctx, privilegeID)}
 }
 
-func (_c *IOneLoginSDK_GetRoleByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleByID_Call {
+func (_c *IOneLoginSDK_DeletePrivilegeWithContext_Call) Run(run func(ctx context.Context, privilegeID string)) *IOneLoginSDK_DeletePrivilegeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleByID_Call {
+func (_c *IOneLoginSDK_DeletePrivilegeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeletePrivilegeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoleByID_Call {
+func (_c *IOneLoginSDK_DeletePrivilegeWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_DeletePrivilegeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoleUsers provides a mock function with given fields: roleID, queryParams
-func (_m *IOneLoginSDK) GetRoleUsers(roleID int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(roleID, queryParams)
+// DeleteRole provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) DeleteRole(id int, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoleUsers")
+		panic("no return value specified for DeleteRole")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(roleID, queryParams)
+	if rf, ok := ret.Get(0).(func(int, map[string]string) (interface{}, error)); ok {
+		return rf(id, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(roleID, queryParams)
+	if rf, ok := ret.Get(0).(func(int, map[string]string) interface{}); ok {
+		r0 = rf(id, queryParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(roleID, queryParams)
+	if rf, ok := ret.Get(1).(func(int, map[string]string) error); ok {
+		r1 = rf(id, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3837,58 +4225,58 @@ func (_m *IOneLoginSDK) GetRoleUsers(roleID int, queryParams models.Queryable) (
 	return r0, r1
 }
 
-// IOneLoginSDK_GetRoleUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleUsers'
-type IOneLoginSDK_GetRoleUsers_Call struct {
+// IOneLoginSDK_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type IOneLoginSDK_DeleteRole_Call struct {
 	*mock.Call
 }
 
-// GetRoleUsers is a helper method to define mock.On call
-//   - roleID int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetRoleUsers(roleID interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleUsers_Call {
-	return &IOneLoginSDK_GetRoleUsers_Call{Call: _e.mock.On("GetRoleUsers", roleID, queryParams)}
+// DeleteRole is a helper method to define mock.On call
+//   - id int
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) DeleteRole(id interface{}, queryParams interface{}) *IOneLoginSDK_DeleteRole_Call {
+	return &IOneLoginSDK_DeleteRole_Call{Call: _e.mock.On("DeleteRole", id, queryParams)}
 }
 
-func (_c *IOneLoginSDK_GetRoleUsers_Call) Run(run func(roleID int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleUsers_Call {
+func (_c *IOneLoginSDK_DeleteRole_Call) Run(run func(id int, queryParams map[string]string)) *IOneLoginSDK_DeleteRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(int), args[1].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleUsers_Call {
+func (_c *IOneLoginSDK_DeleteRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoleUsers_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoleUsers_Call {
+func (_c *IOneLoginSDK_DeleteRole_Call) RunAndReturn(run func(int, map[string]string) (interface{}, error)) *IOneLoginSDK_DeleteRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRoles provides a mock function with given fields: queryParams
-func (_m *IOneLoginSDK) GetRoles(queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(queryParams)
+// DeleteRoleAdmins provides a mock function with given fields: roleID, admins
+func (_m *IOneLoginSDK) DeleteRoleAdmins(roleID int, admins []int) (interface{}, error) {
+	ret := _m.Called(roleID, admins)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoles")
+		panic("no return value specified for DeleteRoleAdmins")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(queryParams)
+	if rf, ok := ret.Get(0).(func(int, []int) (interface{}, error)); ok {
+		return rf(roleID, admins)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(queryParams)
+	if rf, ok := ret.Get(0).(func(int, []int) interface{}); ok {
+		r0 = rf(roleID, admins)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(queryParams)
+	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
+		r1 = rf(roleID, admins)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3896,55 +4284,58 @@ func (_m *IOneLoginSDK) GetRoles(queryParams models.Queryable) (interface{}, err
 	return r0, r1
 }
 
-// IOneLoginSDK_GetRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoles'
-type IOneLoginSDK_GetRoles_Call struct {
+// IOneLoginSDK_DeleteRoleAdmins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleAdmins'
+type IOneLoginSDK_DeleteRoleAdmins_Call struct {
 	*mock.Call
 }
 
-// GetRoles is a helper method to define mock.On call
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetRoles(queryParams interface{}) *IOneLoginSDK_GetRoles_Call {
-	return &IOneLoginSDK_GetRoles_Call{Call: _e.mock.On("GetRoles", queryParams)}
+// DeleteRoleAdmins is a helper method to define mock.On call
+//   - roleID int
+//   - admins []int
+func (_e *IOneLoginSDK_Expecter) DeleteRoleAdmins(roleID interface{}, admins interface{}) *IOneLoginSDK_DeleteRoleAdmins_Call {
+	return &IOneLoginSDK_DeleteRoleAdmins_Call{Call: _e.mock.On("DeleteRoleAdmins", roleID, admins)}
 }
 
-func (_c *IOneLoginSDK_GetRoles_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetRoles_Call {
+func (_c *IOneLoginSDK_DeleteRoleAdmins_Call) Run(run func(roleID int, admins []int)) *IOneLoginSDK_DeleteRoleAdmins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(int), args[1].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoles_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoles_Call {
+func (_c *IOneLoginSDK_DeleteRoleAdmins_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleAdmins_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetRoles_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoles_Call {
+func (_c *IOneLoginSDK_DeleteRoleAdmins_Call) RunAndReturn(run func(int, []int) (interface{}, error)) *IOneLoginSDK_DeleteRoleAdmins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetToken provides a mock function with given fields:
-func (_m *IOneLoginSDK) GetToken() (string, error) {
-	ret := _m.Called()
+// DeleteRoleAdminsWithContext provides a mock function with given fields: ctx, roleID, admins
+func (_m *IOneLoginSDK) DeleteRoleAdminsWithContext(ctx context.Context, roleID int, admins []int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID, admins)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetToken")
+		panic("no return value specified for DeleteRoleAdminsWithContext")
 	}
 
-	var r0 string
+	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (string, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (interface{}, error)); ok {
+		return rf(ctx, roleID, admins)
 	}
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) interface{}); ok {
+		r0 = rf(ctx, roleID, admins)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, roleID, admins)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3952,56 +4343,59 @@ func (_m *IOneLoginSDK) GetToken() (string, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetToken'
-type IOneLoginSDK_GetToken_Call struct {
+// IOneLoginSDK_DeleteRoleAdminsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleAdminsWithContext'
+type IOneLoginSDK_DeleteRoleAdminsWithContext_Call struct {
 	*mock.Call
 }
 
-// GetToken is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) GetToken() *IOneLoginSDK_GetToken_Call {
-	return &IOneLoginSDK_GetToken_Call{Call: _e.mock.On("GetToken")}
+// DeleteRoleAdminsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+//   - admins []int
+func (_e *IOneLoginSDK_Expecter) DeleteRoleAdminsWithContext(ctx interface{}, roleID interface{}, admins interface{}) *IOneLoginSDK_DeleteRoleAdminsWithContext_Call {
+	return &IOneLoginSDK_DeleteRoleAdminsWithContext_Call{Call: _e.mock.On("DeleteRoleAdminsWithContext", ctx, roleID, admins)}
 }
 
-func (_c *IOneLoginSDK_GetToken_Call) Run(run func()) *IOneLoginSDK_GetToken_Call {
+func (_c *IOneLoginSDK_DeleteRoleAdminsWithContext_Call) Run(run func(ctx context.Context, roleID int, admins []int)) *IOneLoginSDK_DeleteRoleAdminsWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetToken_Call) Return(_a0 string, _a1 error) *IOneLoginSDK_GetToken_Call {
+func (_c *IOneLoginSDK_DeleteRoleAdminsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleAdminsWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetToken_Call) RunAndReturn(run func() (string, error)) *IOneLoginSDK_GetToken_Call {
+func (_c *IOneLoginSDK_DeleteRoleAdminsWithContext_Call) RunAndReturn(run func(context.Context, int, []int) (interface{}, error)) *IOneLoginSDK_DeleteRoleAdminsWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserApps provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetUserApps(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// DeleteRoleFromPrivilege provides a mock function with given fields: privilegeID, roleID
+func (_m *IOneLoginSDK) DeleteRoleFromPrivilege(privilegeID string, roleID int) (interface{}, error) {
+	ret := _m.Called(privilegeID, roleID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserApps")
+		panic("no return value specified for DeleteRoleFromPrivilege")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(string, int) (interface{}, error)); ok {
+		return rf(privilegeID, roleID)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(string, int) interface{}); ok {
+		r0 = rf(privilegeID, roleID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(privilegeID, roleID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4009,58 +4403,58 @@ func (_m *IOneLoginSDK) GetUserApps(id int, queryParams models.Queryable) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_GetUserApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserApps'
-type IOneLoginSDK_GetUserApps_Call struct {
+// IOneLoginSDK_DeleteRoleFromPrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleFromPrivilege'
+type IOneLoginSDK_DeleteRoleFromPrivilege_Call struct {
 	*mock.Call
 }
 
-// GetUserApps is a helper method to define mock.On call
-//   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetUserApps(id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserApps_Call {
-	return &IOneLoginSDK_GetUserApps_Call{Call: _e.mock.On("GetUserApps", id, queryParams)}
+// DeleteRoleFromPrivilege is a helper method to define mock.On call
+//   - privilegeID string
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) DeleteRoleFromPrivilege(privilegeID interface{}, roleID interface{}) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
+	return &IOneLoginSDK_DeleteRoleFromPrivilege_Call{Call: _e.mock.On("DeleteRoleFromPrivilege", privilegeID, roleID)}
 }
 
-func (_c *IOneLoginSDK_GetUserApps_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserApps_Call {
+func (_c *IOneLoginSDK_DeleteRoleFromPrivilege_Call) Run(run func(privilegeID string, roleID int)) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(string), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUserApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserApps_Call {
+func (_c *IOneLoginSDK_DeleteRoleFromPrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUserApps_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUserApps_Call {
+func (_c *IOneLoginSDK_DeleteRoleFromPrivilege_Call) RunAndReturn(run func(string, int) (interface{}, error)) *IOneLoginSDK_DeleteRoleFromPrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByID provides a mock function with given fields: id, queryParams
-func (_m *IOneLoginSDK) GetUserByID(id int, queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(id, queryParams)
+// DeleteRoleFromPrivilegeWithContext provides a mock function with given fields: ctx, privilegeID, roleID
+func (_m *IOneLoginSDK) DeleteRoleFromPrivilegeWithContext(ctx context.Context, privilegeID string, roleID int) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID, roleID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for DeleteRoleFromPrivilegeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
-		return rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (interface{}, error)); ok {
+		return rf(ctx, privilegeID, roleID)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
-		r0 = rf(id, queryParams)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) interface{}); ok {
+		r0 = rf(ctx, privilegeID, roleID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
-		r1 = rf(id, queryParams)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, privilegeID, roleID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4068,58 +4462,59 @@ func (_m *IOneLoginSDK) GetUserByID(id int, queryParams models.Queryable) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type IOneLoginSDK_GetUserByID_Call struct {
+// IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleFromPrivilegeWithContext'
+type IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
-//   - id int
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetUserByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserByID_Call {
-	return &IOneLoginSDK_GetUserByID_Call{Call: _e.mock.On("GetUserByID", id, queryParams)}
+// DeleteRoleFromPrivilegeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) DeleteRoleFromPrivilegeWithContext(ctx interface{}, privilegeID interface{}, roleID interface{}) *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call {
+	return &IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call{Call: _e.mock.On("DeleteRoleFromPrivilegeWithContext", ctx, privilegeID, roleID)}
 }
 
-func (_c *IOneLoginSDK_GetUserByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserByID_Call {
+func (_c *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call) Run(run func(ctx context.Context, privilegeID string, roleID int)) *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Queryable))
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUserByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserByID_Call {
+func (_c *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUserByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUserByID_Call {
+func (_c *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call) RunAndReturn(run func(context.Context, string, int) (interface{}, error)) *IOneLoginSDK_DeleteRoleFromPrivilegeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserRoles provides a mock function with given fields: id
-func (_m *IOneLoginSDK) GetUserRoles(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// DeleteRoleUsers provides a mock function with given fields: roleID, users
+func (_m *IOneLoginSDK) DeleteRoleUsers(roleID int, users []int) (interface{}, error) {
+	ret := _m.Called(roleID, users)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserRoles")
+		panic("no return value specified for DeleteRoleUsers")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(int, []int) (interface{}, error)); ok {
+		return rf(roleID, users)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int, []int) interface{}); ok {
+		r0 = rf(roleID, users)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
+		r1 = rf(roleID, users)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4127,57 +4522,58 @@ func (_m *IOneLoginSDK) GetUserRoles(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRoles'
-type IOneLoginSDK_GetUserRoles_Call struct {
+// IOneLoginSDK_DeleteRoleUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleUsers'
+type IOneLoginSDK_DeleteRoleUsers_Call struct {
 	*mock.Call
 }
 
-// GetUserRoles is a helper method to define mock.On call
-//   - id int
-func (_e *IOneLoginSDK_Expecter) GetUserRoles(id interface{}) *IOneLoginSDK_GetUserRoles_Call {
-	return &IOneLoginSDK_GetUserRoles_Call{Call: _e.mock.On("GetUserRoles", id)}
+// DeleteRoleUsers is a helper method to define mock.On call
+//   - roleID int
+//   - users []int
+func (_e *IOneLoginSDK_Expecter) DeleteRoleUsers(roleID interface{}, users interface{}) *IOneLoginSDK_DeleteRoleUsers_Call {
+	return &IOneLoginSDK_DeleteRoleUsers_Call{Call: _e.mock.On("DeleteRoleUsers", roleID, users)}
 }
 
-func (_c *IOneLoginSDK_GetUserRoles_Call) Run(run func(id int)) *IOneLoginSDK_GetUserRoles_Call {
+func (_c *IOneLoginSDK_DeleteRoleUsers_Call) Run(run func(roleID int, users []int)) *IOneLoginSDK_DeleteRoleUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(int), args[1].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUserRoles_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserRoles_Call {
+func (_c *IOneLoginSDK_DeleteRoleUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUserRoles_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetUserRoles_Call {
+func (_c *IOneLoginSDK_DeleteRoleUsers_Call) RunAndReturn(run func(int, []int) (interface{}, error)) *IOneLoginSDK_DeleteRoleUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsers provides a mock function with given fields: query
-func (_m *IOneLoginSDK) GetUsers(query models.Queryable) (interface{}, error) {
-	ret := _m.Called(query)
+// DeleteRoleUsersWithContext provides a mock function with given fields: ctx, roleID, users
+func (_m *IOneLoginSDK) DeleteRoleUsersWithContext(ctx context.Context, roleID int, users []int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID, users)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsers")
+		panic("no return value specified for DeleteRoleUsersWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(query)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (interface{}, error)); ok {
+		return rf(ctx, roleID, users)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(query)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) interface{}); ok {
+		r0 = rf(ctx, roleID, users)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(query)
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, roleID, users)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4185,57 +4581,59 @@ func (_m *IOneLoginSDK) GetUsers(query models.Queryable) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
-type IOneLoginSDK_GetUsers_Call struct {
+// IOneLoginSDK_DeleteRoleUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleUsersWithContext'
+type IOneLoginSDK_DeleteRoleUsersWithContext_Call struct {
 	*mock.Call
 }
 
-// GetUsers is a helper method to define mock.On call
-//   - query models.Queryable
-func (_e *IOneLoginSDK_Expecter) GetUsers(query interface{}) *IOneLoginSDK_GetUsers_Call {
-	return &IOneLoginSDK_GetUsers_Call{Call: _e.mock.On("GetUsers", query)}
+// DeleteRoleUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+//   - users []int
+func (_e *IOneLoginSDK_Expecter) DeleteRoleUsersWithContext(ctx interface{}, roleID interface{}, users interface{}) *IOneLoginSDK_DeleteRoleUsersWithContext_Call {
+	return &IOneLoginSDK_DeleteRoleUsersWithContext_Call{Call: _e.mock.On("DeleteRoleUsersWithContext", ctx, roleID, users)}
 }
 
-func (_c *IOneLoginSDK_GetUsers_Call) Run(run func(query models.Queryable)) *IOneLoginSDK_GetUsers_Call {
+func (_c *IOneLoginSDK_DeleteRoleUsersWithContext_Call) Run(run func(ctx context.Context, roleID int, users []int)) *IOneLoginSDK_DeleteRoleUsersWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUsers_Call {
+func (_c *IOneLoginSDK_DeleteRoleUsersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleUsersWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_GetUsers_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUsers_Call {
+func (_c *IOneLoginSDK_DeleteRoleUsersWithContext_Call) RunAndReturn(run func(context.Context, int, []int) (interface{}, error)) *IOneLoginSDK_DeleteRoleUsersWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListActionValues provides a mock function with given fields: actionValue
-func (_m *IOneLoginSDK) ListActionValues(actionValue string) (interface{}, error) {
-	ret := _m.Called(actionValue)
+// DeleteRoleWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) DeleteRoleWithContext(ctx context.Context, id int, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListActionValues")
+		panic("no return value specified for DeleteRoleWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(actionValue)
+	if rf, ok := ret.Get(0).(func(context.Context, int, map[string]string) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(actionValue)
+	if rf, ok := ret.Get(0).(func(context.Context, int, map[string]string) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(actionValue)
+	if rf, ok := ret.Get(1).(func(context.Context, int, map[string]string) error); ok {
+		r1 = rf(ctx, id, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4243,57 +4641,59 @@ func (_m *IOneLoginSDK) ListActionValues(actionValue string) (interface{}, error
 	return r0, r1
 }
 
-// IOneLoginSDK_ListActionValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActionValues'
-type IOneLoginSDK_ListActionValues_Call struct {
+// IOneLoginSDK_DeleteRoleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleWithContext'
+type IOneLoginSDK_DeleteRoleWithContext_Call struct {
 	*mock.Call
 }
 
-// ListActionValues is a helper method to define mock.On call
-//   - actionValue string
-func (_e *IOneLoginSDK_Expecter) ListActionValues(actionValue interface{}) *IOneLoginSDK_ListActionValues_Call {
-	return &IOneLoginSDK_ListActionValues_Call{Call: _e.mock.On("ListActionValues", actionValue)}
+// DeleteRoleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) DeleteRoleWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_DeleteRoleWithContext_Call {
+	return &IOneLoginSDK_DeleteRoleWithContext_Call{Call: _e.mock.On("DeleteRoleWithContext", ctx, id, queryParams)}
 }
 
-func (_c *IOneLoginSDK_ListActionValues_Call) Run(run func(actionValue string)) *IOneLoginSDK_ListActionValues_Call {
+func (_c *IOneLoginSDK_DeleteRoleWithContext_Call) Run(run func(ctx context.Context, id int, queryParams map[string]string)) *IOneLoginSDK_DeleteRoleWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(int), args[2].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListActionValues_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListActionValues_Call {
+func (_c *IOneLoginSDK_DeleteRoleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteRoleWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListActionValues_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_ListActionValues_Call {
+func (_c *IOneLoginSDK_DeleteRoleWithContext_Call) RunAndReturn(run func(context.Context, int, map[string]string) (interface{}, error)) *IOneLoginSDK_DeleteRoleWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListActions provides a mock function with given fields:
-func (_m *IOneLoginSDK) ListActions() (interface{}, error) {
-	ret := _m.Called()
+// DeleteUser provides a mock function with given fields: id
+func (_m *IOneLoginSDK) DeleteUser(id int) (interface{}, error) {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListActions")
+		panic("no return value specified for DeleteUser")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func() interface{}); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4301,56 +4701,8795 @@ func (_m *IOneLoginSDK) ListActions() (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_ListActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActions'
-type IOneLoginSDK_ListActions_Call struct {
+// IOneLoginSDK_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type IOneLoginSDK_DeleteUser_Call struct {
 	*mock.Call
 }
 
-// ListActions is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) ListActions() *IOneLoginSDK_ListActions_Call {
-	return &IOneLoginSDK_ListActions_Call{Call: _e.mock.On("ListActions")}
+// DeleteUser is a helper method to define mock.On call
+//   - id int
+func (_e *IOneLoginSDK_Expecter) DeleteUser(id interface{}) *IOneLoginSDK_DeleteUser_Call {
+	return &IOneLoginSDK_DeleteUser_Call{Call: _e.mock.On("DeleteUser", id)}
 }
 
-func (_c *IOneLoginSDK_ListActions_Call) Run(run func()) *IOneLoginSDK_ListActions_Call {
+func (_c *IOneLoginSDK_DeleteUser_Call) Run(run func(id int)) *IOneLoginSDK_DeleteUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListActions_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListActions_Call {
+func (_c *IOneLoginSDK_DeleteUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListActions_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListActions_Call {
+func (_c *IOneLoginSDK_DeleteUser_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DeleteUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConditionOperators provides a mock function with given fields: conditionValue
-func (_m *IOneLoginSDK) ListConditionOperators(conditionValue string) (interface{}, error) {
-	ret := _m.Called(conditionValue)
+// DeleteUserWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) DeleteUserWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConditionOperators")
+		panic("no return value specified for DeleteUserWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(conditionValue)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(conditionValue)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_DeleteUserWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserWithContext'
+type IOneLoginSDK_DeleteUserWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteUserWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *IOneLoginSDK_Expecter) DeleteUserWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_DeleteUserWithContext_Call {
+	return &IOneLoginSDK_DeleteUserWithContext_Call{Call: _e.mock.On("DeleteUserWithContext", ctx, id)}
+}
+
+func (_c *IOneLoginSDK_DeleteUserWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_DeleteUserWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_DeleteUserWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DeleteUserWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_DeleteUserWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DeleteUserWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Do provides a mock function with given fields: ctx, method, path, query, body, out, opts
+func (_m *IOneLoginSDK) Do(ctx context.Context, method string, path string, query interface{}, body interface{}, out interface{}, opts ...onelogin.RequestOption) (*models.ResponseMetadata, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, method, path, query, body, out)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Do")
+	}
+
+	var r0 *models.ResponseMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, interface{}, interface{}, interface{}, ...onelogin.RequestOption) (*models.ResponseMetadata, error)); ok {
+		return rf(ctx, method, path, query, body, out, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, interface{}, interface{}, interface{}, ...onelogin.RequestOption) *models.ResponseMetadata); ok {
+		r0 = rf(ctx, method, path, query, body, out, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResponseMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, interface{}, interface{}, interface{}, ...onelogin.RequestOption) error); ok {
+		r1 = rf(ctx, method, path, query, body, out, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_Do_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Do'
+type IOneLoginSDK_Do_Call struct {
+	*mock.Call
+}
+
+// Do is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path string
+//   - query interface{}
+//   - body interface{}
+//   - out interface{}
+//   - opts ...onelogin.RequestOption
+func (_e *IOneLoginSDK_Expecter) Do(ctx interface{}, method interface{}, path interface{}, query interface{}, body interface{}, out interface{}, opts ...interface{}) *IOneLoginSDK_Do_Call {
+	return &IOneLoginSDK_Do_Call{Call: _e.mock.On("Do",
+		append([]interface{}{ctx, method, path, query, body, out}, opts...)...)}
+}
+
+func (_c *IOneLoginSDK_Do_Call) Run(run func(ctx context.Context, method string, path string, query interface{}, body interface{}, out interface{}, opts ...onelogin.RequestOption)) *IOneLoginSDK_Do_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]onelogin.RequestOption, len(args)-6)
+		for i, a := range args[6:] {
+			if a != nil {
+				variadicArgs[i] = a.(onelogin.RequestOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(interface{}), args[4].(interface{}), args[5].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_Do_Call) Return(_a0 *models.ResponseMetadata, _a1 error) *IOneLoginSDK_Do_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_Do_Call) RunAndReturn(run func(context.Context, string, string, interface{}, interface{}, interface{}, ...onelogin.RequestOption) (*models.ResponseMetadata, error)) *IOneLoginSDK_Do_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryrunMapping provides a mock function with given fields: mappingID
+func (_m *IOneLoginSDK) DryrunMapping(mappingID int) (interface{}, error) {
+	ret := _m.Called(mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryrunMapping")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(mappingID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(mappingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(mappingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_DryrunMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryrunMapping'
+type IOneLoginSDK_DryrunMapping_Call struct {
+	*mock.Call
+}
+
+// DryrunMapping is a helper method to define mock.On call
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) DryrunMapping(mappingID interface{}) *IOneLoginSDK_DryrunMapping_Call {
+	return &IOneLoginSDK_DryrunMapping_Call{Call: _e.mock.On("DryrunMapping", mappingID)}
+}
+
+func (_c *IOneLoginSDK_DryrunMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_DryrunMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_DryrunMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DryrunMapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_DryrunMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_DryrunMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryrunMappingWithContext provides a mock function with given fields: ctx, mappingID
+func (_m *IOneLoginSDK) DryrunMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
+	ret := _m.Called(ctx, mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryrunMappingWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, mappingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, mappingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, mappingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_DryrunMappingWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryrunMappingWithContext'
+type IOneLoginSDK_DryrunMappingWithContext_Call struct {
+	*mock.Call
+}
+
+// DryrunMappingWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) DryrunMappingWithContext(ctx interface{}, mappingID interface{}) *IOneLoginSDK_DryrunMappingWithContext_Call {
+	return &IOneLoginSDK_DryrunMappingWithContext_Call{Call: _e.mock.On("DryrunMappingWithContext", ctx, mappingID)}
+}
+
+func (_c *IOneLoginSDK_DryrunMappingWithContext_Call) Run(run func(ctx context.Context, mappingID int)) *IOneLoginSDK_DryrunMappingWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_DryrunMappingWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_DryrunMappingWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_DryrunMappingWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_DryrunMappingWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollMFAFactor provides a mock function with given fields: factor, userID
+func (_m *IOneLoginSDK) EnrollMFAFactor(factor models.EnrollFactorRequest, userID int) (interface{}, error) {
+	ret := _m.Called(factor, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollMFAFactor")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EnrollFactorRequest, int) (interface{}, error)); ok {
+		return rf(factor, userID)
+	}
+	if rf, ok := ret.Get(0).(func(models.EnrollFactorRequest, int) interface{}); ok {
+		r0 = rf(factor, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EnrollFactorRequest, int) error); ok {
+		r1 = rf(factor, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_EnrollMFAFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFAFactor'
+type IOneLoginSDK_EnrollMFAFactor_Call struct {
+	*mock.Call
+}
+
+// EnrollMFAFactor is a helper method to define mock.On call
+//   - factor models.EnrollFactorRequest
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) EnrollMFAFactor(factor interface{}, userID interface{}) *IOneLoginSDK_EnrollMFAFactor_Call {
+	return &IOneLoginSDK_EnrollMFAFactor_Call{Call: _e.mock.On("EnrollMFAFactor", factor, userID)}
+}
+
+func (_c *IOneLoginSDK_EnrollMFAFactor_Call) Run(run func(factor models.EnrollFactorRequest, userID int)) *IOneLoginSDK_EnrollMFAFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.EnrollFactorRequest), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_EnrollMFAFactor_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_EnrollMFAFactor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_EnrollMFAFactor_Call) RunAndReturn(run func(models.EnrollFactorRequest, int) (interface{}, error)) *IOneLoginSDK_EnrollMFAFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollMFAFactorWithContext provides a mock function with given fields: ctx, factor, userID
+func (_m *IOneLoginSDK) EnrollMFAFactorWithContext(ctx context.Context, factor models.EnrollFactorRequest, userID int) (interface{}, error) {
+	ret := _m.Called(ctx, factor, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollMFAFactorWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.EnrollFactorRequest, int) (interface{}, error)); ok {
+		return rf(ctx, factor, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.EnrollFactorRequest, int) interface{}); ok {
+		r0 = rf(ctx, factor, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.EnrollFactorRequest, int) error); ok {
+		r1 = rf(ctx, factor, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_EnrollMFAFactorWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollMFAFactorWithContext'
+type IOneLoginSDK_EnrollMFAFactorWithContext_Call struct {
+	*mock.Call
+}
+
+// EnrollMFAFactorWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - factor models.EnrollFactorRequest
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) EnrollMFAFactorWithContext(ctx interface{}, factor interface{}, userID interface{}) *IOneLoginSDK_EnrollMFAFactorWithContext_Call {
+	return &IOneLoginSDK_EnrollMFAFactorWithContext_Call{Call: _e.mock.On("EnrollMFAFactorWithContext", ctx, factor, userID)}
+}
+
+func (_c *IOneLoginSDK_EnrollMFAFactorWithContext_Call) Run(run func(ctx context.Context, factor models.EnrollFactorRequest, userID int)) *IOneLoginSDK_EnrollMFAFactorWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.EnrollFactorRequest), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_EnrollMFAFactorWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_EnrollMFAFactorWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_EnrollMFAFactorWithContext_Call) RunAndReturn(run func(context.Context, models.EnrollFactorRequest, int) (interface{}, error)) *IOneLoginSDK_EnrollMFAFactorWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateInviteLink provides a mock function with given fields: email
+func (_m *IOneLoginSDK) GenerateInviteLink(email string) (interface{}, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateInviteLink")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GenerateInviteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateInviteLink'
+type IOneLoginSDK_GenerateInviteLink_Call struct {
+	*mock.Call
+}
+
+// GenerateInviteLink is a helper method to define mock.On call
+//   - email string
+func (_e *IOneLoginSDK_Expecter) GenerateInviteLink(email interface{}) *IOneLoginSDK_GenerateInviteLink_Call {
+	return &IOneLoginSDK_GenerateInviteLink_Call{Call: _e.mock.On("GenerateInviteLink", email)}
+}
+
+func (_c *IOneLoginSDK_GenerateInviteLink_Call) Run(run func(email string)) *IOneLoginSDK_GenerateInviteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateInviteLink_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateInviteLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateInviteLink_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GenerateInviteLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateInviteLinkWithContext provides a mock function with given fields: ctx, email
+func (_m *IOneLoginSDK) GenerateInviteLinkWithContext(ctx context.Context, email string) (interface{}, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateInviteLinkWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GenerateInviteLinkWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateInviteLinkWithContext'
+type IOneLoginSDK_GenerateInviteLinkWithContext_Call struct {
+	*mock.Call
+}
+
+// GenerateInviteLinkWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *IOneLoginSDK_Expecter) GenerateInviteLinkWithContext(ctx interface{}, email interface{}) *IOneLoginSDK_GenerateInviteLinkWithContext_Call {
+	return &IOneLoginSDK_GenerateInviteLinkWithContext_Call{Call: _e.mock.On("GenerateInviteLinkWithContext", ctx, email)}
+}
+
+func (_c *IOneLoginSDK_GenerateInviteLinkWithContext_Call) Run(run func(ctx context.Context, email string)) *IOneLoginSDK_GenerateInviteLinkWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateInviteLinkWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateInviteLinkWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateInviteLinkWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_GenerateInviteLinkWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateMFAToken provides a mock function with given fields: userID, request
+func (_m *IOneLoginSDK) GenerateMFAToken(userID int, request models.GenerateMFATokenRequest) (interface{}, error) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateMFAToken")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.GenerateMFATokenRequest) (interface{}, error)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.GenerateMFATokenRequest) interface{}); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.GenerateMFATokenRequest) error); ok {
+		r1 = rf(userID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GenerateMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateMFAToken'
+type IOneLoginSDK_GenerateMFAToken_Call struct {
+	*mock.Call
+}
+
+// GenerateMFAToken is a helper method to define mock.On call
+//   - userID int
+//   - request models.GenerateMFATokenRequest
+func (_e *IOneLoginSDK_Expecter) GenerateMFAToken(userID interface{}, request interface{}) *IOneLoginSDK_GenerateMFAToken_Call {
+	return &IOneLoginSDK_GenerateMFAToken_Call{Call: _e.mock.On("GenerateMFAToken", userID, request)}
+}
+
+func (_c *IOneLoginSDK_GenerateMFAToken_Call) Run(run func(userID int, request models.GenerateMFATokenRequest)) *IOneLoginSDK_GenerateMFAToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.GenerateMFATokenRequest))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateMFAToken_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateMFAToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateMFAToken_Call) RunAndReturn(run func(int, models.GenerateMFATokenRequest) (interface{}, error)) *IOneLoginSDK_GenerateMFAToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateMFATokenWithContext provides a mock function with given fields: ctx, userID, request
+func (_m *IOneLoginSDK) GenerateMFATokenWithContext(ctx context.Context, userID int, request models.GenerateMFATokenRequest) (interface{}, error) {
+	ret := _m.Called(ctx, userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateMFATokenWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.GenerateMFATokenRequest) (interface{}, error)); ok {
+		return rf(ctx, userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.GenerateMFATokenRequest) interface{}); ok {
+		r0 = rf(ctx, userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.GenerateMFATokenRequest) error); ok {
+		r1 = rf(ctx, userID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GenerateMFATokenWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateMFATokenWithContext'
+type IOneLoginSDK_GenerateMFATokenWithContext_Call struct {
+	*mock.Call
+}
+
+// GenerateMFATokenWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - request models.GenerateMFATokenRequest
+func (_e *IOneLoginSDK_Expecter) GenerateMFATokenWithContext(ctx interface{}, userID interface{}, request interface{}) *IOneLoginSDK_GenerateMFATokenWithContext_Call {
+	return &IOneLoginSDK_GenerateMFATokenWithContext_Call{Call: _e.mock.On("GenerateMFATokenWithContext", ctx, userID, request)}
+}
+
+func (_c *IOneLoginSDK_GenerateMFATokenWithContext_Call) Run(run func(ctx context.Context, userID int, request models.GenerateMFATokenRequest)) *IOneLoginSDK_GenerateMFATokenWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.GenerateMFATokenRequest))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateMFATokenWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateMFATokenWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateMFATokenWithContext_Call) RunAndReturn(run func(context.Context, int, models.GenerateMFATokenRequest) (interface{}, error)) *IOneLoginSDK_GenerateMFATokenWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateSAMLAssertion provides a mock function with given fields: request
+func (_m *IOneLoginSDK) GenerateSAMLAssertion(request models.GenerateSAMLTokenRequest) (interface{}, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSAMLAssertion")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.GenerateSAMLTokenRequest) (interface{}, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(models.GenerateSAMLTokenRequest) interface{}); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.GenerateSAMLTokenRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GenerateSAMLAssertion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSAMLAssertion'
+type IOneLoginSDK_GenerateSAMLAssertion_Call struct {
+	*mock.Call
+}
+
+// GenerateSAMLAssertion is a helper method to define mock.On call
+//   - request models.GenerateSAMLTokenRequest
+func (_e *IOneLoginSDK_Expecter) GenerateSAMLAssertion(request interface{}) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+	return &IOneLoginSDK_GenerateSAMLAssertion_Call{Call: _e.mock.On("GenerateSAMLAssertion", request)}
+}
+
+func (_c *IOneLoginSDK_GenerateSAMLAssertion_Call) Run(run func(request models.GenerateSAMLTokenRequest)) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.GenerateSAMLTokenRequest))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateSAMLAssertion_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateSAMLAssertion_Call) RunAndReturn(run func(models.GenerateSAMLTokenRequest) (interface{}, error)) *IOneLoginSDK_GenerateSAMLAssertion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateSAMLAssertionWithContext provides a mock function with given fields: ctx, request
+func (_m *IOneLoginSDK) GenerateSAMLAssertionWithContext(ctx context.Context, request models.GenerateSAMLTokenRequest) (interface{}, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSAMLAssertionWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.GenerateSAMLTokenRequest) (interface{}, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.GenerateSAMLTokenRequest) interface{}); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.GenerateSAMLTokenRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GenerateSAMLAssertionWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSAMLAssertionWithContext'
+type IOneLoginSDK_GenerateSAMLAssertionWithContext_Call struct {
+	*mock.Call
+}
+
+// GenerateSAMLAssertionWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request models.GenerateSAMLTokenRequest
+func (_e *IOneLoginSDK_Expecter) GenerateSAMLAssertionWithContext(ctx interface{}, request interface{}) *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call {
+	return &IOneLoginSDK_GenerateSAMLAssertionWithContext_Call{Call: _e.mock.On("GenerateSAMLAssertionWithContext", ctx, request)}
+}
+
+func (_c *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call) Run(run func(ctx context.Context, request models.GenerateSAMLTokenRequest)) *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.GenerateSAMLTokenRequest))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call) RunAndReturn(run func(context.Context, models.GenerateSAMLTokenRequest) (interface{}, error)) *IOneLoginSDK_GenerateSAMLAssertionWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountId provides a mock function with given fields:
+func (_m *IOneLoginSDK) GetAccountId() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountId")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// IOneLoginSDK_GetAccountId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountId'
+type IOneLoginSDK_GetAccountId_Call struct {
+	*mock.Call
+}
+
+// GetAccountId is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) GetAccountId() *IOneLoginSDK_GetAccountId_Call {
+	return &IOneLoginSDK_GetAccountId_Call{Call: _e.mock.On("GetAccountId")}
+}
+
+func (_c *IOneLoginSDK_GetAccountId_Call) Run(run func()) *IOneLoginSDK_GetAccountId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAccountId_Call) Return(_a0 string) *IOneLoginSDK_GetAccountId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAccountId_Call) RunAndReturn(run func() string) *IOneLoginSDK_GetAccountId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppByID provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetAppByID(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppByID")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppByID'
+type IOneLoginSDK_GetAppByID_Call struct {
+	*mock.Call
+}
+
+// GetAppByID is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppByID_Call {
+	return &IOneLoginSDK_GetAppByID_Call{Call: _e.mock.On("GetAppByID", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppByIDTyped provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetAppByIDTyped(id int, queryParams models.Queryable) (*models.Response[models.App], error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppByIDTyped")
+	}
+
+	var r0 *models.Response[models.App]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (*models.Response[models.App], error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) *models.Response[models.App]); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.App])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppByIDTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppByIDTyped'
+type IOneLoginSDK_GetAppByIDTyped_Call struct {
+	*mock.Call
+}
+
+// GetAppByIDTyped is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppByIDTyped(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppByIDTyped_Call {
+	return &IOneLoginSDK_GetAppByIDTyped_Call{Call: _e.mock.On("GetAppByIDTyped", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppByIDTyped_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppByIDTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByIDTyped_Call) Return(_a0 *models.Response[models.App], _a1 error) *IOneLoginSDK_GetAppByIDTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByIDTyped_Call) RunAndReturn(run func(int, models.Queryable) (*models.Response[models.App], error)) *IOneLoginSDK_GetAppByIDTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppByIDTypedWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetAppByIDTypedWithContext(ctx context.Context, id int, queryParams models.Queryable) (*models.Response[models.App], error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppByIDTypedWithContext")
+	}
+
+	var r0 *models.Response[models.App]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (*models.Response[models.App], error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) *models.Response[models.App]); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.App])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppByIDTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppByIDTypedWithContext'
+type IOneLoginSDK_GetAppByIDTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppByIDTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppByIDTypedWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppByIDTypedWithContext_Call {
+	return &IOneLoginSDK_GetAppByIDTypedWithContext_Call{Call: _e.mock.On("GetAppByIDTypedWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppByIDTypedWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppByIDTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByIDTypedWithContext_Call) Return(_a0 *models.Response[models.App], _a1 error) *IOneLoginSDK_GetAppByIDTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByIDTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (*models.Response[models.App], error)) *IOneLoginSDK_GetAppByIDTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppByIDWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetAppByIDWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppByIDWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppByIDWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppByIDWithContext'
+type IOneLoginSDK_GetAppByIDWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppByIDWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppByIDWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppByIDWithContext_Call {
+	return &IOneLoginSDK_GetAppByIDWithContext_Call{Call: _e.mock.On("GetAppByIDWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppByIDWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppByIDWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByIDWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppByIDWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppByIDWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppByIDWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppRuleByID provides a mock function with given fields: id, ruleID, queryParams
+func (_m *IOneLoginSDK) GetAppRuleByID(id int, ruleID int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, ruleID, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppRuleByID")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, ruleID, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, models.Queryable) interface{}); ok {
+		r0 = rf(id, ruleID, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, models.Queryable) error); ok {
+		r1 = rf(id, ruleID, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppRuleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppRuleByID'
+type IOneLoginSDK_GetAppRuleByID_Call struct {
+	*mock.Call
+}
+
+// GetAppRuleByID is a helper method to define mock.On call
+//   - id int
+//   - ruleID int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppRuleByID(id interface{}, ruleID interface{}, queryParams interface{}) *IOneLoginSDK_GetAppRuleByID_Call {
+	return &IOneLoginSDK_GetAppRuleByID_Call{Call: _e.mock.On("GetAppRuleByID", id, ruleID, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppRuleByID_Call) Run(run func(id int, ruleID int, queryParams models.Queryable)) *IOneLoginSDK_GetAppRuleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRuleByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppRuleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRuleByID_Call) RunAndReturn(run func(int, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppRuleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppRuleByIDWithContext provides a mock function with given fields: ctx, id, ruleID, queryParams
+func (_m *IOneLoginSDK) GetAppRuleByIDWithContext(ctx context.Context, id int, ruleID int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, ruleID, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppRuleByIDWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, ruleID, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, ruleID, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, ruleID, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppRuleByIDWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppRuleByIDWithContext'
+type IOneLoginSDK_GetAppRuleByIDWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppRuleByIDWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - ruleID int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppRuleByIDWithContext(ctx interface{}, id interface{}, ruleID interface{}, queryParams interface{}) *IOneLoginSDK_GetAppRuleByIDWithContext_Call {
+	return &IOneLoginSDK_GetAppRuleByIDWithContext_Call{Call: _e.mock.On("GetAppRuleByIDWithContext", ctx, id, ruleID, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppRuleByIDWithContext_Call) Run(run func(ctx context.Context, id int, ruleID int, queryParams models.Queryable)) *IOneLoginSDK_GetAppRuleByIDWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRuleByIDWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppRuleByIDWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRuleByIDWithContext_Call) RunAndReturn(run func(context.Context, int, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppRuleByIDWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppRules provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetAppRules(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppRules")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppRules'
+type IOneLoginSDK_GetAppRules_Call struct {
+	*mock.Call
+}
+
+// GetAppRules is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppRules(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppRules_Call {
+	return &IOneLoginSDK_GetAppRules_Call{Call: _e.mock.On("GetAppRules", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppRules_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRules_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRules_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppRulesWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetAppRulesWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppRulesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppRulesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppRulesWithContext'
+type IOneLoginSDK_GetAppRulesWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppRulesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppRulesWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetAppRulesWithContext_Call {
+	return &IOneLoginSDK_GetAppRulesWithContext_Call{Call: _e.mock.On("GetAppRulesWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppRulesWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetAppRulesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRulesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppRulesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppRulesWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppRulesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppUsers provides a mock function with given fields: appID
+func (_m *IOneLoginSDK) GetAppUsers(appID int) (interface{}, error) {
+	ret := _m.Called(appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppUsers")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(appID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppUsers'
+type IOneLoginSDK_GetAppUsers_Call struct {
+	*mock.Call
+}
+
+// GetAppUsers is a helper method to define mock.On call
+//   - appID int
+func (_e *IOneLoginSDK_Expecter) GetAppUsers(appID interface{}) *IOneLoginSDK_GetAppUsers_Call {
+	return &IOneLoginSDK_GetAppUsers_Call{Call: _e.mock.On("GetAppUsers", appID)}
+}
+
+func (_c *IOneLoginSDK_GetAppUsers_Call) Run(run func(appID int)) *IOneLoginSDK_GetAppUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppUsers_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetAppUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppUsersWithContext provides a mock function with given fields: ctx, appID
+func (_m *IOneLoginSDK) GetAppUsersWithContext(ctx context.Context, appID int) (interface{}, error) {
+	ret := _m.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppUsersWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, appID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppUsersWithContext'
+type IOneLoginSDK_GetAppUsersWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID int
+func (_e *IOneLoginSDK_Expecter) GetAppUsersWithContext(ctx interface{}, appID interface{}) *IOneLoginSDK_GetAppUsersWithContext_Call {
+	return &IOneLoginSDK_GetAppUsersWithContext_Call{Call: _e.mock.On("GetAppUsersWithContext", ctx, appID)}
+}
+
+func (_c *IOneLoginSDK_GetAppUsersWithContext_Call) Run(run func(ctx context.Context, appID int)) *IOneLoginSDK_GetAppUsersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppUsersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppUsersWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppUsersWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetAppUsersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApps provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetApps(queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApps")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApps'
+type IOneLoginSDK_GetApps_Call struct {
+	*mock.Call
+}
+
+// GetApps is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetApps(queryParams interface{}) *IOneLoginSDK_GetApps_Call {
+	return &IOneLoginSDK_GetApps_Call{Call: _e.mock.On("GetApps", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetApps_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetApps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetApps_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppsTyped provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetAppsTyped(queryParams models.Queryable) (*models.Response[[]models.App], error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppsTyped")
+	}
+
+	var r0 *models.Response[[]models.App]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.App], error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.App]); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.App])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppsTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppsTyped'
+type IOneLoginSDK_GetAppsTyped_Call struct {
+	*mock.Call
+}
+
+// GetAppsTyped is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppsTyped(queryParams interface{}) *IOneLoginSDK_GetAppsTyped_Call {
+	return &IOneLoginSDK_GetAppsTyped_Call{Call: _e.mock.On("GetAppsTyped", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppsTyped_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetAppsTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppsTyped_Call) Return(_a0 *models.Response[[]models.App], _a1 error) *IOneLoginSDK_GetAppsTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppsTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.App], error)) *IOneLoginSDK_GetAppsTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppsTypedWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetAppsTypedWithContext(ctx context.Context, queryParams models.Queryable) (*models.Response[[]models.App], error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppsTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.App]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.App], error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.App]); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.App])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppsTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppsTypedWithContext'
+type IOneLoginSDK_GetAppsTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppsTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppsTypedWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetAppsTypedWithContext_Call {
+	return &IOneLoginSDK_GetAppsTypedWithContext_Call{Call: _e.mock.On("GetAppsTypedWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppsTypedWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetAppsTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppsTypedWithContext_Call) Return(_a0 *models.Response[[]models.App], _a1 error) *IOneLoginSDK_GetAppsTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppsTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.App], error)) *IOneLoginSDK_GetAppsTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAppsWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetAppsWithContext(ctx context.Context, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAppsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAppsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAppsWithContext'
+type IOneLoginSDK_GetAppsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAppsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAppsWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetAppsWithContext_Call {
+	return &IOneLoginSDK_GetAppsWithContext_Call{Call: _e.mock.On("GetAppsWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAppsWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetAppsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAppsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAppsWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAppsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthClaims provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetAuthClaims(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthClaims")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthClaims'
+type IOneLoginSDK_GetAuthClaims_Call struct {
+	*mock.Call
+}
+
+// GetAuthClaims is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthClaims(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthClaims_Call {
+	return &IOneLoginSDK_GetAuthClaims_Call{Call: _e.mock.On("GetAuthClaims", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthClaims_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthClaims_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthClaims_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthClaimsWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetAuthClaimsWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthClaimsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthClaimsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthClaimsWithContext'
+type IOneLoginSDK_GetAuthClaimsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAuthClaimsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthClaimsWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthClaimsWithContext_Call {
+	return &IOneLoginSDK_GetAuthClaimsWithContext_Call{Call: _e.mock.On("GetAuthClaimsWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthClaimsWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthClaimsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthClaimsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthClaimsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthClaimsWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthClaimsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServerByID provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetAuthServerByID(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServerByID")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServerByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServerByID'
+type IOneLoginSDK_GetAuthServerByID_Call struct {
+	*mock.Call
+}
+
+// GetAuthServerByID is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServerByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServerByID_Call {
+	return &IOneLoginSDK_GetAuthServerByID_Call{Call: _e.mock.On("GetAuthServerByID", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServerByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServerByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServerByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServerByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServerByIDWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetAuthServerByIDWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServerByIDWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServerByIDWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServerByIDWithContext'
+type IOneLoginSDK_GetAuthServerByIDWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAuthServerByIDWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServerByIDWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServerByIDWithContext_Call {
+	return &IOneLoginSDK_GetAuthServerByIDWithContext_Call{Call: _e.mock.On("GetAuthServerByIDWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServerByIDWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServerByIDWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerByIDWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServerByIDWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerByIDWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServerByIDWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServerScopes provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetAuthServerScopes(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServerScopes")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServerScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServerScopes'
+type IOneLoginSDK_GetAuthServerScopes_Call struct {
+	*mock.Call
+}
+
+// GetAuthServerScopes is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServerScopes(id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServerScopes_Call {
+	return &IOneLoginSDK_GetAuthServerScopes_Call{Call: _e.mock.On("GetAuthServerScopes", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServerScopes_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServerScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerScopes_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServerScopes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerScopes_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServerScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServerScopesWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetAuthServerScopesWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServerScopesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServerScopesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServerScopesWithContext'
+type IOneLoginSDK_GetAuthServerScopesWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAuthServerScopesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServerScopesWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServerScopesWithContext_Call {
+	return &IOneLoginSDK_GetAuthServerScopesWithContext_Call{Call: _e.mock.On("GetAuthServerScopesWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServerScopesWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServerScopesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerScopesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServerScopesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServerScopesWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServerScopesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServers provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetAuthServers(queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServers")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServers'
+type IOneLoginSDK_GetAuthServers_Call struct {
+	*mock.Call
+}
+
+// GetAuthServers is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServers(queryParams interface{}) *IOneLoginSDK_GetAuthServers_Call {
+	return &IOneLoginSDK_GetAuthServers_Call{Call: _e.mock.On("GetAuthServers", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServers_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetAuthServers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServers_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServersTyped provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetAuthServersTyped(queryParams models.Queryable) (*models.Response[[]models.AuthServer], error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServersTyped")
+	}
+
+	var r0 *models.Response[[]models.AuthServer]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.AuthServer], error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.AuthServer]); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.AuthServer])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServersTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServersTyped'
+type IOneLoginSDK_GetAuthServersTyped_Call struct {
+	*mock.Call
+}
+
+// GetAuthServersTyped is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServersTyped(queryParams interface{}) *IOneLoginSDK_GetAuthServersTyped_Call {
+	return &IOneLoginSDK_GetAuthServersTyped_Call{Call: _e.mock.On("GetAuthServersTyped", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServersTyped_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetAuthServersTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServersTyped_Call) Return(_a0 *models.Response[[]models.AuthServer], _a1 error) *IOneLoginSDK_GetAuthServersTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServersTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.AuthServer], error)) *IOneLoginSDK_GetAuthServersTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServersTypedWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetAuthServersTypedWithContext(ctx context.Context, queryParams models.Queryable) (*models.Response[[]models.AuthServer], error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServersTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.AuthServer]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.AuthServer], error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.AuthServer]); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.AuthServer])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServersTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServersTypedWithContext'
+type IOneLoginSDK_GetAuthServersTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAuthServersTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServersTypedWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServersTypedWithContext_Call {
+	return &IOneLoginSDK_GetAuthServersTypedWithContext_Call{Call: _e.mock.On("GetAuthServersTypedWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServersTypedWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServersTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServersTypedWithContext_Call) Return(_a0 *models.Response[[]models.AuthServer], _a1 error) *IOneLoginSDK_GetAuthServersTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServersTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.AuthServer], error)) *IOneLoginSDK_GetAuthServersTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuthServersWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetAuthServersWithContext(ctx context.Context, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuthServersWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAuthServersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuthServersWithContext'
+type IOneLoginSDK_GetAuthServersWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAuthServersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetAuthServersWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetAuthServersWithContext_Call {
+	return &IOneLoginSDK_GetAuthServersWithContext_Call{Call: _e.mock.On("GetAuthServersWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetAuthServersWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetAuthServersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAuthServersWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAuthServersWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetAuthServersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailableMFAFactors provides a mock function with given fields: userID
+func (_m *IOneLoginSDK) GetAvailableMFAFactors(userID int) (interface{}, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableMFAFactors")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAvailableMFAFactors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailableMFAFactors'
+type IOneLoginSDK_GetAvailableMFAFactors_Call struct {
+	*mock.Call
+}
+
+// GetAvailableMFAFactors is a helper method to define mock.On call
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) GetAvailableMFAFactors(userID interface{}) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+	return &IOneLoginSDK_GetAvailableMFAFactors_Call{Call: _e.mock.On("GetAvailableMFAFactors", userID)}
+}
+
+func (_c *IOneLoginSDK_GetAvailableMFAFactors_Call) Run(run func(userID int)) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAvailableMFAFactors_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAvailableMFAFactors_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetAvailableMFAFactors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailableMFAFactorsWithContext provides a mock function with given fields: ctx, userID
+func (_m *IOneLoginSDK) GetAvailableMFAFactorsWithContext(ctx context.Context, userID int) (interface{}, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableMFAFactorsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailableMFAFactorsWithContext'
+type IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAvailableMFAFactorsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) GetAvailableMFAFactorsWithContext(ctx interface{}, userID interface{}) *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call {
+	return &IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call{Call: _e.mock.On("GetAvailableMFAFactorsWithContext", ctx, userID)}
+}
+
+func (_c *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call) Run(run func(ctx context.Context, userID int)) *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetAvailableMFAFactorsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClientApps provides a mock function with given fields: id
+func (_m *IOneLoginSDK) GetClientApps(id int) (interface{}, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientApps")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetClientApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientApps'
+type IOneLoginSDK_GetClientApps_Call struct {
+	*mock.Call
+}
+
+// GetClientApps is a helper method to define mock.On call
+//   - id int
+func (_e *IOneLoginSDK_Expecter) GetClientApps(id interface{}) *IOneLoginSDK_GetClientApps_Call {
+	return &IOneLoginSDK_GetClientApps_Call{Call: _e.mock.On("GetClientApps", id)}
+}
+
+func (_c *IOneLoginSDK_GetClientApps_Call) Run(run func(id int)) *IOneLoginSDK_GetClientApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetClientApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetClientApps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetClientApps_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetClientApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClientAppsWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) GetClientAppsWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientAppsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetClientAppsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClientAppsWithContext'
+type IOneLoginSDK_GetClientAppsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetClientAppsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *IOneLoginSDK_Expecter) GetClientAppsWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_GetClientAppsWithContext_Call {
+	return &IOneLoginSDK_GetClientAppsWithContext_Call{Call: _e.mock.On("GetClientAppsWithContext", ctx, id)}
+}
+
+func (_c *IOneLoginSDK_GetClientAppsWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_GetClientAppsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetClientAppsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetClientAppsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetClientAppsWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetClientAppsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomAttributes provides a mock function with given fields:
+func (_m *IOneLoginSDK) GetCustomAttributes() (interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomAttributes")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetCustomAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomAttributes'
+type IOneLoginSDK_GetCustomAttributes_Call struct {
+	*mock.Call
+}
+
+// GetCustomAttributes is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) GetCustomAttributes() *IOneLoginSDK_GetCustomAttributes_Call {
+	return &IOneLoginSDK_GetCustomAttributes_Call{Call: _e.mock.On("GetCustomAttributes")}
+}
+
+func (_c *IOneLoginSDK_GetCustomAttributes_Call) Run(run func()) *IOneLoginSDK_GetCustomAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetCustomAttributes_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetCustomAttributes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetCustomAttributes_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_GetCustomAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomAttributesWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) GetCustomAttributesWithContext(ctx context.Context) (interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomAttributesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetCustomAttributesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomAttributesWithContext'
+type IOneLoginSDK_GetCustomAttributesWithContext_Call struct {
+	*mock.Call
+}
+
+// GetCustomAttributesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) GetCustomAttributesWithContext(ctx interface{}) *IOneLoginSDK_GetCustomAttributesWithContext_Call {
+	return &IOneLoginSDK_GetCustomAttributesWithContext_Call{Call: _e.mock.On("GetCustomAttributesWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_GetCustomAttributesWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_GetCustomAttributesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetCustomAttributesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetCustomAttributesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetCustomAttributesWithContext_Call) RunAndReturn(run func(context.Context) (interface{}, error)) *IOneLoginSDK_GetCustomAttributesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnrolledMFAFactors provides a mock function with given fields: userID
+func (_m *IOneLoginSDK) GetEnrolledMFAFactors(userID int) (interface{}, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnrolledMFAFactors")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetEnrolledMFAFactors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrolledMFAFactors'
+type IOneLoginSDK_GetEnrolledMFAFactors_Call struct {
+	*mock.Call
+}
+
+// GetEnrolledMFAFactors is a helper method to define mock.On call
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) GetEnrolledMFAFactors(userID interface{}) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+	return &IOneLoginSDK_GetEnrolledMFAFactors_Call{Call: _e.mock.On("GetEnrolledMFAFactors", userID)}
+}
+
+func (_c *IOneLoginSDK_GetEnrolledMFAFactors_Call) Run(run func(userID int)) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnrolledMFAFactors_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnrolledMFAFactors_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetEnrolledMFAFactors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnrolledMFAFactorsWithContext provides a mock function with given fields: ctx, userID
+func (_m *IOneLoginSDK) GetEnrolledMFAFactorsWithContext(ctx context.Context, userID int) (interface{}, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnrolledMFAFactorsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnrolledMFAFactorsWithContext'
+type IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetEnrolledMFAFactorsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) GetEnrolledMFAFactorsWithContext(ctx interface{}, userID interface{}) *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call {
+	return &IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call{Call: _e.mock.On("GetEnrolledMFAFactorsWithContext", ctx, userID)}
+}
+
+func (_c *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call) Run(run func(ctx context.Context, userID int)) *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetEnrolledMFAFactorsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentVariable provides a mock function with given fields: envVarID
+func (_m *IOneLoginSDK) GetEnvironmentVariable(envVarID int) (interface{}, error) {
+	ret := _m.Called(envVarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentVariable")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(envVarID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(envVarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(envVarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentVariable'
+type IOneLoginSDK_GetEnvironmentVariable_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentVariable is a helper method to define mock.On call
+//   - envVarID int
+func (_e *IOneLoginSDK_Expecter) GetEnvironmentVariable(envVarID interface{}) *IOneLoginSDK_GetEnvironmentVariable_Call {
+	return &IOneLoginSDK_GetEnvironmentVariable_Call{Call: _e.mock.On("GetEnvironmentVariable", envVarID)}
+}
+
+func (_c *IOneLoginSDK_GetEnvironmentVariable_Call) Run(run func(envVarID int)) *IOneLoginSDK_GetEnvironmentVariable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetEnvironmentVariable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnvironmentVariable_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetEnvironmentVariable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentVariableWithContext provides a mock function with given fields: ctx, envVarID
+func (_m *IOneLoginSDK) GetEnvironmentVariableWithContext(ctx context.Context, envVarID int) (interface{}, error) {
+	ret := _m.Called(ctx, envVarID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentVariableWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, envVarID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, envVarID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, envVarID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetEnvironmentVariableWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentVariableWithContext'
+type IOneLoginSDK_GetEnvironmentVariableWithContext_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentVariableWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - envVarID int
+func (_e *IOneLoginSDK_Expecter) GetEnvironmentVariableWithContext(ctx interface{}, envVarID interface{}) *IOneLoginSDK_GetEnvironmentVariableWithContext_Call {
+	return &IOneLoginSDK_GetEnvironmentVariableWithContext_Call{Call: _e.mock.On("GetEnvironmentVariableWithContext", ctx, envVarID)}
+}
+
+func (_c *IOneLoginSDK_GetEnvironmentVariableWithContext_Call) Run(run func(ctx context.Context, envVarID int)) *IOneLoginSDK_GetEnvironmentVariableWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnvironmentVariableWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetEnvironmentVariableWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetEnvironmentVariableWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetEnvironmentVariableWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByID provides a mock function with given fields: groupID
+func (_m *IOneLoginSDK) GetGroupByID(groupID int) (interface{}, error) {
+	ret := _m.Called(groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByID")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(groupID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByID'
+type IOneLoginSDK_GetGroupByID_Call struct {
+	*mock.Call
+}
+
+// GetGroupByID is a helper method to define mock.On call
+//   - groupID int
+func (_e *IOneLoginSDK_Expecter) GetGroupByID(groupID interface{}) *IOneLoginSDK_GetGroupByID_Call {
+	return &IOneLoginSDK_GetGroupByID_Call{Call: _e.mock.On("GetGroupByID", groupID)}
+}
+
+func (_c *IOneLoginSDK_GetGroupByID_Call) Run(run func(groupID int)) *IOneLoginSDK_GetGroupByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetGroupByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByID_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetGroupByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByIDTyped provides a mock function with given fields: groupID
+func (_m *IOneLoginSDK) GetGroupByIDTyped(groupID int) (*models.Response[models.Group], error) {
+	ret := _m.Called(groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByIDTyped")
+	}
+
+	var r0 *models.Response[models.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Response[models.Group], error)); ok {
+		return rf(groupID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Response[models.Group]); ok {
+		r0 = rf(groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Group])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupByIDTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByIDTyped'
+type IOneLoginSDK_GetGroupByIDTyped_Call struct {
+	*mock.Call
+}
+
+// GetGroupByIDTyped is a helper method to define mock.On call
+//   - groupID int
+func (_e *IOneLoginSDK_Expecter) GetGroupByIDTyped(groupID interface{}) *IOneLoginSDK_GetGroupByIDTyped_Call {
+	return &IOneLoginSDK_GetGroupByIDTyped_Call{Call: _e.mock.On("GetGroupByIDTyped", groupID)}
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDTyped_Call) Run(run func(groupID int)) *IOneLoginSDK_GetGroupByIDTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDTyped_Call) Return(_a0 *models.Response[models.Group], _a1 error) *IOneLoginSDK_GetGroupByIDTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDTyped_Call) RunAndReturn(run func(int) (*models.Response[models.Group], error)) *IOneLoginSDK_GetGroupByIDTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByIDTypedWithContext provides a mock function with given fields: ctx, groupID
+func (_m *IOneLoginSDK) GetGroupByIDTypedWithContext(ctx context.Context, groupID int) (*models.Response[models.Group], error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByIDTypedWithContext")
+	}
+
+	var r0 *models.Response[models.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*models.Response[models.Group], error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *models.Response[models.Group]); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Group])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupByIDTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByIDTypedWithContext'
+type IOneLoginSDK_GetGroupByIDTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetGroupByIDTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID int
+func (_e *IOneLoginSDK_Expecter) GetGroupByIDTypedWithContext(ctx interface{}, groupID interface{}) *IOneLoginSDK_GetGroupByIDTypedWithContext_Call {
+	return &IOneLoginSDK_GetGroupByIDTypedWithContext_Call{Call: _e.mock.On("GetGroupByIDTypedWithContext", ctx, groupID)}
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDTypedWithContext_Call) Run(run func(ctx context.Context, groupID int)) *IOneLoginSDK_GetGroupByIDTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDTypedWithContext_Call) Return(_a0 *models.Response[models.Group], _a1 error) *IOneLoginSDK_GetGroupByIDTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDTypedWithContext_Call) RunAndReturn(run func(context.Context, int) (*models.Response[models.Group], error)) *IOneLoginSDK_GetGroupByIDTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByIDWithContext provides a mock function with given fields: ctx, groupID
+func (_m *IOneLoginSDK) GetGroupByIDWithContext(ctx context.Context, groupID int) (interface{}, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByIDWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupByIDWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByIDWithContext'
+type IOneLoginSDK_GetGroupByIDWithContext_Call struct {
+	*mock.Call
+}
+
+// GetGroupByIDWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID int
+func (_e *IOneLoginSDK_Expecter) GetGroupByIDWithContext(ctx interface{}, groupID interface{}) *IOneLoginSDK_GetGroupByIDWithContext_Call {
+	return &IOneLoginSDK_GetGroupByIDWithContext_Call{Call: _e.mock.On("GetGroupByIDWithContext", ctx, groupID)}
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDWithContext_Call) Run(run func(ctx context.Context, groupID int)) *IOneLoginSDK_GetGroupByIDWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetGroupByIDWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupByIDWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetGroupByIDWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroups provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetGroups(queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroups")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroups'
+type IOneLoginSDK_GetGroups_Call struct {
+	*mock.Call
+}
+
+// GetGroups is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetGroups(queryParams interface{}) *IOneLoginSDK_GetGroups_Call {
+	return &IOneLoginSDK_GetGroups_Call{Call: _e.mock.On("GetGroups", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetGroups_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroups_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroups_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupsTyped provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetGroupsTyped(queryParams models.Queryable) (*models.Response[[]models.Group], error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupsTyped")
+	}
+
+	var r0 *models.Response[[]models.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.Group], error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.Group]); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.Group])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupsTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupsTyped'
+type IOneLoginSDK_GetGroupsTyped_Call struct {
+	*mock.Call
+}
+
+// GetGroupsTyped is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetGroupsTyped(queryParams interface{}) *IOneLoginSDK_GetGroupsTyped_Call {
+	return &IOneLoginSDK_GetGroupsTyped_Call{Call: _e.mock.On("GetGroupsTyped", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetGroupsTyped_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetGroupsTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupsTyped_Call) Return(_a0 *models.Response[[]models.Group], _a1 error) *IOneLoginSDK_GetGroupsTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupsTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.Group], error)) *IOneLoginSDK_GetGroupsTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupsTypedWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetGroupsTypedWithContext(ctx context.Context, queryParams models.Queryable) (*models.Response[[]models.Group], error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupsTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.Group]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.Group], error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.Group]); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.Group])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupsTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupsTypedWithContext'
+type IOneLoginSDK_GetGroupsTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetGroupsTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetGroupsTypedWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetGroupsTypedWithContext_Call {
+	return &IOneLoginSDK_GetGroupsTypedWithContext_Call{Call: _e.mock.On("GetGroupsTypedWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetGroupsTypedWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetGroupsTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupsTypedWithContext_Call) Return(_a0 *models.Response[[]models.Group], _a1 error) *IOneLoginSDK_GetGroupsTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupsTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.Group], error)) *IOneLoginSDK_GetGroupsTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupsWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetGroupsWithContext(ctx context.Context, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetGroupsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupsWithContext'
+type IOneLoginSDK_GetGroupsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetGroupsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetGroupsWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetGroupsWithContext_Call {
+	return &IOneLoginSDK_GetGroupsWithContext_Call{Call: _e.mock.On("GetGroupsWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetGroupsWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetGroupsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetGroupsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetGroupsWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetGroupsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHook provides a mock function with given fields: hookID, query
+func (_m *IOneLoginSDK) GetHook(hookID int, query models.Queryable) (interface{}, error) {
+	ret := _m.Called(hookID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHook")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(hookID, query)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(hookID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(hookID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHook'
+type IOneLoginSDK_GetHook_Call struct {
+	*mock.Call
+}
+
+// GetHook is a helper method to define mock.On call
+//   - hookID int
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetHook(hookID interface{}, query interface{}) *IOneLoginSDK_GetHook_Call {
+	return &IOneLoginSDK_GetHook_Call{Call: _e.mock.On("GetHook", hookID, query)}
+}
+
+func (_c *IOneLoginSDK_GetHook_Call) Run(run func(hookID int, query models.Queryable)) *IOneLoginSDK_GetHook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetHook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHook_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetHook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHookLogs provides a mock function with given fields: hookID, query
+func (_m *IOneLoginSDK) GetHookLogs(hookID int, query models.Queryable) (interface{}, error) {
+	ret := _m.Called(hookID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHookLogs")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(hookID, query)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(hookID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(hookID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetHookLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHookLogs'
+type IOneLoginSDK_GetHookLogs_Call struct {
+	*mock.Call
+}
+
+// GetHookLogs is a helper method to define mock.On call
+//   - hookID int
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetHookLogs(hookID interface{}, query interface{}) *IOneLoginSDK_GetHookLogs_Call {
+	return &IOneLoginSDK_GetHookLogs_Call{Call: _e.mock.On("GetHookLogs", hookID, query)}
+}
+
+func (_c *IOneLoginSDK_GetHookLogs_Call) Run(run func(hookID int, query models.Queryable)) *IOneLoginSDK_GetHookLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookLogs_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetHookLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookLogs_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetHookLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHookLogsWithContext provides a mock function with given fields: ctx, hookID, query
+func (_m *IOneLoginSDK) GetHookLogsWithContext(ctx context.Context, hookID int, query models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, hookID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHookLogsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, hookID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, hookID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, hookID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetHookLogsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHookLogsWithContext'
+type IOneLoginSDK_GetHookLogsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetHookLogsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hookID int
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetHookLogsWithContext(ctx interface{}, hookID interface{}, query interface{}) *IOneLoginSDK_GetHookLogsWithContext_Call {
+	return &IOneLoginSDK_GetHookLogsWithContext_Call{Call: _e.mock.On("GetHookLogsWithContext", ctx, hookID, query)}
+}
+
+func (_c *IOneLoginSDK_GetHookLogsWithContext_Call) Run(run func(ctx context.Context, hookID int, query models.Queryable)) *IOneLoginSDK_GetHookLogsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookLogsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetHookLogsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookLogsWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetHookLogsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHookTyped provides a mock function with given fields: hookID, query
+func (_m *IOneLoginSDK) GetHookTyped(hookID int, query models.Queryable) (*models.Response[models.SmartHook], error) {
+	ret := _m.Called(hookID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHookTyped")
+	}
+
+	var r0 *models.Response[models.SmartHook]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (*models.Response[models.SmartHook], error)); ok {
+		return rf(hookID, query)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) *models.Response[models.SmartHook]); ok {
+		r0 = rf(hookID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.SmartHook])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(hookID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetHookTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHookTyped'
+type IOneLoginSDK_GetHookTyped_Call struct {
+	*mock.Call
+}
+
+// GetHookTyped is a helper method to define mock.On call
+//   - hookID int
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetHookTyped(hookID interface{}, query interface{}) *IOneLoginSDK_GetHookTyped_Call {
+	return &IOneLoginSDK_GetHookTyped_Call{Call: _e.mock.On("GetHookTyped", hookID, query)}
+}
+
+func (_c *IOneLoginSDK_GetHookTyped_Call) Run(run func(hookID int, query models.Queryable)) *IOneLoginSDK_GetHookTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookTyped_Call) Return(_a0 *models.Response[models.SmartHook], _a1 error) *IOneLoginSDK_GetHookTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookTyped_Call) RunAndReturn(run func(int, models.Queryable) (*models.Response[models.SmartHook], error)) *IOneLoginSDK_GetHookTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHookTypedWithContext provides a mock function with given fields: ctx, hookID, query
+func (_m *IOneLoginSDK) GetHookTypedWithContext(ctx context.Context, hookID int, query models.Queryable) (*models.Response[models.SmartHook], error) {
+	ret := _m.Called(ctx, hookID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHookTypedWithContext")
+	}
+
+	var r0 *models.Response[models.SmartHook]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (*models.Response[models.SmartHook], error)); ok {
+		return rf(ctx, hookID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) *models.Response[models.SmartHook]); ok {
+		r0 = rf(ctx, hookID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.SmartHook])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, hookID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetHookTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHookTypedWithContext'
+type IOneLoginSDK_GetHookTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetHookTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hookID int
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetHookTypedWithContext(ctx interface{}, hookID interface{}, query interface{}) *IOneLoginSDK_GetHookTypedWithContext_Call {
+	return &IOneLoginSDK_GetHookTypedWithContext_Call{Call: _e.mock.On("GetHookTypedWithContext", ctx, hookID, query)}
+}
+
+func (_c *IOneLoginSDK_GetHookTypedWithContext_Call) Run(run func(ctx context.Context, hookID int, query models.Queryable)) *IOneLoginSDK_GetHookTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookTypedWithContext_Call) Return(_a0 *models.Response[models.SmartHook], _a1 error) *IOneLoginSDK_GetHookTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (*models.Response[models.SmartHook], error)) *IOneLoginSDK_GetHookTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHookWithContext provides a mock function with given fields: ctx, hookID, query
+func (_m *IOneLoginSDK) GetHookWithContext(ctx context.Context, hookID int, query models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, hookID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHookWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, hookID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, hookID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, hookID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetHookWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHookWithContext'
+type IOneLoginSDK_GetHookWithContext_Call struct {
+	*mock.Call
+}
+
+// GetHookWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hookID int
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetHookWithContext(ctx interface{}, hookID interface{}, query interface{}) *IOneLoginSDK_GetHookWithContext_Call {
+	return &IOneLoginSDK_GetHookWithContext_Call{Call: _e.mock.On("GetHookWithContext", ctx, hookID, query)}
+}
+
+func (_c *IOneLoginSDK_GetHookWithContext_Call) Run(run func(ctx context.Context, hookID int, query models.Queryable)) *IOneLoginSDK_GetHookWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetHookWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetHookWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetHookWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMapping provides a mock function with given fields: mappingID
+func (_m *IOneLoginSDK) GetMapping(mappingID int) (interface{}, error) {
+	ret := _m.Called(mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMapping")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(mappingID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(mappingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(mappingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMapping'
+type IOneLoginSDK_GetMapping_Call struct {
+	*mock.Call
+}
+
+// GetMapping is a helper method to define mock.On call
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) GetMapping(mappingID interface{}) *IOneLoginSDK_GetMapping_Call {
+	return &IOneLoginSDK_GetMapping_Call{Call: _e.mock.On("GetMapping", mappingID)}
+}
+
+func (_c *IOneLoginSDK_GetMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_GetMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetMapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMappingTyped provides a mock function with given fields: mappingID
+func (_m *IOneLoginSDK) GetMappingTyped(mappingID int) (*models.Response[models.UserMapping], error) {
+	ret := _m.Called(mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMappingTyped")
+	}
+
+	var r0 *models.Response[models.UserMapping]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.Response[models.UserMapping], error)); ok {
+		return rf(mappingID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.Response[models.UserMapping]); ok {
+		r0 = rf(mappingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.UserMapping])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(mappingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetMappingTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMappingTyped'
+type IOneLoginSDK_GetMappingTyped_Call struct {
+	*mock.Call
+}
+
+// GetMappingTyped is a helper method to define mock.On call
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) GetMappingTyped(mappingID interface{}) *IOneLoginSDK_GetMappingTyped_Call {
+	return &IOneLoginSDK_GetMappingTyped_Call{Call: _e.mock.On("GetMappingTyped", mappingID)}
+}
+
+func (_c *IOneLoginSDK_GetMappingTyped_Call) Run(run func(mappingID int)) *IOneLoginSDK_GetMappingTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMappingTyped_Call) Return(_a0 *models.Response[models.UserMapping], _a1 error) *IOneLoginSDK_GetMappingTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMappingTyped_Call) RunAndReturn(run func(int) (*models.Response[models.UserMapping], error)) *IOneLoginSDK_GetMappingTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMappingTypedWithContext provides a mock function with given fields: ctx, mappingID
+func (_m *IOneLoginSDK) GetMappingTypedWithContext(ctx context.Context, mappingID int) (*models.Response[models.UserMapping], error) {
+	ret := _m.Called(ctx, mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMappingTypedWithContext")
+	}
+
+	var r0 *models.Response[models.UserMapping]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*models.Response[models.UserMapping], error)); ok {
+		return rf(ctx, mappingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *models.Response[models.UserMapping]); ok {
+		r0 = rf(ctx, mappingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.UserMapping])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, mappingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetMappingTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMappingTypedWithContext'
+type IOneLoginSDK_GetMappingTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetMappingTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) GetMappingTypedWithContext(ctx interface{}, mappingID interface{}) *IOneLoginSDK_GetMappingTypedWithContext_Call {
+	return &IOneLoginSDK_GetMappingTypedWithContext_Call{Call: _e.mock.On("GetMappingTypedWithContext", ctx, mappingID)}
+}
+
+func (_c *IOneLoginSDK_GetMappingTypedWithContext_Call) Run(run func(ctx context.Context, mappingID int)) *IOneLoginSDK_GetMappingTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMappingTypedWithContext_Call) Return(_a0 *models.Response[models.UserMapping], _a1 error) *IOneLoginSDK_GetMappingTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMappingTypedWithContext_Call) RunAndReturn(run func(context.Context, int) (*models.Response[models.UserMapping], error)) *IOneLoginSDK_GetMappingTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMappingWithContext provides a mock function with given fields: ctx, mappingID
+func (_m *IOneLoginSDK) GetMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
+	ret := _m.Called(ctx, mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMappingWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, mappingID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, mappingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, mappingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetMappingWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMappingWithContext'
+type IOneLoginSDK_GetMappingWithContext_Call struct {
+	*mock.Call
+}
+
+// GetMappingWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) GetMappingWithContext(ctx interface{}, mappingID interface{}) *IOneLoginSDK_GetMappingWithContext_Call {
+	return &IOneLoginSDK_GetMappingWithContext_Call{Call: _e.mock.On("GetMappingWithContext", ctx, mappingID)}
+}
+
+func (_c *IOneLoginSDK_GetMappingWithContext_Call) Run(run func(ctx context.Context, mappingID int)) *IOneLoginSDK_GetMappingWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMappingWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetMappingWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetMappingWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetMappingWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilege provides a mock function with given fields: privilegeID
+func (_m *IOneLoginSDK) GetPrivilege(privilegeID string) (interface{}, error) {
+	ret := _m.Called(privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilege")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilege'
+type IOneLoginSDK_GetPrivilege_Call struct {
+	*mock.Call
+}
+
+// GetPrivilege is a helper method to define mock.On call
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilege(privilegeID interface{}) *IOneLoginSDK_GetPrivilege_Call {
+	return &IOneLoginSDK_GetPrivilege_Call{Call: _e.mock.On("GetPrivilege", privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilege_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilege_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilege_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilege_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GetPrivilege_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeRoles provides a mock function with given fields: privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeRoles(privilegeID string) (interface{}, error) {
+	ret := _m.Called(privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeRoles")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeRoles'
+type IOneLoginSDK_GetPrivilegeRoles_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeRoles is a helper method to define mock.On call
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeRoles(privilegeID interface{}) *IOneLoginSDK_GetPrivilegeRoles_Call {
+	return &IOneLoginSDK_GetPrivilegeRoles_Call{Call: _e.mock.On("GetPrivilegeRoles", privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeRoles_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilegeRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeRoles_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeRoles_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeRolesWithContext provides a mock function with given fields: ctx, privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeRolesWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeRolesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeRolesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeRolesWithContext'
+type IOneLoginSDK_GetPrivilegeRolesWithContext_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeRolesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeRolesWithContext(ctx interface{}, privilegeID interface{}) *IOneLoginSDK_GetPrivilegeRolesWithContext_Call {
+	return &IOneLoginSDK_GetPrivilegeRolesWithContext_Call{Call: _e.mock.On("GetPrivilegeRolesWithContext", ctx, privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeRolesWithContext_Call) Run(run func(ctx context.Context, privilegeID string)) *IOneLoginSDK_GetPrivilegeRolesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeRolesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeRolesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeRolesWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeRolesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeTyped provides a mock function with given fields: privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeTyped(privilegeID string) (*models.Response[models.Privilege], error) {
+	ret := _m.Called(privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeTyped")
+	}
+
+	var r0 *models.Response[models.Privilege]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Response[models.Privilege], error)); ok {
+		return rf(privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Response[models.Privilege]); ok {
+		r0 = rf(privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Privilege])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeTyped'
+type IOneLoginSDK_GetPrivilegeTyped_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeTyped is a helper method to define mock.On call
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeTyped(privilegeID interface{}) *IOneLoginSDK_GetPrivilegeTyped_Call {
+	return &IOneLoginSDK_GetPrivilegeTyped_Call{Call: _e.mock.On("GetPrivilegeTyped", privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeTyped_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilegeTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeTyped_Call) Return(_a0 *models.Response[models.Privilege], _a1 error) *IOneLoginSDK_GetPrivilegeTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeTyped_Call) RunAndReturn(run func(string) (*models.Response[models.Privilege], error)) *IOneLoginSDK_GetPrivilegeTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeTypedWithContext provides a mock function with given fields: ctx, privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeTypedWithContext(ctx context.Context, privilegeID string) (*models.Response[models.Privilege], error) {
+	ret := _m.Called(ctx, privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeTypedWithContext")
+	}
+
+	var r0 *models.Response[models.Privilege]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Response[models.Privilege], error)); ok {
+		return rf(ctx, privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Response[models.Privilege]); ok {
+		r0 = rf(ctx, privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Privilege])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeTypedWithContext'
+type IOneLoginSDK_GetPrivilegeTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeTypedWithContext(ctx interface{}, privilegeID interface{}) *IOneLoginSDK_GetPrivilegeTypedWithContext_Call {
+	return &IOneLoginSDK_GetPrivilegeTypedWithContext_Call{Call: _e.mock.On("GetPrivilegeTypedWithContext", ctx, privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeTypedWithContext_Call) Run(run func(ctx context.Context, privilegeID string)) *IOneLoginSDK_GetPrivilegeTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeTypedWithContext_Call) Return(_a0 *models.Response[models.Privilege], _a1 error) *IOneLoginSDK_GetPrivilegeTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeTypedWithContext_Call) RunAndReturn(run func(context.Context, string) (*models.Response[models.Privilege], error)) *IOneLoginSDK_GetPrivilegeTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeUsers provides a mock function with given fields: privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeUsers(privilegeID string) (interface{}, error) {
+	ret := _m.Called(privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeUsers")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeUsers'
+type IOneLoginSDK_GetPrivilegeUsers_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeUsers is a helper method to define mock.On call
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeUsers(privilegeID interface{}) *IOneLoginSDK_GetPrivilegeUsers_Call {
+	return &IOneLoginSDK_GetPrivilegeUsers_Call{Call: _e.mock.On("GetPrivilegeUsers", privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeUsers_Call) Run(run func(privilegeID string)) *IOneLoginSDK_GetPrivilegeUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeUsers_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeUsersWithContext provides a mock function with given fields: ctx, privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeUsersWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeUsersWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeUsersWithContext'
+type IOneLoginSDK_GetPrivilegeUsersWithContext_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeUsersWithContext(ctx interface{}, privilegeID interface{}) *IOneLoginSDK_GetPrivilegeUsersWithContext_Call {
+	return &IOneLoginSDK_GetPrivilegeUsersWithContext_Call{Call: _e.mock.On("GetPrivilegeUsersWithContext", ctx, privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeUsersWithContext_Call) Run(run func(ctx context.Context, privilegeID string)) *IOneLoginSDK_GetPrivilegeUsersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeUsersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeUsersWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeUsersWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeUsersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrivilegeWithContext provides a mock function with given fields: ctx, privilegeID
+func (_m *IOneLoginSDK) GetPrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrivilegeWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, privilegeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, privilegeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, privilegeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetPrivilegeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrivilegeWithContext'
+type IOneLoginSDK_GetPrivilegeWithContext_Call struct {
+	*mock.Call
+}
+
+// GetPrivilegeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) GetPrivilegeWithContext(ctx interface{}, privilegeID interface{}) *IOneLoginSDK_GetPrivilegeWithContext_Call {
+	return &IOneLoginSDK_GetPrivilegeWithContext_Call{Call: _e.mock.On("GetPrivilegeWithContext", ctx, privilegeID)}
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeWithContext_Call) Run(run func(ctx context.Context, privilegeID string)) *IOneLoginSDK_GetPrivilegeWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetPrivilegeWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetPrivilegeWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_GetPrivilegeWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleAdmins provides a mock function with given fields: roleID
+func (_m *IOneLoginSDK) GetRoleAdmins(roleID int) (interface{}, error) {
+	ret := _m.Called(roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleAdmins")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(roleID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleAdmins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleAdmins'
+type IOneLoginSDK_GetRoleAdmins_Call struct {
+	*mock.Call
+}
+
+// GetRoleAdmins is a helper method to define mock.On call
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) GetRoleAdmins(roleID interface{}) *IOneLoginSDK_GetRoleAdmins_Call {
+	return &IOneLoginSDK_GetRoleAdmins_Call{Call: _e.mock.On("GetRoleAdmins", roleID)}
+}
+
+func (_c *IOneLoginSDK_GetRoleAdmins_Call) Run(run func(roleID int)) *IOneLoginSDK_GetRoleAdmins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleAdmins_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleAdmins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleAdmins_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetRoleAdmins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleAdminsWithContext provides a mock function with given fields: ctx, roleID
+func (_m *IOneLoginSDK) GetRoleAdminsWithContext(ctx context.Context, roleID int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleAdminsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, roleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleAdminsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleAdminsWithContext'
+type IOneLoginSDK_GetRoleAdminsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRoleAdminsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) GetRoleAdminsWithContext(ctx interface{}, roleID interface{}) *IOneLoginSDK_GetRoleAdminsWithContext_Call {
+	return &IOneLoginSDK_GetRoleAdminsWithContext_Call{Call: _e.mock.On("GetRoleAdminsWithContext", ctx, roleID)}
+}
+
+func (_c *IOneLoginSDK_GetRoleAdminsWithContext_Call) Run(run func(ctx context.Context, roleID int)) *IOneLoginSDK_GetRoleAdminsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleAdminsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleAdminsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleAdminsWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetRoleAdminsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleApps provides a mock function with given fields: roleID
+func (_m *IOneLoginSDK) GetRoleApps(roleID int) (interface{}, error) {
+	ret := _m.Called(roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleApps")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(roleID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleApps'
+type IOneLoginSDK_GetRoleApps_Call struct {
+	*mock.Call
+}
+
+// GetRoleApps is a helper method to define mock.On call
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) GetRoleApps(roleID interface{}) *IOneLoginSDK_GetRoleApps_Call {
+	return &IOneLoginSDK_GetRoleApps_Call{Call: _e.mock.On("GetRoleApps", roleID)}
+}
+
+func (_c *IOneLoginSDK_GetRoleApps_Call) Run(run func(roleID int)) *IOneLoginSDK_GetRoleApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleApps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleApps_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetRoleApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleAppsWithContext provides a mock function with given fields: ctx, roleID
+func (_m *IOneLoginSDK) GetRoleAppsWithContext(ctx context.Context, roleID int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleAppsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, roleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleAppsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleAppsWithContext'
+type IOneLoginSDK_GetRoleAppsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRoleAppsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+func (_e *IOneLoginSDK_Expecter) GetRoleAppsWithContext(ctx interface{}, roleID interface{}) *IOneLoginSDK_GetRoleAppsWithContext_Call {
+	return &IOneLoginSDK_GetRoleAppsWithContext_Call{Call: _e.mock.On("GetRoleAppsWithContext", ctx, roleID)}
+}
+
+func (_c *IOneLoginSDK_GetRoleAppsWithContext_Call) Run(run func(ctx context.Context, roleID int)) *IOneLoginSDK_GetRoleAppsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleAppsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleAppsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleAppsWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetRoleAppsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByID provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetRoleByID(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByID")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByID'
+type IOneLoginSDK_GetRoleByID_Call struct {
+	*mock.Call
+}
+
+// GetRoleByID is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoleByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleByID_Call {
+	return &IOneLoginSDK_GetRoleByID_Call{Call: _e.mock.On("GetRoleByID", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoleByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByIDTyped provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetRoleByIDTyped(id int, queryParams models.Queryable) (*models.Response[models.Role], error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByIDTyped")
+	}
+
+	var r0 *models.Response[models.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (*models.Response[models.Role], error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) *models.Response[models.Role]); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Role])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleByIDTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByIDTyped'
+type IOneLoginSDK_GetRoleByIDTyped_Call struct {
+	*mock.Call
+}
+
+// GetRoleByIDTyped is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoleByIDTyped(id interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleByIDTyped_Call {
+	return &IOneLoginSDK_GetRoleByIDTyped_Call{Call: _e.mock.On("GetRoleByIDTyped", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDTyped_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleByIDTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDTyped_Call) Return(_a0 *models.Response[models.Role], _a1 error) *IOneLoginSDK_GetRoleByIDTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDTyped_Call) RunAndReturn(run func(int, models.Queryable) (*models.Response[models.Role], error)) *IOneLoginSDK_GetRoleByIDTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByIDTypedWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetRoleByIDTypedWithContext(ctx context.Context, id int, queryParams models.Queryable) (*models.Response[models.Role], error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByIDTypedWithContext")
+	}
+
+	var r0 *models.Response[models.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (*models.Response[models.Role], error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) *models.Response[models.Role]); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.Role])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleByIDTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByIDTypedWithContext'
+type IOneLoginSDK_GetRoleByIDTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRoleByIDTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoleByIDTypedWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleByIDTypedWithContext_Call {
+	return &IOneLoginSDK_GetRoleByIDTypedWithContext_Call{Call: _e.mock.On("GetRoleByIDTypedWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDTypedWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleByIDTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDTypedWithContext_Call) Return(_a0 *models.Response[models.Role], _a1 error) *IOneLoginSDK_GetRoleByIDTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (*models.Response[models.Role], error)) *IOneLoginSDK_GetRoleByIDTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByIDWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetRoleByIDWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByIDWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleByIDWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByIDWithContext'
+type IOneLoginSDK_GetRoleByIDWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRoleByIDWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoleByIDWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleByIDWithContext_Call {
+	return &IOneLoginSDK_GetRoleByIDWithContext_Call{Call: _e.mock.On("GetRoleByIDWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleByIDWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleByIDWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleByIDWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoleByIDWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleUsers provides a mock function with given fields: roleID, queryParams
+func (_m *IOneLoginSDK) GetRoleUsers(roleID int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(roleID, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleUsers")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(roleID, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(roleID, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(roleID, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleUsers'
+type IOneLoginSDK_GetRoleUsers_Call struct {
+	*mock.Call
+}
+
+// GetRoleUsers is a helper method to define mock.On call
+//   - roleID int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoleUsers(roleID interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleUsers_Call {
+	return &IOneLoginSDK_GetRoleUsers_Call{Call: _e.mock.On("GetRoleUsers", roleID, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoleUsers_Call) Run(run func(roleID int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleUsers_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoleUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleUsersWithContext provides a mock function with given fields: ctx, roleID, queryParams
+func (_m *IOneLoginSDK) GetRoleUsersWithContext(ctx context.Context, roleID int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, roleID, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleUsersWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, roleID, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, roleID, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, roleID, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoleUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleUsersWithContext'
+type IOneLoginSDK_GetRoleUsersWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRoleUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoleUsersWithContext(ctx interface{}, roleID interface{}, queryParams interface{}) *IOneLoginSDK_GetRoleUsersWithContext_Call {
+	return &IOneLoginSDK_GetRoleUsersWithContext_Call{Call: _e.mock.On("GetRoleUsersWithContext", ctx, roleID, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoleUsersWithContext_Call) Run(run func(ctx context.Context, roleID int, queryParams models.Queryable)) *IOneLoginSDK_GetRoleUsersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleUsersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoleUsersWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoleUsersWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoleUsersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoles provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetRoles(queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoles")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoles'
+type IOneLoginSDK_GetRoles_Call struct {
+	*mock.Call
+}
+
+// GetRoles is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRoles(queryParams interface{}) *IOneLoginSDK_GetRoles_Call {
+	return &IOneLoginSDK_GetRoles_Call{Call: _e.mock.On("GetRoles", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRoles_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoles_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRoles_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRolesTyped provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) GetRolesTyped(queryParams models.Queryable) (*models.Response[[]models.Role], error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRolesTyped")
+	}
+
+	var r0 *models.Response[[]models.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.Role], error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.Role]); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.Role])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRolesTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRolesTyped'
+type IOneLoginSDK_GetRolesTyped_Call struct {
+	*mock.Call
+}
+
+// GetRolesTyped is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRolesTyped(queryParams interface{}) *IOneLoginSDK_GetRolesTyped_Call {
+	return &IOneLoginSDK_GetRolesTyped_Call{Call: _e.mock.On("GetRolesTyped", queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRolesTyped_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_GetRolesTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRolesTyped_Call) Return(_a0 *models.Response[[]models.Role], _a1 error) *IOneLoginSDK_GetRolesTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRolesTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.Role], error)) *IOneLoginSDK_GetRolesTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRolesTypedWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetRolesTypedWithContext(ctx context.Context, queryParams models.Queryable) (*models.Response[[]models.Role], error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRolesTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.Role]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.Role], error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.Role]); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.Role])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRolesTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRolesTypedWithContext'
+type IOneLoginSDK_GetRolesTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRolesTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRolesTypedWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetRolesTypedWithContext_Call {
+	return &IOneLoginSDK_GetRolesTypedWithContext_Call{Call: _e.mock.On("GetRolesTypedWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRolesTypedWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetRolesTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRolesTypedWithContext_Call) Return(_a0 *models.Response[[]models.Role], _a1 error) *IOneLoginSDK_GetRolesTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRolesTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.Role], error)) *IOneLoginSDK_GetRolesTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRolesWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) GetRolesWithContext(ctx context.Context, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRolesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetRolesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRolesWithContext'
+type IOneLoginSDK_GetRolesWithContext_Call struct {
+	*mock.Call
+}
+
+// GetRolesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetRolesWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_GetRolesWithContext_Call {
+	return &IOneLoginSDK_GetRolesWithContext_Call{Call: _e.mock.On("GetRolesWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetRolesWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_GetRolesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRolesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetRolesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetRolesWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetRolesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetToken provides a mock function with given fields:
+func (_m *IOneLoginSDK) GetToken() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetToken'
+type IOneLoginSDK_GetToken_Call struct {
+	*mock.Call
+}
+
+// GetToken is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) GetToken() *IOneLoginSDK_GetToken_Call {
+	return &IOneLoginSDK_GetToken_Call{Call: _e.mock.On("GetToken")}
+}
+
+func (_c *IOneLoginSDK_GetToken_Call) Run(run func()) *IOneLoginSDK_GetToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetToken_Call) Return(_a0 string, _a1 error) *IOneLoginSDK_GetToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetToken_Call) RunAndReturn(run func() (string, error)) *IOneLoginSDK_GetToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserApps provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetUserApps(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserApps")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserApps'
+type IOneLoginSDK_GetUserApps_Call struct {
+	*mock.Call
+}
+
+// GetUserApps is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserApps(id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserApps_Call {
+	return &IOneLoginSDK_GetUserApps_Call{Call: _e.mock.On("GetUserApps", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserApps_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserApps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserApps_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUserApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAppsTyped provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetUserAppsTyped(id int, queryParams models.Queryable) (*models.Response[[]models.UserApp], error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAppsTyped")
+	}
+
+	var r0 *models.Response[[]models.UserApp]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (*models.Response[[]models.UserApp], error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) *models.Response[[]models.UserApp]); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.UserApp])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserAppsTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAppsTyped'
+type IOneLoginSDK_GetUserAppsTyped_Call struct {
+	*mock.Call
+}
+
+// GetUserAppsTyped is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserAppsTyped(id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserAppsTyped_Call {
+	return &IOneLoginSDK_GetUserAppsTyped_Call{Call: _e.mock.On("GetUserAppsTyped", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserAppsTyped_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserAppsTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserAppsTyped_Call) Return(_a0 *models.Response[[]models.UserApp], _a1 error) *IOneLoginSDK_GetUserAppsTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserAppsTyped_Call) RunAndReturn(run func(int, models.Queryable) (*models.Response[[]models.UserApp], error)) *IOneLoginSDK_GetUserAppsTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAppsTypedWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetUserAppsTypedWithContext(ctx context.Context, id int, queryParams models.Queryable) (*models.Response[[]models.UserApp], error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAppsTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.UserApp]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (*models.Response[[]models.UserApp], error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) *models.Response[[]models.UserApp]); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.UserApp])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserAppsTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAppsTypedWithContext'
+type IOneLoginSDK_GetUserAppsTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUserAppsTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserAppsTypedWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserAppsTypedWithContext_Call {
+	return &IOneLoginSDK_GetUserAppsTypedWithContext_Call{Call: _e.mock.On("GetUserAppsTypedWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserAppsTypedWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserAppsTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserAppsTypedWithContext_Call) Return(_a0 *models.Response[[]models.UserApp], _a1 error) *IOneLoginSDK_GetUserAppsTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserAppsTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (*models.Response[[]models.UserApp], error)) *IOneLoginSDK_GetUserAppsTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAppsWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetUserAppsWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAppsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserAppsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAppsWithContext'
+type IOneLoginSDK_GetUserAppsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUserAppsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserAppsWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserAppsWithContext_Call {
+	return &IOneLoginSDK_GetUserAppsWithContext_Call{Call: _e.mock.On("GetUserAppsWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserAppsWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserAppsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserAppsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserAppsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserAppsWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUserAppsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetUserByID(id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (interface{}, error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) interface{}); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type IOneLoginSDK_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserByID(id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserByID_Call {
+	return &IOneLoginSDK_GetUserByID_Call{Call: _e.mock.On("GetUserByID", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserByID_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByID_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByID_Call) RunAndReturn(run func(int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByIDTyped provides a mock function with given fields: id, queryParams
+func (_m *IOneLoginSDK) GetUserByIDTyped(id int, queryParams models.Queryable) (*models.Response[models.User], error) {
+	ret := _m.Called(id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByIDTyped")
+	}
+
+	var r0 *models.Response[models.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) (*models.Response[models.User], error)); ok {
+		return rf(id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.Queryable) *models.Response[models.User]); ok {
+		r0 = rf(id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.Queryable) error); ok {
+		r1 = rf(id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserByIDTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByIDTyped'
+type IOneLoginSDK_GetUserByIDTyped_Call struct {
+	*mock.Call
+}
+
+// GetUserByIDTyped is a helper method to define mock.On call
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserByIDTyped(id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserByIDTyped_Call {
+	return &IOneLoginSDK_GetUserByIDTyped_Call{Call: _e.mock.On("GetUserByIDTyped", id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserByIDTyped_Call) Run(run func(id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserByIDTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByIDTyped_Call) Return(_a0 *models.Response[models.User], _a1 error) *IOneLoginSDK_GetUserByIDTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByIDTyped_Call) RunAndReturn(run func(int, models.Queryable) (*models.Response[models.User], error)) *IOneLoginSDK_GetUserByIDTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByIDTypedWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetUserByIDTypedWithContext(ctx context.Context, id int, queryParams models.Queryable) (*models.Response[models.User], error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByIDTypedWithContext")
+	}
+
+	var r0 *models.Response[models.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (*models.Response[models.User], error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) *models.Response[models.User]); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[models.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserByIDTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByIDTypedWithContext'
+type IOneLoginSDK_GetUserByIDTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUserByIDTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserByIDTypedWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserByIDTypedWithContext_Call {
+	return &IOneLoginSDK_GetUserByIDTypedWithContext_Call{Call: _e.mock.On("GetUserByIDTypedWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserByIDTypedWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserByIDTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByIDTypedWithContext_Call) Return(_a0 *models.Response[models.User], _a1 error) *IOneLoginSDK_GetUserByIDTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByIDTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (*models.Response[models.User], error)) *IOneLoginSDK_GetUserByIDTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByIDWithContext provides a mock function with given fields: ctx, id, queryParams
+func (_m *IOneLoginSDK) GetUserByIDWithContext(ctx context.Context, id int, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, id, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByIDWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, id, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, id, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Queryable) error); ok {
+		r1 = rf(ctx, id, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserByIDWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByIDWithContext'
+type IOneLoginSDK_GetUserByIDWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUserByIDWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUserByIDWithContext(ctx interface{}, id interface{}, queryParams interface{}) *IOneLoginSDK_GetUserByIDWithContext_Call {
+	return &IOneLoginSDK_GetUserByIDWithContext_Call{Call: _e.mock.On("GetUserByIDWithContext", ctx, id, queryParams)}
+}
+
+func (_c *IOneLoginSDK_GetUserByIDWithContext_Call) Run(run func(ctx context.Context, id int, queryParams models.Queryable)) *IOneLoginSDK_GetUserByIDWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByIDWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserByIDWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserByIDWithContext_Call) RunAndReturn(run func(context.Context, int, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUserByIDWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRoles provides a mock function with given fields: id
+func (_m *IOneLoginSDK) GetUserRoles(id int) (interface{}, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRoles")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRoles'
+type IOneLoginSDK_GetUserRoles_Call struct {
+	*mock.Call
+}
+
+// GetUserRoles is a helper method to define mock.On call
+//   - id int
+func (_e *IOneLoginSDK_Expecter) GetUserRoles(id interface{}) *IOneLoginSDK_GetUserRoles_Call {
+	return &IOneLoginSDK_GetUserRoles_Call{Call: _e.mock.On("GetUserRoles", id)}
+}
+
+func (_c *IOneLoginSDK_GetUserRoles_Call) Run(run func(id int)) *IOneLoginSDK_GetUserRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserRoles_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserRoles_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_GetUserRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRolesWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) GetUserRolesWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRolesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUserRolesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRolesWithContext'
+type IOneLoginSDK_GetUserRolesWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUserRolesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *IOneLoginSDK_Expecter) GetUserRolesWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_GetUserRolesWithContext_Call {
+	return &IOneLoginSDK_GetUserRolesWithContext_Call{Call: _e.mock.On("GetUserRolesWithContext", ctx, id)}
+}
+
+func (_c *IOneLoginSDK_GetUserRolesWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_GetUserRolesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserRolesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUserRolesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUserRolesWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_GetUserRolesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: query
+func (_m *IOneLoginSDK) GetUsers(query models.Queryable) (interface{}, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type IOneLoginSDK_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUsers(query interface{}) *IOneLoginSDK_GetUsers_Call {
+	return &IOneLoginSDK_GetUsers_Call{Call: _e.mock.On("GetUsers", query)}
+}
+
+func (_c *IOneLoginSDK_GetUsers_Call) Run(run func(query models.Queryable)) *IOneLoginSDK_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsers_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsers_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersTyped provides a mock function with given fields: query
+func (_m *IOneLoginSDK) GetUsersTyped(query models.Queryable) (*models.Response[[]models.User], error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersTyped")
+	}
+
+	var r0 *models.Response[[]models.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.User], error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.User]); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUsersTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersTyped'
+type IOneLoginSDK_GetUsersTyped_Call struct {
+	*mock.Call
+}
+
+// GetUsersTyped is a helper method to define mock.On call
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUsersTyped(query interface{}) *IOneLoginSDK_GetUsersTyped_Call {
+	return &IOneLoginSDK_GetUsersTyped_Call{Call: _e.mock.On("GetUsersTyped", query)}
+}
+
+func (_c *IOneLoginSDK_GetUsersTyped_Call) Run(run func(query models.Queryable)) *IOneLoginSDK_GetUsersTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsersTyped_Call) Return(_a0 *models.Response[[]models.User], _a1 error) *IOneLoginSDK_GetUsersTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsersTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.User], error)) *IOneLoginSDK_GetUsersTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersTypedWithContext provides a mock function with given fields: ctx, query
+func (_m *IOneLoginSDK) GetUsersTypedWithContext(ctx context.Context, query models.Queryable) (*models.Response[[]models.User], error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.User], error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.User]); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUsersTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersTypedWithContext'
+type IOneLoginSDK_GetUsersTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUsersTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUsersTypedWithContext(ctx interface{}, query interface{}) *IOneLoginSDK_GetUsersTypedWithContext_Call {
+	return &IOneLoginSDK_GetUsersTypedWithContext_Call{Call: _e.mock.On("GetUsersTypedWithContext", ctx, query)}
+}
+
+func (_c *IOneLoginSDK_GetUsersTypedWithContext_Call) Run(run func(ctx context.Context, query models.Queryable)) *IOneLoginSDK_GetUsersTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsersTypedWithContext_Call) Return(_a0 *models.Response[[]models.User], _a1 error) *IOneLoginSDK_GetUsersTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsersTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.User], error)) *IOneLoginSDK_GetUsersTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersWithContext provides a mock function with given fields: ctx, query
+func (_m *IOneLoginSDK) GetUsersWithContext(ctx context.Context, query models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_GetUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersWithContext'
+type IOneLoginSDK_GetUsersWithContext_Call struct {
+	*mock.Call
+}
+
+// GetUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) GetUsersWithContext(ctx interface{}, query interface{}) *IOneLoginSDK_GetUsersWithContext_Call {
+	return &IOneLoginSDK_GetUsersWithContext_Call{Call: _e.mock.On("GetUsersWithContext", ctx, query)}
+}
+
+func (_c *IOneLoginSDK_GetUsersWithContext_Call) Run(run func(ctx context.Context, query models.Queryable)) *IOneLoginSDK_GetUsersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsersWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_GetUsersWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_GetUsersWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_GetUsersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActionValues provides a mock function with given fields: actionValue
+func (_m *IOneLoginSDK) ListActionValues(actionValue string) (interface{}, error) {
+	ret := _m.Called(actionValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActionValues")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(actionValue)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(actionValue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(actionValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListActionValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActionValues'
+type IOneLoginSDK_ListActionValues_Call struct {
+	*mock.Call
+}
+
+// ListActionValues is a helper method to define mock.On call
+//   - actionValue string
+func (_e *IOneLoginSDK_Expecter) ListActionValues(actionValue interface{}) *IOneLoginSDK_ListActionValues_Call {
+	return &IOneLoginSDK_ListActionValues_Call{Call: _e.mock.On("ListActionValues", actionValue)}
+}
+
+func (_c *IOneLoginSDK_ListActionValues_Call) Run(run func(actionValue string)) *IOneLoginSDK_ListActionValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActionValues_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListActionValues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActionValues_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_ListActionValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActionValuesWithContext provides a mock function with given fields: ctx, actionValue
+func (_m *IOneLoginSDK) ListActionValuesWithContext(ctx context.Context, actionValue string) (interface{}, error) {
+	ret := _m.Called(ctx, actionValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActionValuesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, actionValue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, actionValue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, actionValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListActionValuesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActionValuesWithContext'
+type IOneLoginSDK_ListActionValuesWithContext_Call struct {
+	*mock.Call
+}
+
+// ListActionValuesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionValue string
+func (_e *IOneLoginSDK_Expecter) ListActionValuesWithContext(ctx interface{}, actionValue interface{}) *IOneLoginSDK_ListActionValuesWithContext_Call {
+	return &IOneLoginSDK_ListActionValuesWithContext_Call{Call: _e.mock.On("ListActionValuesWithContext", ctx, actionValue)}
+}
+
+func (_c *IOneLoginSDK_ListActionValuesWithContext_Call) Run(run func(ctx context.Context, actionValue string)) *IOneLoginSDK_ListActionValuesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActionValuesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListActionValuesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActionValuesWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_ListActionValuesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActions provides a mock function with given fields:
+func (_m *IOneLoginSDK) ListActions() (interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActions")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActions'
+type IOneLoginSDK_ListActions_Call struct {
+	*mock.Call
+}
+
+// ListActions is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) ListActions() *IOneLoginSDK_ListActions_Call {
+	return &IOneLoginSDK_ListActions_Call{Call: _e.mock.On("ListActions")}
+}
+
+func (_c *IOneLoginSDK_ListActions_Call) Run(run func()) *IOneLoginSDK_ListActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActions_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActions_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActionsWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) ListActionsWithContext(ctx context.Context) (interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActionsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListActionsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActionsWithContext'
+type IOneLoginSDK_ListActionsWithContext_Call struct {
+	*mock.Call
+}
+
+// ListActionsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) ListActionsWithContext(ctx interface{}) *IOneLoginSDK_ListActionsWithContext_Call {
+	return &IOneLoginSDK_ListActionsWithContext_Call{Call: _e.mock.On("ListActionsWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_ListActionsWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_ListActionsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActionsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListActionsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListActionsWithContext_Call) RunAndReturn(run func(context.Context) (interface{}, error)) *IOneLoginSDK_ListActionsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConditionOperators provides a mock function with given fields: conditionValue
+func (_m *IOneLoginSDK) ListConditionOperators(conditionValue string) (interface{}, error) {
+	ret := _m.Called(conditionValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConditionOperators")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(conditionValue)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(conditionValue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(conditionValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConditionOperators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionOperators'
+type IOneLoginSDK_ListConditionOperators_Call struct {
+	*mock.Call
+}
+
+// ListConditionOperators is a helper method to define mock.On call
+//   - conditionValue string
+func (_e *IOneLoginSDK_Expecter) ListConditionOperators(conditionValue interface{}) *IOneLoginSDK_ListConditionOperators_Call {
+	return &IOneLoginSDK_ListConditionOperators_Call{Call: _e.mock.On("ListConditionOperators", conditionValue)}
+}
+
+func (_c *IOneLoginSDK_ListConditionOperators_Call) Run(run func(conditionValue string)) *IOneLoginSDK_ListConditionOperators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionOperators_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionOperators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionOperators_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_ListConditionOperators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConditionOperatorsWithContext provides a mock function with given fields: ctx, conditionValue
+func (_m *IOneLoginSDK) ListConditionOperatorsWithContext(ctx context.Context, conditionValue string) (interface{}, error) {
+	ret := _m.Called(ctx, conditionValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConditionOperatorsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, conditionValue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, conditionValue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, conditionValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConditionOperatorsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionOperatorsWithContext'
+type IOneLoginSDK_ListConditionOperatorsWithContext_Call struct {
+	*mock.Call
+}
+
+// ListConditionOperatorsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conditionValue string
+func (_e *IOneLoginSDK_Expecter) ListConditionOperatorsWithContext(ctx interface{}, conditionValue interface{}) *IOneLoginSDK_ListConditionOperatorsWithContext_Call {
+	return &IOneLoginSDK_ListConditionOperatorsWithContext_Call{Call: _e.mock.On("ListConditionOperatorsWithContext", ctx, conditionValue)}
+}
+
+func (_c *IOneLoginSDK_ListConditionOperatorsWithContext_Call) Run(run func(ctx context.Context, conditionValue string)) *IOneLoginSDK_ListConditionOperatorsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionOperatorsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionOperatorsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionOperatorsWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_ListConditionOperatorsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConditionValues provides a mock function with given fields: conditionValue
+func (_m *IOneLoginSDK) ListConditionValues(conditionValue string) (interface{}, error) {
+	ret := _m.Called(conditionValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConditionValues")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(conditionValue)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(conditionValue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(conditionValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConditionValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionValues'
+type IOneLoginSDK_ListConditionValues_Call struct {
+	*mock.Call
+}
+
+// ListConditionValues is a helper method to define mock.On call
+//   - conditionValue string
+func (_e *IOneLoginSDK_Expecter) ListConditionValues(conditionValue interface{}) *IOneLoginSDK_ListConditionValues_Call {
+	return &IOneLoginSDK_ListConditionValues_Call{Call: _e.mock.On("ListConditionValues", conditionValue)}
+}
+
+func (_c *IOneLoginSDK_ListConditionValues_Call) Run(run func(conditionValue string)) *IOneLoginSDK_ListConditionValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionValues_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionValues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionValues_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_ListConditionValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConditionValuesWithContext provides a mock function with given fields: ctx, conditionValue
+func (_m *IOneLoginSDK) ListConditionValuesWithContext(ctx context.Context, conditionValue string) (interface{}, error) {
+	ret := _m.Called(ctx, conditionValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConditionValuesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, conditionValue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, conditionValue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, conditionValue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConditionValuesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionValuesWithContext'
+type IOneLoginSDK_ListConditionValuesWithContext_Call struct {
+	*mock.Call
+}
+
+// ListConditionValuesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conditionValue string
+func (_e *IOneLoginSDK_Expecter) ListConditionValuesWithContext(ctx interface{}, conditionValue interface{}) *IOneLoginSDK_ListConditionValuesWithContext_Call {
+	return &IOneLoginSDK_ListConditionValuesWithContext_Call{Call: _e.mock.On("ListConditionValuesWithContext", ctx, conditionValue)}
+}
+
+func (_c *IOneLoginSDK_ListConditionValuesWithContext_Call) Run(run func(ctx context.Context, conditionValue string)) *IOneLoginSDK_ListConditionValuesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionValuesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionValuesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionValuesWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_ListConditionValuesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConditions provides a mock function with given fields:
+func (_m *IOneLoginSDK) ListConditions() (interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConditions")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditions'
+type IOneLoginSDK_ListConditions_Call struct {
+	*mock.Call
+}
+
+// ListConditions is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) ListConditions() *IOneLoginSDK_ListConditions_Call {
+	return &IOneLoginSDK_ListConditions_Call{Call: _e.mock.On("ListConditions")}
+}
+
+func (_c *IOneLoginSDK_ListConditions_Call) Run(run func()) *IOneLoginSDK_ListConditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditions_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditions_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListConditions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConditionsWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) ListConditionsWithContext(ctx context.Context) (interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConditionsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConditionsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionsWithContext'
+type IOneLoginSDK_ListConditionsWithContext_Call struct {
+	*mock.Call
+}
+
+// ListConditionsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) ListConditionsWithContext(ctx interface{}) *IOneLoginSDK_ListConditionsWithContext_Call {
+	return &IOneLoginSDK_ListConditionsWithContext_Call{Call: _e.mock.On("ListConditionsWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_ListConditionsWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_ListConditionsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConditionsWithContext_Call) RunAndReturn(run func(context.Context) (interface{}, error)) *IOneLoginSDK_ListConditionsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConnectors provides a mock function with given fields:
+func (_m *IOneLoginSDK) ListConnectors() (interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConnectors")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConnectors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnectors'
+type IOneLoginSDK_ListConnectors_Call struct {
+	*mock.Call
+}
+
+// ListConnectors is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) ListConnectors() *IOneLoginSDK_ListConnectors_Call {
+	return &IOneLoginSDK_ListConnectors_Call{Call: _e.mock.On("ListConnectors")}
+}
+
+func (_c *IOneLoginSDK_ListConnectors_Call) Run(run func()) *IOneLoginSDK_ListConnectors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConnectors_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConnectors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConnectors_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListConnectors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConnectorsWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) ListConnectorsWithContext(ctx context.Context) (interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConnectorsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListConnectorsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnectorsWithContext'
+type IOneLoginSDK_ListConnectorsWithContext_Call struct {
+	*mock.Call
+}
+
+// ListConnectorsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) ListConnectorsWithContext(ctx interface{}) *IOneLoginSDK_ListConnectorsWithContext_Call {
+	return &IOneLoginSDK_ListConnectorsWithContext_Call{Call: _e.mock.On("ListConnectorsWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_ListConnectorsWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_ListConnectorsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConnectorsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConnectorsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListConnectorsWithContext_Call) RunAndReturn(run func(context.Context) (interface{}, error)) *IOneLoginSDK_ListConnectorsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvironmentVariables provides a mock function with given fields:
+func (_m *IOneLoginSDK) ListEnvironmentVariables() (interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvironmentVariables")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListEnvironmentVariables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironmentVariables'
+type IOneLoginSDK_ListEnvironmentVariables_Call struct {
+	*mock.Call
+}
+
+// ListEnvironmentVariables is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) ListEnvironmentVariables() *IOneLoginSDK_ListEnvironmentVariables_Call {
+	return &IOneLoginSDK_ListEnvironmentVariables_Call{Call: _e.mock.On("ListEnvironmentVariables")}
+}
+
+func (_c *IOneLoginSDK_ListEnvironmentVariables_Call) Run(run func()) *IOneLoginSDK_ListEnvironmentVariables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListEnvironmentVariables_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListEnvironmentVariables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListEnvironmentVariables_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListEnvironmentVariables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvironmentVariablesWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) ListEnvironmentVariablesWithContext(ctx context.Context) (interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvironmentVariablesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListEnvironmentVariablesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironmentVariablesWithContext'
+type IOneLoginSDK_ListEnvironmentVariablesWithContext_Call struct {
+	*mock.Call
+}
+
+// ListEnvironmentVariablesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) ListEnvironmentVariablesWithContext(ctx interface{}) *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call {
+	return &IOneLoginSDK_ListEnvironmentVariablesWithContext_Call{Call: _e.mock.On("ListEnvironmentVariablesWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call) RunAndReturn(run func(context.Context) (interface{}, error)) *IOneLoginSDK_ListEnvironmentVariablesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHooks provides a mock function with given fields: query
+func (_m *IOneLoginSDK) ListHooks(query models.Queryable) (interface{}, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHooks")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListHooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHooks'
+type IOneLoginSDK_ListHooks_Call struct {
+	*mock.Call
+}
+
+// ListHooks is a helper method to define mock.On call
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListHooks(query interface{}) *IOneLoginSDK_ListHooks_Call {
+	return &IOneLoginSDK_ListHooks_Call{Call: _e.mock.On("ListHooks", query)}
+}
+
+func (_c *IOneLoginSDK_ListHooks_Call) Run(run func(query models.Queryable)) *IOneLoginSDK_ListHooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooks_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListHooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooks_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_ListHooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHooksTyped provides a mock function with given fields: query
+func (_m *IOneLoginSDK) ListHooksTyped(query models.Queryable) (*models.Response[[]models.SmartHook], error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHooksTyped")
+	}
+
+	var r0 *models.Response[[]models.SmartHook]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.SmartHook], error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.SmartHook]); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.SmartHook])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListHooksTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHooksTyped'
+type IOneLoginSDK_ListHooksTyped_Call struct {
+	*mock.Call
+}
+
+// ListHooksTyped is a helper method to define mock.On call
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListHooksTyped(query interface{}) *IOneLoginSDK_ListHooksTyped_Call {
+	return &IOneLoginSDK_ListHooksTyped_Call{Call: _e.mock.On("ListHooksTyped", query)}
+}
+
+func (_c *IOneLoginSDK_ListHooksTyped_Call) Run(run func(query models.Queryable)) *IOneLoginSDK_ListHooksTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooksTyped_Call) Return(_a0 *models.Response[[]models.SmartHook], _a1 error) *IOneLoginSDK_ListHooksTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooksTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.SmartHook], error)) *IOneLoginSDK_ListHooksTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHooksTypedWithContext provides a mock function with given fields: ctx, query
+func (_m *IOneLoginSDK) ListHooksTypedWithContext(ctx context.Context, query models.Queryable) (*models.Response[[]models.SmartHook], error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHooksTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.SmartHook]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.SmartHook], error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.SmartHook]); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.SmartHook])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListHooksTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHooksTypedWithContext'
+type IOneLoginSDK_ListHooksTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// ListHooksTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListHooksTypedWithContext(ctx interface{}, query interface{}) *IOneLoginSDK_ListHooksTypedWithContext_Call {
+	return &IOneLoginSDK_ListHooksTypedWithContext_Call{Call: _e.mock.On("ListHooksTypedWithContext", ctx, query)}
+}
+
+func (_c *IOneLoginSDK_ListHooksTypedWithContext_Call) Run(run func(ctx context.Context, query models.Queryable)) *IOneLoginSDK_ListHooksTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooksTypedWithContext_Call) Return(_a0 *models.Response[[]models.SmartHook], _a1 error) *IOneLoginSDK_ListHooksTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooksTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.SmartHook], error)) *IOneLoginSDK_ListHooksTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHooksWithContext provides a mock function with given fields: ctx, query
+func (_m *IOneLoginSDK) ListHooksWithContext(ctx context.Context, query models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHooksWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListHooksWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHooksWithContext'
+type IOneLoginSDK_ListHooksWithContext_Call struct {
+	*mock.Call
+}
+
+// ListHooksWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListHooksWithContext(ctx interface{}, query interface{}) *IOneLoginSDK_ListHooksWithContext_Call {
+	return &IOneLoginSDK_ListHooksWithContext_Call{Call: _e.mock.On("ListHooksWithContext", ctx, query)}
+}
+
+func (_c *IOneLoginSDK_ListHooksWithContext_Call) Run(run func(ctx context.Context, query models.Queryable)) *IOneLoginSDK_ListHooksWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooksWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListHooksWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListHooksWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_ListHooksWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMappings provides a mock function with given fields:
+func (_m *IOneLoginSDK) ListMappings() (interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMappings")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMappings'
+type IOneLoginSDK_ListMappings_Call struct {
+	*mock.Call
+}
+
+// ListMappings is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) ListMappings() *IOneLoginSDK_ListMappings_Call {
+	return &IOneLoginSDK_ListMappings_Call{Call: _e.mock.On("ListMappings")}
+}
+
+func (_c *IOneLoginSDK_ListMappings_Call) Run(run func()) *IOneLoginSDK_ListMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappings_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListMappings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappings_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMappingsTyped provides a mock function with given fields:
+func (_m *IOneLoginSDK) ListMappingsTyped() (*models.Response[[]models.UserMapping], error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMappingsTyped")
+	}
+
+	var r0 *models.Response[[]models.UserMapping]
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*models.Response[[]models.UserMapping], error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *models.Response[[]models.UserMapping]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.UserMapping])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListMappingsTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMappingsTyped'
+type IOneLoginSDK_ListMappingsTyped_Call struct {
+	*mock.Call
+}
+
+// ListMappingsTyped is a helper method to define mock.On call
+func (_e *IOneLoginSDK_Expecter) ListMappingsTyped() *IOneLoginSDK_ListMappingsTyped_Call {
+	return &IOneLoginSDK_ListMappingsTyped_Call{Call: _e.mock.On("ListMappingsTyped")}
+}
+
+func (_c *IOneLoginSDK_ListMappingsTyped_Call) Run(run func()) *IOneLoginSDK_ListMappingsTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappingsTyped_Call) Return(_a0 *models.Response[[]models.UserMapping], _a1 error) *IOneLoginSDK_ListMappingsTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappingsTyped_Call) RunAndReturn(run func() (*models.Response[[]models.UserMapping], error)) *IOneLoginSDK_ListMappingsTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMappingsTypedWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) ListMappingsTypedWithContext(ctx context.Context) (*models.Response[[]models.UserMapping], error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMappingsTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.UserMapping]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.Response[[]models.UserMapping], error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.Response[[]models.UserMapping]); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.UserMapping])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListMappingsTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMappingsTypedWithContext'
+type IOneLoginSDK_ListMappingsTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// ListMappingsTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) ListMappingsTypedWithContext(ctx interface{}) *IOneLoginSDK_ListMappingsTypedWithContext_Call {
+	return &IOneLoginSDK_ListMappingsTypedWithContext_Call{Call: _e.mock.On("ListMappingsTypedWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_ListMappingsTypedWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_ListMappingsTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappingsTypedWithContext_Call) Return(_a0 *models.Response[[]models.UserMapping], _a1 error) *IOneLoginSDK_ListMappingsTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappingsTypedWithContext_Call) RunAndReturn(run func(context.Context) (*models.Response[[]models.UserMapping], error)) *IOneLoginSDK_ListMappingsTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMappingsWithContext provides a mock function with given fields: ctx
+func (_m *IOneLoginSDK) ListMappingsWithContext(ctx context.Context) (interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMappingsWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListMappingsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMappingsWithContext'
+type IOneLoginSDK_ListMappingsWithContext_Call struct {
+	*mock.Call
+}
+
+// ListMappingsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IOneLoginSDK_Expecter) ListMappingsWithContext(ctx interface{}) *IOneLoginSDK_ListMappingsWithContext_Call {
+	return &IOneLoginSDK_ListMappingsWithContext_Call{Call: _e.mock.On("ListMappingsWithContext", ctx)}
+}
+
+func (_c *IOneLoginSDK_ListMappingsWithContext_Call) Run(run func(ctx context.Context)) *IOneLoginSDK_ListMappingsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappingsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListMappingsWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListMappingsWithContext_Call) RunAndReturn(run func(context.Context) (interface{}, error)) *IOneLoginSDK_ListMappingsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPrivileges provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) ListPrivileges(queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPrivileges")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListPrivileges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivileges'
+type IOneLoginSDK_ListPrivileges_Call struct {
+	*mock.Call
+}
+
+// ListPrivileges is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListPrivileges(queryParams interface{}) *IOneLoginSDK_ListPrivileges_Call {
+	return &IOneLoginSDK_ListPrivileges_Call{Call: _e.mock.On("ListPrivileges", queryParams)}
+}
+
+func (_c *IOneLoginSDK_ListPrivileges_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_ListPrivileges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivileges_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListPrivileges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivileges_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_ListPrivileges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPrivilegesTyped provides a mock function with given fields: queryParams
+func (_m *IOneLoginSDK) ListPrivilegesTyped(queryParams models.Queryable) (*models.Response[[]models.Privilege], error) {
+	ret := _m.Called(queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPrivilegesTyped")
+	}
+
+	var r0 *models.Response[[]models.Privilege]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Queryable) (*models.Response[[]models.Privilege], error)); ok {
+		return rf(queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(models.Queryable) *models.Response[[]models.Privilege]); ok {
+		r0 = rf(queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.Privilege])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
+		r1 = rf(queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListPrivilegesTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivilegesTyped'
+type IOneLoginSDK_ListPrivilegesTyped_Call struct {
+	*mock.Call
+}
+
+// ListPrivilegesTyped is a helper method to define mock.On call
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListPrivilegesTyped(queryParams interface{}) *IOneLoginSDK_ListPrivilegesTyped_Call {
+	return &IOneLoginSDK_ListPrivilegesTyped_Call{Call: _e.mock.On("ListPrivilegesTyped", queryParams)}
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesTyped_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_ListPrivilegesTyped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesTyped_Call) Return(_a0 *models.Response[[]models.Privilege], _a1 error) *IOneLoginSDK_ListPrivilegesTyped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesTyped_Call) RunAndReturn(run func(models.Queryable) (*models.Response[[]models.Privilege], error)) *IOneLoginSDK_ListPrivilegesTyped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPrivilegesTypedWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) ListPrivilegesTypedWithContext(ctx context.Context, queryParams models.Queryable) (*models.Response[[]models.Privilege], error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPrivilegesTypedWithContext")
+	}
+
+	var r0 *models.Response[[]models.Privilege]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (*models.Response[[]models.Privilege], error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) *models.Response[[]models.Privilege]); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Response[[]models.Privilege])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListPrivilegesTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivilegesTypedWithContext'
+type IOneLoginSDK_ListPrivilegesTypedWithContext_Call struct {
+	*mock.Call
+}
+
+// ListPrivilegesTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListPrivilegesTypedWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_ListPrivilegesTypedWithContext_Call {
+	return &IOneLoginSDK_ListPrivilegesTypedWithContext_Call{Call: _e.mock.On("ListPrivilegesTypedWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesTypedWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_ListPrivilegesTypedWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesTypedWithContext_Call) Return(_a0 *models.Response[[]models.Privilege], _a1 error) *IOneLoginSDK_ListPrivilegesTypedWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesTypedWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (*models.Response[[]models.Privilege], error)) *IOneLoginSDK_ListPrivilegesTypedWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPrivilegesWithContext provides a mock function with given fields: ctx, queryParams
+func (_m *IOneLoginSDK) ListPrivilegesWithContext(ctx context.Context, queryParams models.Queryable) (interface{}, error) {
+	ret := _m.Called(ctx, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPrivilegesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) (interface{}, error)); ok {
+		return rf(ctx, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable) interface{}); ok {
+		r0 = rf(ctx, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Queryable) error); ok {
+		r1 = rf(ctx, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_ListPrivilegesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivilegesWithContext'
+type IOneLoginSDK_ListPrivilegesWithContext_Call struct {
+	*mock.Call
+}
+
+// ListPrivilegesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryParams models.Queryable
+func (_e *IOneLoginSDK_Expecter) ListPrivilegesWithContext(ctx interface{}, queryParams interface{}) *IOneLoginSDK_ListPrivilegesWithContext_Call {
+	return &IOneLoginSDK_ListPrivilegesWithContext_Call{Call: _e.mock.On("ListPrivilegesWithContext", ctx, queryParams)}
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesWithContext_Call) Run(run func(ctx context.Context, queryParams models.Queryable)) *IOneLoginSDK_ListPrivilegesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListPrivilegesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_ListPrivilegesWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable) (interface{}, error)) *IOneLoginSDK_ListPrivilegesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockUserAccount provides a mock function with given fields: id
+func (_m *IOneLoginSDK) LockUserAccount(id int) (interface{}, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockUserAccount")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_LockUserAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockUserAccount'
+type IOneLoginSDK_LockUserAccount_Call struct {
+	*mock.Call
+}
+
+// LockUserAccount is a helper method to define mock.On call
+//   - id int
+func (_e *IOneLoginSDK_Expecter) LockUserAccount(id interface{}) *IOneLoginSDK_LockUserAccount_Call {
+	return &IOneLoginSDK_LockUserAccount_Call{Call: _e.mock.On("LockUserAccount", id)}
+}
+
+func (_c *IOneLoginSDK_LockUserAccount_Call) Run(run func(id int)) *IOneLoginSDK_LockUserAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_LockUserAccount_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_LockUserAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_LockUserAccount_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_LockUserAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockUserAccountWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) LockUserAccountWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockUserAccountWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_LockUserAccountWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockUserAccountWithContext'
+type IOneLoginSDK_LockUserAccountWithContext_Call struct {
+	*mock.Call
+}
+
+// LockUserAccountWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *IOneLoginSDK_Expecter) LockUserAccountWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_LockUserAccountWithContext_Call {
+	return &IOneLoginSDK_LockUserAccountWithContext_Call{Call: _e.mock.On("LockUserAccountWithContext", ctx, id)}
+}
+
+func (_c *IOneLoginSDK_LockUserAccountWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_LockUserAccountWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_LockUserAccountWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_LockUserAccountWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_LockUserAccountWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_LockUserAccountWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogOutUser provides a mock function with given fields: userID
+func (_m *IOneLoginSDK) LogOutUser(userID int) (interface{}, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogOutUser")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_LogOutUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogOutUser'
+type IOneLoginSDK_LogOutUser_Call struct {
+	*mock.Call
+}
+
+// LogOutUser is a helper method to define mock.On call
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) LogOutUser(userID interface{}) *IOneLoginSDK_LogOutUser_Call {
+	return &IOneLoginSDK_LogOutUser_Call{Call: _e.mock.On("LogOutUser", userID)}
+}
+
+func (_c *IOneLoginSDK_LogOutUser_Call) Run(run func(userID int)) *IOneLoginSDK_LogOutUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_LogOutUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_LogOutUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_LogOutUser_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_LogOutUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogOutUserWithContext provides a mock function with given fields: ctx, userID
+func (_m *IOneLoginSDK) LogOutUserWithContext(ctx context.Context, userID int) (interface{}, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogOutUserWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_LogOutUserWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogOutUserWithContext'
+type IOneLoginSDK_LogOutUserWithContext_Call struct {
+	*mock.Call
+}
+
+// LogOutUserWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) LogOutUserWithContext(ctx interface{}, userID interface{}) *IOneLoginSDK_LogOutUserWithContext_Call {
+	return &IOneLoginSDK_LogOutUserWithContext_Call{Call: _e.mock.On("LogOutUserWithContext", ctx, userID)}
+}
+
+func (_c *IOneLoginSDK_LogOutUserWithContext_Call) Run(run func(ctx context.Context, userID int)) *IOneLoginSDK_LogOutUserWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_LogOutUserWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_LogOutUserWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_LogOutUserWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_LogOutUserWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMFAFactor provides a mock function with given fields: userID, deviceID
+func (_m *IOneLoginSDK) RemoveMFAFactor(userID int, deviceID int) (interface{}, error) {
+	ret := _m.Called(userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMFAFactor")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
+		return rf(userID, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
+		r0 = rf(userID, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(userID, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_RemoveMFAFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMFAFactor'
+type IOneLoginSDK_RemoveMFAFactor_Call struct {
+	*mock.Call
+}
+
+// RemoveMFAFactor is a helper method to define mock.On call
+//   - userID int
+//   - deviceID int
+func (_e *IOneLoginSDK_Expecter) RemoveMFAFactor(userID interface{}, deviceID interface{}) *IOneLoginSDK_RemoveMFAFactor_Call {
+	return &IOneLoginSDK_RemoveMFAFactor_Call{Call: _e.mock.On("RemoveMFAFactor", userID, deviceID)}
+}
+
+func (_c *IOneLoginSDK_RemoveMFAFactor_Call) Run(run func(userID int, deviceID int)) *IOneLoginSDK_RemoveMFAFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveMFAFactor_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemoveMFAFactor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveMFAFactor_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_RemoveMFAFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMFAFactorWithContext provides a mock function with given fields: ctx, userID, deviceID
+func (_m *IOneLoginSDK) RemoveMFAFactorWithContext(ctx context.Context, userID int, deviceID int) (interface{}, error) {
+	ret := _m.Called(ctx, userID, deviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMFAFactorWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (interface{}, error)); ok {
+		return rf(ctx, userID, deviceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) interface{}); ok {
+		r0 = rf(ctx, userID, deviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, deviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_RemoveMFAFactorWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMFAFactorWithContext'
+type IOneLoginSDK_RemoveMFAFactorWithContext_Call struct {
+	*mock.Call
+}
+
+// RemoveMFAFactorWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - deviceID int
+func (_e *IOneLoginSDK_Expecter) RemoveMFAFactorWithContext(ctx interface{}, userID interface{}, deviceID interface{}) *IOneLoginSDK_RemoveMFAFactorWithContext_Call {
+	return &IOneLoginSDK_RemoveMFAFactorWithContext_Call{Call: _e.mock.On("RemoveMFAFactorWithContext", ctx, userID, deviceID)}
+}
+
+func (_c *IOneLoginSDK_RemoveMFAFactorWithContext_Call) Run(run func(ctx context.Context, userID int, deviceID int)) *IOneLoginSDK_RemoveMFAFactorWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveMFAFactorWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemoveMFAFactorWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveMFAFactorWithContext_Call) RunAndReturn(run func(context.Context, int, int) (interface{}, error)) *IOneLoginSDK_RemoveMFAFactorWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePrivilegeFromUser provides a mock function with given fields: privilegeID, userID
+func (_m *IOneLoginSDK) RemovePrivilegeFromUser(privilegeID string, userID int) (interface{}, error) {
+	ret := _m.Called(privilegeID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePrivilegeFromUser")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (interface{}, error)); ok {
+		return rf(privilegeID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) interface{}); ok {
+		r0 = rf(privilegeID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(privilegeID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_RemovePrivilegeFromUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePrivilegeFromUser'
+type IOneLoginSDK_RemovePrivilegeFromUser_Call struct {
+	*mock.Call
+}
+
+// RemovePrivilegeFromUser is a helper method to define mock.On call
+//   - privilegeID string
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) RemovePrivilegeFromUser(privilegeID interface{}, userID interface{}) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+	return &IOneLoginSDK_RemovePrivilegeFromUser_Call{Call: _e.mock.On("RemovePrivilegeFromUser", privilegeID, userID)}
+}
+
+func (_c *IOneLoginSDK_RemovePrivilegeFromUser_Call) Run(run func(privilegeID string, userID int)) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemovePrivilegeFromUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemovePrivilegeFromUser_Call) RunAndReturn(run func(string, int) (interface{}, error)) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePrivilegeFromUserWithContext provides a mock function with given fields: ctx, privilegeID, userID
+func (_m *IOneLoginSDK) RemovePrivilegeFromUserWithContext(ctx context.Context, privilegeID string, userID int) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePrivilegeFromUserWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (interface{}, error)); ok {
+		return rf(ctx, privilegeID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) interface{}); ok {
+		r0 = rf(ctx, privilegeID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, privilegeID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePrivilegeFromUserWithContext'
+type IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call struct {
+	*mock.Call
+}
+
+// RemovePrivilegeFromUserWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) RemovePrivilegeFromUserWithContext(ctx interface{}, privilegeID interface{}, userID interface{}) *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call {
+	return &IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call{Call: _e.mock.On("RemovePrivilegeFromUserWithContext", ctx, privilegeID, userID)}
+}
+
+func (_c *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call) Run(run func(ctx context.Context, privilegeID string, userID int)) *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call) RunAndReturn(run func(context.Context, string, int) (interface{}, error)) *IOneLoginSDK_RemovePrivilegeFromUserWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserRole provides a mock function with given fields: userID
+func (_m *IOneLoginSDK) RemoveUserRole(userID int) (interface{}, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUserRole")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_RemoveUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserRole'
+type IOneLoginSDK_RemoveUserRole_Call struct {
+	*mock.Call
+}
+
+// RemoveUserRole is a helper method to define mock.On call
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) RemoveUserRole(userID interface{}) *IOneLoginSDK_RemoveUserRole_Call {
+	return &IOneLoginSDK_RemoveUserRole_Call{Call: _e.mock.On("RemoveUserRole", userID)}
+}
+
+func (_c *IOneLoginSDK_RemoveUserRole_Call) Run(run func(userID int)) *IOneLoginSDK_RemoveUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveUserRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemoveUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveUserRole_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_RemoveUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveUserRoleWithContext provides a mock function with given fields: ctx, userID
+func (_m *IOneLoginSDK) RemoveUserRoleWithContext(ctx context.Context, userID int) (interface{}, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveUserRoleWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_RemoveUserRoleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserRoleWithContext'
+type IOneLoginSDK_RemoveUserRoleWithContext_Call struct {
+	*mock.Call
+}
+
+// RemoveUserRoleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *IOneLoginSDK_Expecter) RemoveUserRoleWithContext(ctx interface{}, userID interface{}) *IOneLoginSDK_RemoveUserRoleWithContext_Call {
+	return &IOneLoginSDK_RemoveUserRoleWithContext_Call{Call: _e.mock.On("RemoveUserRoleWithContext", ctx, userID)}
+}
+
+func (_c *IOneLoginSDK_RemoveUserRoleWithContext_Call) Run(run func(ctx context.Context, userID int)) *IOneLoginSDK_RemoveUserRoleWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveUserRoleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemoveUserRoleWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_RemoveUserRoleWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_RemoveUserRoleWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendInviteLink provides a mock function with given fields: email
+func (_m *IOneLoginSDK) SendInviteLink(email string) (interface{}, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendInviteLink")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_SendInviteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendInviteLink'
+type IOneLoginSDK_SendInviteLink_Call struct {
+	*mock.Call
+}
+
+// SendInviteLink is a helper method to define mock.On call
+//   - email string
+func (_e *IOneLoginSDK_Expecter) SendInviteLink(email interface{}) *IOneLoginSDK_SendInviteLink_Call {
+	return &IOneLoginSDK_SendInviteLink_Call{Call: _e.mock.On("SendInviteLink", email)}
+}
+
+func (_c *IOneLoginSDK_SendInviteLink_Call) Run(run func(email string)) *IOneLoginSDK_SendInviteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_SendInviteLink_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SendInviteLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_SendInviteLink_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_SendInviteLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendInviteLinkWithContext provides a mock function with given fields: ctx, email
+func (_m *IOneLoginSDK) SendInviteLinkWithContext(ctx context.Context, email string) (interface{}, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendInviteLinkWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_SendInviteLinkWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendInviteLinkWithContext'
+type IOneLoginSDK_SendInviteLinkWithContext_Call struct {
+	*mock.Call
+}
+
+// SendInviteLinkWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *IOneLoginSDK_Expecter) SendInviteLinkWithContext(ctx interface{}, email interface{}) *IOneLoginSDK_SendInviteLinkWithContext_Call {
+	return &IOneLoginSDK_SendInviteLinkWithContext_Call{Call: _e.mock.On("SendInviteLinkWithContext", ctx, email)}
+}
+
+func (_c *IOneLoginSDK_SendInviteLinkWithContext_Call) Run(run func(ctx context.Context, email string)) *IOneLoginSDK_SendInviteLinkWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_SendInviteLinkWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SendInviteLinkWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_SendInviteLinkWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_SendInviteLinkWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCustomAttributes provides a mock function with given fields: userID, attr
+func (_m *IOneLoginSDK) SetCustomAttributes(userID int, attr interface{}) (interface{}, error) {
+	ret := _m.Called(userID, attr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCustomAttributes")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, interface{}) (interface{}, error)); ok {
+		return rf(userID, attr)
+	}
+	if rf, ok := ret.Get(0).(func(int, interface{}) interface{}); ok {
+		r0 = rf(userID, attr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, interface{}) error); ok {
+		r1 = rf(userID, attr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_SetCustomAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCustomAttributes'
+type IOneLoginSDK_SetCustomAttributes_Call struct {
+	*mock.Call
+}
+
+// SetCustomAttributes is a helper method to define mock.On call
+//   - userID int
+//   - attr interface{}
+func (_e *IOneLoginSDK_Expecter) SetCustomAttributes(userID interface{}, attr interface{}) *IOneLoginSDK_SetCustomAttributes_Call {
+	return &IOneLoginSDK_SetCustomAttributes_Call{Call: _e.mock.On("SetCustomAttributes", userID, attr)}
+}
+
+func (_c *IOneLoginSDK_SetCustomAttributes_Call) Run(run func(userID int, attr interface{})) *IOneLoginSDK_SetCustomAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetCustomAttributes_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SetCustomAttributes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetCustomAttributes_Call) RunAndReturn(run func(int, interface{}) (interface{}, error)) *IOneLoginSDK_SetCustomAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCustomAttributesWithContext provides a mock function with given fields: ctx, userID, attr
+func (_m *IOneLoginSDK) SetCustomAttributesWithContext(ctx context.Context, userID int, attr interface{}) (interface{}, error) {
+	ret := _m.Called(ctx, userID, attr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCustomAttributesWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, interface{}) (interface{}, error)); ok {
+		return rf(ctx, userID, attr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, interface{}) interface{}); ok {
+		r0 = rf(ctx, userID, attr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, interface{}) error); ok {
+		r1 = rf(ctx, userID, attr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_SetCustomAttributesWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCustomAttributesWithContext'
+type IOneLoginSDK_SetCustomAttributesWithContext_Call struct {
+	*mock.Call
+}
+
+// SetCustomAttributesWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - attr interface{}
+func (_e *IOneLoginSDK_Expecter) SetCustomAttributesWithContext(ctx interface{}, userID interface{}, attr interface{}) *IOneLoginSDK_SetCustomAttributesWithContext_Call {
+	return &IOneLoginSDK_SetCustomAttributesWithContext_Call{Call: _e.mock.On("SetCustomAttributesWithContext", ctx, userID, attr)}
+}
+
+func (_c *IOneLoginSDK_SetCustomAttributesWithContext_Call) Run(run func(ctx context.Context, userID int, attr interface{})) *IOneLoginSDK_SetCustomAttributesWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetCustomAttributesWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SetCustomAttributesWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetCustomAttributesWithContext_Call) RunAndReturn(run func(context.Context, int, interface{}) (interface{}, error)) *IOneLoginSDK_SetCustomAttributesWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserState provides a mock function with given fields: userID, state
+func (_m *IOneLoginSDK) SetUserState(userID int, state int) (interface{}, error) {
+	ret := _m.Called(userID, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserState")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
+		return rf(userID, state)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
+		r0 = rf(userID, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(userID, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_SetUserState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserState'
+type IOneLoginSDK_SetUserState_Call struct {
+	*mock.Call
+}
+
+// SetUserState is a helper method to define mock.On call
+//   - userID int
+//   - state int
+func (_e *IOneLoginSDK_Expecter) SetUserState(userID interface{}, state interface{}) *IOneLoginSDK_SetUserState_Call {
+	return &IOneLoginSDK_SetUserState_Call{Call: _e.mock.On("SetUserState", userID, state)}
+}
+
+func (_c *IOneLoginSDK_SetUserState_Call) Run(run func(userID int, state int)) *IOneLoginSDK_SetUserState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetUserState_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SetUserState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetUserState_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_SetUserState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserStateWithContext provides a mock function with given fields: ctx, userID, state
+func (_m *IOneLoginSDK) SetUserStateWithContext(ctx context.Context, userID int, state int) (interface{}, error) {
+	ret := _m.Called(ctx, userID, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserStateWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (interface{}, error)); ok {
+		return rf(ctx, userID, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) interface{}); ok {
+		r0 = rf(ctx, userID, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, userID, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_SetUserStateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserStateWithContext'
+type IOneLoginSDK_SetUserStateWithContext_Call struct {
+	*mock.Call
+}
+
+// SetUserStateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - state int
+func (_e *IOneLoginSDK_Expecter) SetUserStateWithContext(ctx interface{}, userID interface{}, state interface{}) *IOneLoginSDK_SetUserStateWithContext_Call {
+	return &IOneLoginSDK_SetUserStateWithContext_Call{Call: _e.mock.On("SetUserStateWithContext", ctx, userID, state)}
+}
+
+func (_c *IOneLoginSDK_SetUserStateWithContext_Call) Run(run func(ctx context.Context, userID int, state int)) *IOneLoginSDK_SetUserStateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetUserStateWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SetUserStateWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_SetUserStateWithContext_Call) RunAndReturn(run func(context.Context, int, int) (interface{}, error)) *IOneLoginSDK_SetUserStateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamUsers provides a mock function with given fields: query, fn
+func (_m *IOneLoginSDK) StreamUsers(query models.Queryable, fn func(models.User) error) error {
+	ret := _m.Called(query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamUsers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Queryable, func(models.User) error) error); ok {
+		r0 = rf(query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IOneLoginSDK_StreamUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamUsers'
+type IOneLoginSDK_StreamUsers_Call struct {
+	*mock.Call
+}
+
+// StreamUsers is a helper method to define mock.On call
+//   - query models.Queryable
+//   - fn func(models.User) error
+func (_e *IOneLoginSDK_Expecter) StreamUsers(query interface{}, fn interface{}) *IOneLoginSDK_StreamUsers_Call {
+	return &IOneLoginSDK_StreamUsers_Call{Call: _e.mock.On("StreamUsers", query, fn)}
+}
+
+func (_c *IOneLoginSDK_StreamUsers_Call) Run(run func(query models.Queryable, fn func(models.User) error)) *IOneLoginSDK_StreamUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Queryable), args[1].(func(models.User) error))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_StreamUsers_Call) Return(_a0 error) *IOneLoginSDK_StreamUsers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IOneLoginSDK_StreamUsers_Call) RunAndReturn(run func(models.Queryable, func(models.User) error) error) *IOneLoginSDK_StreamUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamUsersWithContext provides a mock function with given fields: ctx, query, fn
+func (_m *IOneLoginSDK) StreamUsersWithContext(ctx context.Context, query models.Queryable, fn func(models.User) error) error {
+	ret := _m.Called(ctx, query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamUsersWithContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Queryable, func(models.User) error) error); ok {
+		r0 = rf(ctx, query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IOneLoginSDK_StreamUsersWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamUsersWithContext'
+type IOneLoginSDK_StreamUsersWithContext_Call struct {
+	*mock.Call
+}
+
+// StreamUsersWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query models.Queryable
+//   - fn func(models.User) error
+func (_e *IOneLoginSDK_Expecter) StreamUsersWithContext(ctx interface{}, query interface{}, fn interface{}) *IOneLoginSDK_StreamUsersWithContext_Call {
+	return &IOneLoginSDK_StreamUsersWithContext_Call{Call: _e.mock.On("StreamUsersWithContext", ctx, query, fn)}
+}
+
+func (_c *IOneLoginSDK_StreamUsersWithContext_Call) Run(run func(ctx context.Context, query models.Queryable, fn func(models.User) error)) *IOneLoginSDK_StreamUsersWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Queryable), args[2].(func(models.User) error))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_StreamUsersWithContext_Call) Return(_a0 error) *IOneLoginSDK_StreamUsersWithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IOneLoginSDK_StreamUsersWithContext_Call) RunAndReturn(run func(context.Context, models.Queryable, func(models.User) error) error) *IOneLoginSDK_StreamUsersWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateApp provides a mock function with given fields: id, app
+func (_m *IOneLoginSDK) UpdateApp(id int, app models.App) (interface{}, error) {
+	ret := _m.Called(id, app)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateApp")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.App) (interface{}, error)); ok {
+		return rf(id, app)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.App) interface{}); ok {
+		r0 = rf(id, app)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, models.App) error); ok {
+		r1 = rf(id, app)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_UpdateApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApp'
+type IOneLoginSDK_UpdateApp_Call struct {
+	*mock.Call
+}
+
+// UpdateApp is a helper method to define mock.On call
+//   - id int
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) UpdateApp(id interface{}, app interface{}) *IOneLoginSDK_UpdateApp_Call {
+	return &IOneLoginSDK_UpdateApp_Call{Call: _e.mock.On("UpdateApp", id, app)}
+}
+
+func (_c *IOneLoginSDK_UpdateApp_Call) Run(run func(id int, app models.App)) *IOneLoginSDK_UpdateApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(models.App))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_UpdateApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_UpdateApp_Call) RunAndReturn(run func(int, models.App) (interface{}, error)) *IOneLoginSDK_UpdateApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAppRule provides a mock function with given fields: id, ruleID, appRule, queryParams
+func (_m *IOneLoginSDK) UpdateAppRule(id int, ruleID int, appRule models.AppRule, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(id, ruleID, appRule, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppRule")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, models.AppRule, map[string]string) (interface{}, error)); ok {
+		return rf(id, ruleID, appRule, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, models.AppRule, map[string]string) interface{}); ok {
+		r0 = rf(id, ruleID, appRule, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, models.AppRule, map[string]string) error); ok {
+		r1 = rf(id, ruleID, appRule, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_UpdateAppRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppRule'
+type IOneLoginSDK_UpdateAppRule_Call struct {
+	*mock.Call
+}
+
+// UpdateAppRule is a helper method to define mock.On call
+//   - id int
+//   - ruleID int
+//   - appRule models.AppRule
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) UpdateAppRule(id interface{}, ruleID interface{}, appRule interface{}, queryParams interface{}) *IOneLoginSDK_UpdateAppRule_Call {
+	return &IOneLoginSDK_UpdateAppRule_Call{Call: _e.mock.On("UpdateAppRule", id, ruleID, appRule, queryParams)}
+}
+
+func (_c *IOneLoginSDK_UpdateAppRule_Call) Run(run func(id int, ruleID int, appRule models.AppRule, queryParams map[string]string)) *IOneLoginSDK_UpdateAppRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(models.AppRule), args[3].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_UpdateAppRule_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAppRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_UpdateAppRule_Call) RunAndReturn(run func(int, int, models.AppRule, map[string]string) (interface{}, error)) *IOneLoginSDK_UpdateAppRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAppRuleWithContext provides a mock function with given fields: ctx, id, ruleID, appRule, queryParams
+func (_m *IOneLoginSDK) UpdateAppRuleWithContext(ctx context.Context, id int, ruleID int, appRule models.AppRule, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(ctx, id, ruleID, appRule, queryParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppRuleWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.AppRule, map[string]string) (interface{}, error)); ok {
+		return rf(ctx, id, ruleID, appRule, queryParams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.AppRule, map[string]string) interface{}); ok {
+		r0 = rf(ctx, id, ruleID, appRule, queryParams)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, models.AppRule, map[string]string) error); ok {
+		r1 = rf(ctx, id, ruleID, appRule, queryParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_UpdateAppRuleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppRuleWithContext'
+type IOneLoginSDK_UpdateAppRuleWithContext_Call struct {
+	*mock.Call
+}
+
+// UpdateAppRuleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - ruleID int
+//   - appRule models.AppRule
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) UpdateAppRuleWithContext(ctx interface{}, id interface{}, ruleID interface{}, appRule interface{}, queryParams interface{}) *IOneLoginSDK_UpdateAppRuleWithContext_Call {
+	return &IOneLoginSDK_UpdateAppRuleWithContext_Call{Call: _e.mock.On("UpdateAppRuleWithContext", ctx, id, ruleID, appRule, queryParams)}
+}
+
+func (_c *IOneLoginSDK_UpdateAppRuleWithContext_Call) Run(run func(ctx context.Context, id int, ruleID int, appRule models.AppRule, queryParams map[string]string)) *IOneLoginSDK_UpdateAppRuleWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(models.AppRule), args[4].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_UpdateAppRuleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAppRuleWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_UpdateAppRuleWithContext_Call) RunAndReturn(run func(context.Context, int, int, models.AppRule, map[string]string) (interface{}, error)) *IOneLoginSDK_UpdateAppRuleWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAppTyped provides a mock function with given fields: id, app
+func (_m *IOneLoginSDK) UpdateAppTyped(id int, app models.App) (*models.Response[models.App], error) {
+	ret := _m.Called(id, app)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppTyped")
+	}
+
+	var r0 *models.Response[models.App]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, models.App) (*models.Response[models.App], error)); ok {
+		return rf(id, app)
+	}
+	if rf, ok := ret.Get(0).(func(int, models.App) *models.Response[models.App]); ok {
+		r0 = rf(id, app)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.App])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(conditionValue)
+	if rf, ok := ret.Get(1).(func(int, models.App) error); ok {
+		r1 = rf(id, app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4358,57 +13497,58 @@ func (_m *IOneLoginSDK) ListConditionOperators(conditionValue string) (interface
 	return r0, r1
 }
 
-// IOneLoginSDK_ListConditionOperators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionOperators'
-type IOneLoginSDK_ListConditionOperators_Call struct {
+// IOneLoginSDK_UpdateAppTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppTyped'
+type IOneLoginSDK_UpdateAppTyped_Call struct {
 	*mock.Call
 }
 
-// ListConditionOperators is a helper method to define mock.On call
-//   - conditionValue string
-func (_e *IOneLoginSDK_Expecter) ListConditionOperators(conditionValue interface{}) *IOneLoginSDK_ListConditionOperators_Call {
-	return &IOneLoginSDK_ListConditionOperators_Call{Call: _e.mock.On("ListConditionOperators", conditionValue)}
+// UpdateAppTyped is a helper method to define mock.On call
+//   - id int
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) UpdateAppTyped(id interface{}, app interface{}) *IOneLoginSDK_UpdateAppTyped_Call {
+	return &IOneLoginSDK_UpdateAppTyped_Call{Call: _e.mock.On("UpdateAppTyped", id, app)}
 }
 
-func (_c *IOneLoginSDK_ListConditionOperators_Call) Run(run func(conditionValue string)) *IOneLoginSDK_ListConditionOperators_Call {
+func (_c *IOneLoginSDK_UpdateAppTyped_Call) Run(run func(id int, app models.App)) *IOneLoginSDK_UpdateAppTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(int), args[1].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConditionOperators_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionOperators_Call {
+func (_c *IOneLoginSDK_UpdateAppTyped_Call) Return(_a0 *models.Response[models.App], _a1 error) *IOneLoginSDK_UpdateAppTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConditionOperators_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_ListConditionOperators_Call {
+func (_c *IOneLoginSDK_UpdateAppTyped_Call) RunAndReturn(run func(int, models.App) (*models.Response[models.App], error)) *IOneLoginSDK_UpdateAppTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConditionValues provides a mock function with given fields: conditionValue
-func (_m *IOneLoginSDK) ListConditionValues(conditionValue string) (interface{}, error) {
-	ret := _m.Called(conditionValue)
+// UpdateAppTypedWithContext provides a mock function with given fields: ctx, id, app
+func (_m *IOneLoginSDK) UpdateAppTypedWithContext(ctx context.Context, id int, app models.App) (*models.Response[models.App], error) {
+	ret := _m.Called(ctx, id, app)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConditionValues")
+		panic("no return value specified for UpdateAppTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.App]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(conditionValue)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.App) (*models.Response[models.App], error)); ok {
+		return rf(ctx, id, app)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(conditionValue)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.App) *models.Response[models.App]); ok {
+		r0 = rf(ctx, id, app)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.App])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(conditionValue)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.App) error); ok {
+		r1 = rf(ctx, id, app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4416,57 +13556,59 @@ func (_m *IOneLoginSDK) ListConditionValues(conditionValue string) (interface{},
 	return r0, r1
 }
 
-// IOneLoginSDK_ListConditionValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditionValues'
-type IOneLoginSDK_ListConditionValues_Call struct {
+// IOneLoginSDK_UpdateAppTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppTypedWithContext'
+type IOneLoginSDK_UpdateAppTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// ListConditionValues is a helper method to define mock.On call
-//   - conditionValue string
-func (_e *IOneLoginSDK_Expecter) ListConditionValues(conditionValue interface{}) *IOneLoginSDK_ListConditionValues_Call {
-	return &IOneLoginSDK_ListConditionValues_Call{Call: _e.mock.On("ListConditionValues", conditionValue)}
+// UpdateAppTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) UpdateAppTypedWithContext(ctx interface{}, id interface{}, app interface{}) *IOneLoginSDK_UpdateAppTypedWithContext_Call {
+	return &IOneLoginSDK_UpdateAppTypedWithContext_Call{Call: _e.mock.On("UpdateAppTypedWithContext", ctx, id, app)}
 }
 
-func (_c *IOneLoginSDK_ListConditionValues_Call) Run(run func(conditionValue string)) *IOneLoginSDK_ListConditionValues_Call {
+func (_c *IOneLoginSDK_UpdateAppTypedWithContext_Call) Run(run func(ctx context.Context, id int, app models.App)) *IOneLoginSDK_UpdateAppTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConditionValues_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditionValues_Call {
+func (_c *IOneLoginSDK_UpdateAppTypedWithContext_Call) Return(_a0 *models.Response[models.App], _a1 error) *IOneLoginSDK_UpdateAppTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConditionValues_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_ListConditionValues_Call {
+func (_c *IOneLoginSDK_UpdateAppTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.App) (*models.Response[models.App], error)) *IOneLoginSDK_UpdateAppTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConditions provides a mock function with given fields:
-func (_m *IOneLoginSDK) ListConditions() (interface{}, error) {
-	ret := _m.Called()
+// UpdateAppWithContext provides a mock function with given fields: ctx, id, app
+func (_m *IOneLoginSDK) UpdateAppWithContext(ctx context.Context, id int, app models.App) (interface{}, error) {
+	ret := _m.Called(ctx, id, app)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConditions")
+		panic("no return value specified for UpdateAppWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.App) (interface{}, error)); ok {
+		return rf(ctx, id, app)
 	}
-	if rf, ok := ret.Get(0).(func() interface{}); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.App) interface{}); ok {
+		r0 = rf(ctx, id, app)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.App) error); ok {
+		r1 = rf(ctx, id, app)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4474,56 +13616,59 @@ func (_m *IOneLoginSDK) ListConditions() (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_ListConditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConditions'
-type IOneLoginSDK_ListConditions_Call struct {
+// IOneLoginSDK_UpdateAppWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppWithContext'
+type IOneLoginSDK_UpdateAppWithContext_Call struct {
 	*mock.Call
 }
 
-// ListConditions is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) ListConditions() *IOneLoginSDK_ListConditions_Call {
-	return &IOneLoginSDK_ListConditions_Call{Call: _e.mock.On("ListConditions")}
+// UpdateAppWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - app models.App
+func (_e *IOneLoginSDK_Expecter) UpdateAppWithContext(ctx interface{}, id interface{}, app interface{}) *IOneLoginSDK_UpdateAppWithContext_Call {
+	return &IOneLoginSDK_UpdateAppWithContext_Call{Call: _e.mock.On("UpdateAppWithContext", ctx, id, app)}
 }
 
-func (_c *IOneLoginSDK_ListConditions_Call) Run(run func()) *IOneLoginSDK_ListConditions_Call {
+func (_c *IOneLoginSDK_UpdateAppWithContext_Call) Run(run func(ctx context.Context, id int, app models.App)) *IOneLoginSDK_UpdateAppWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(int), args[2].(models.App))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConditions_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConditions_Call {
+func (_c *IOneLoginSDK_UpdateAppWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAppWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConditions_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListConditions_Call {
+func (_c *IOneLoginSDK_UpdateAppWithContext_Call) RunAndReturn(run func(context.Context, int, models.App) (interface{}, error)) *IOneLoginSDK_UpdateAppWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConnectors provides a mock function with given fields:
-func (_m *IOneLoginSDK) ListConnectors() (interface{}, error) {
-	ret := _m.Called()
+// UpdateAuthServer provides a mock function with given fields: id, authServer
+func (_m *IOneLoginSDK) UpdateAuthServer(id int, authServer models.AuthServer) (interface{}, error) {
+	ret := _m.Called(id, authServer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConnectors")
+		panic("no return value specified for UpdateAuthServer")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int, models.AuthServer) (interface{}, error)); ok {
+		return rf(id, authServer)
 	}
-	if rf, ok := ret.Get(0).(func() interface{}); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int, models.AuthServer) interface{}); ok {
+		r0 = rf(id, authServer)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int, models.AuthServer) error); ok {
+		r1 = rf(id, authServer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4531,56 +13676,58 @@ func (_m *IOneLoginSDK) ListConnectors() (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_ListConnectors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConnectors'
-type IOneLoginSDK_ListConnectors_Call struct {
+// IOneLoginSDK_UpdateAuthServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthServer'
+type IOneLoginSDK_UpdateAuthServer_Call struct {
 	*mock.Call
 }
 
-// ListConnectors is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) ListConnectors() *IOneLoginSDK_ListConnectors_Call {
-	return &IOneLoginSDK_ListConnectors_Call{Call: _e.mock.On("ListConnectors")}
+// UpdateAuthServer is a helper method to define mock.On call
+//   - id int
+//   - authServer models.AuthServer
+func (_e *IOneLoginSDK_Expecter) UpdateAuthServer(id interface{}, authServer interface{}) *IOneLoginSDK_UpdateAuthServer_Call {
+	return &IOneLoginSDK_UpdateAuthServer_Call{Call: _e.mock.On("UpdateAuthServer", id, authServer)}
 }
 
-func (_c *IOneLoginSDK_ListConnectors_Call) Run(run func()) *IOneLoginSDK_ListConnectors_Call {
+func (_c *IOneLoginSDK_UpdateAuthServer_Call) Run(run func(id int, authServer models.AuthServer)) *IOneLoginSDK_UpdateAuthServer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(int), args[1].(models.AuthServer))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConnectors_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListConnectors_Call {
+func (_c *IOneLoginSDK_UpdateAuthServer_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAuthServer_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListConnectors_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListConnectors_Call {
+func (_c *IOneLoginSDK_UpdateAuthServer_Call) RunAndReturn(run func(int, models.AuthServer) (interface{}, error)) *IOneLoginSDK_UpdateAuthServer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEnvironmentVariables provides a mock function with given fields:
-func (_m *IOneLoginSDK) ListEnvironmentVariables() (interface{}, error) {
-	ret := _m.Called()
+// UpdateAuthServerScope provides a mock function with given fields: id, scopeID, scope
+func (_m *IOneLoginSDK) UpdateAuthServerScope(id int, scopeID int, scope models.Scope) (interface{}, error) {
+	ret := _m.Called(id, scopeID, scope)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEnvironmentVariables")
+		panic("no return value specified for UpdateAuthServerScope")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(int, int, models.Scope) (interface{}, error)); ok {
+		return rf(id, scopeID, scope)
 	}
-	if rf, ok := ret.Get(0).(func() interface{}); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int, int, models.Scope) interface{}); ok {
+		r0 = rf(id, scopeID, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int, int, models.Scope) error); ok {
+		r1 = rf(id, scopeID, scope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4588,56 +13735,59 @@ func (_m *IOneLoginSDK) ListEnvironmentVariables() (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_ListEnvironmentVariables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironmentVariables'
-type IOneLoginSDK_ListEnvironmentVariables_Call struct {
+// IOneLoginSDK_UpdateAuthServerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthServerScope'
+type IOneLoginSDK_UpdateAuthServerScope_Call struct {
 	*mock.Call
 }
 
-// ListEnvironmentVariables is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) ListEnvironmentVariables() *IOneLoginSDK_ListEnvironmentVariables_Call {
-	return &IOneLoginSDK_ListEnvironmentVariables_Call{Call: _e.mock.On("ListEnvironmentVariables")}
+// UpdateAuthServerScope is a helper method to define mock.On call
+//   - id int
+//   - scopeID int
+//   - scope models.Scope
+func (_e *IOneLoginSDK_Expecter) UpdateAuthServerScope(id interface{}, scopeID interface{}, scope interface{}) *IOneLoginSDK_UpdateAuthServerScope_Call {
+	return &IOneLoginSDK_UpdateAuthServerScope_Call{Call: _e.mock.On("UpdateAuthServerScope", id, scopeID, scope)}
 }
 
-func (_c *IOneLoginSDK_ListEnvironmentVariables_Call) Run(run func()) *IOneLoginSDK_ListEnvironmentVariables_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerScope_Call) Run(run func(id int, scopeID int, scope models.Scope)) *IOneLoginSDK_UpdateAuthServerScope_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(int), args[1].(int), args[2].(models.Scope))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListEnvironmentVariables_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListEnvironmentVariables_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerScope_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAuthServerScope_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListEnvironmentVariables_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListEnvironmentVariables_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerScope_Call) RunAndReturn(run func(int, int, models.Scope) (interface{}, error)) *IOneLoginSDK_UpdateAuthServerScope_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListHooks provides a mock function with given fields: query
-func (_m *IOneLoginSDK) ListHooks(query models.Queryable) (interface{}, error) {
-	ret := _m.Called(query)
+// UpdateAuthServerScopeWithContext provides a mock function with given fields: ctx, id, scopeID, scope
+func (_m *IOneLoginSDK) UpdateAuthServerScopeWithContext(ctx context.Context, id int, scopeID int, scope models.Scope) (interface{}, error) {
+	ret := _m.Called(ctx, id, scopeID, scope)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListHooks")
+		panic("no return value specified for UpdateAuthServerScopeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(query)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.Scope) (interface{}, error)); ok {
+		return rf(ctx, id, scopeID, scope)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(query)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.Scope) interface{}); ok {
+		r0 = rf(ctx, id, scopeID, scope)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(query)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, models.Scope) error); ok {
+		r1 = rf(ctx, id, scopeID, scope)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4645,57 +13795,60 @@ func (_m *IOneLoginSDK) ListHooks(query models.Queryable) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_ListHooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHooks'
-type IOneLoginSDK_ListHooks_Call struct {
+// IOneLoginSDK_UpdateAuthServerScopeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthServerScopeWithContext'
+type IOneLoginSDK_UpdateAuthServerScopeWithContext_Call struct {
 	*mock.Call
 }
 
-// ListHooks is a helper method to define mock.On call
-//   - query models.Queryable
-func (_e *IOneLoginSDK_Expecter) ListHooks(query interface{}) *IOneLoginSDK_ListHooks_Call {
-	return &IOneLoginSDK_ListHooks_Call{Call: _e.mock.On("ListHooks", query)}
+// UpdateAuthServerScopeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - scopeID int
+//   - scope models.Scope
+func (_e *IOneLoginSDK_Expecter) UpdateAuthServerScopeWithContext(ctx interface{}, id interface{}, scopeID interface{}, scope interface{}) *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call {
+	return &IOneLoginSDK_UpdateAuthServerScopeWithContext_Call{Call: _e.mock.On("UpdateAuthServerScopeWithContext", ctx, id, scopeID, scope)}
 }
 
-func (_c *IOneLoginSDK_ListHooks_Call) Run(run func(query models.Queryable)) *IOneLoginSDK_ListHooks_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call) Run(run func(ctx context.Context, id int, scopeID int, scope models.Scope)) *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(models.Scope))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListHooks_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListHooks_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListHooks_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_ListHooks_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call) RunAndReturn(run func(context.Context, int, int, models.Scope) (interface{}, error)) *IOneLoginSDK_UpdateAuthServerScopeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListMappings provides a mock function with given fields:
-func (_m *IOneLoginSDK) ListMappings() (interface{}, error) {
-	ret := _m.Called()
+// UpdateAuthServerWithContext provides a mock function with given fields: ctx, id, authServer
+func (_m *IOneLoginSDK) UpdateAuthServerWithContext(ctx context.Context, id int, authServer models.AuthServer) (interface{}, error) {
+	ret := _m.Called(ctx, id, authServer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListMappings")
+		panic("no return value specified for UpdateAuthServerWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (interface{}, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.AuthServer) (interface{}, error)); ok {
+		return rf(ctx, id, authServer)
 	}
-	if rf, ok := ret.Get(0).(func() interface{}); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.AuthServer) interface{}); ok {
+		r0 = rf(ctx, id, authServer)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.AuthServer) error); ok {
+		r1 = rf(ctx, id, authServer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4703,56 +13856,59 @@ func (_m *IOneLoginSDK) ListMappings() (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_ListMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMappings'
-type IOneLoginSDK_ListMappings_Call struct {
+// IOneLoginSDK_UpdateAuthServerWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthServerWithContext'
+type IOneLoginSDK_UpdateAuthServerWithContext_Call struct {
 	*mock.Call
 }
 
-// ListMappings is a helper method to define mock.On call
-func (_e *IOneLoginSDK_Expecter) ListMappings() *IOneLoginSDK_ListMappings_Call {
-	return &IOneLoginSDK_ListMappings_Call{Call: _e.mock.On("ListMappings")}
+// UpdateAuthServerWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - authServer models.AuthServer
+func (_e *IOneLoginSDK_Expecter) UpdateAuthServerWithContext(ctx interface{}, id interface{}, authServer interface{}) *IOneLoginSDK_UpdateAuthServerWithContext_Call {
+	return &IOneLoginSDK_UpdateAuthServerWithContext_Call{Call: _e.mock.On("UpdateAuthServerWithContext", ctx, id, authServer)}
 }
 
-func (_c *IOneLoginSDK_ListMappings_Call) Run(run func()) *IOneLoginSDK_ListMappings_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerWithContext_Call) Run(run func(ctx context.Context, id int, authServer models.AuthServer)) *IOneLoginSDK_UpdateAuthServerWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(int), args[2].(models.AuthServer))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListMappings_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListMappings_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAuthServerWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListMappings_Call) RunAndReturn(run func() (interface{}, error)) *IOneLoginSDK_ListMappings_Call {
+func (_c *IOneLoginSDK_UpdateAuthServerWithContext_Call) RunAndReturn(run func(context.Context, int, models.AuthServer) (interface{}, error)) *IOneLoginSDK_UpdateAuthServerWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPrivileges provides a mock function with given fields: queryParams
-func (_m *IOneLoginSDK) ListPrivileges(queryParams models.Queryable) (interface{}, error) {
-	ret := _m.Called(queryParams)
+// UpdateClaim provides a mock function with given fields: id, claimID, claim
+func (_m *IOneLoginSDK) UpdateClaim(id int, claimID int, claim models.AccessTokenClaim) (interface{}, error) {
+	ret := _m.Called(id, claimID, claim)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListPrivileges")
+		panic("no return value specified for UpdateClaim")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Queryable) (interface{}, error)); ok {
-		return rf(queryParams)
+	if rf, ok := ret.Get(0).(func(int, int, models.AccessTokenClaim) (interface{}, error)); ok {
+		return rf(id, claimID, claim)
 	}
-	if rf, ok := ret.Get(0).(func(models.Queryable) interface{}); ok {
-		r0 = rf(queryParams)
+	if rf, ok := ret.Get(0).(func(int, int, models.AccessTokenClaim) interface{}); ok {
+		r0 = rf(id, claimID, claim)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Queryable) error); ok {
-		r1 = rf(queryParams)
+	if rf, ok := ret.Get(1).(func(int, int, models.AccessTokenClaim) error); ok {
+		r1 = rf(id, claimID, claim)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4760,57 +13916,59 @@ func (_m *IOneLoginSDK) ListPrivileges(queryParams models.Queryable) (interface{
 	return r0, r1
 }
 
-// IOneLoginSDK_ListPrivileges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivileges'
-type IOneLoginSDK_ListPrivileges_Call struct {
+// IOneLoginSDK_UpdateClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaim'
+type IOneLoginSDK_UpdateClaim_Call struct {
 	*mock.Call
 }
 
-// ListPrivileges is a helper method to define mock.On call
-//   - queryParams models.Queryable
-func (_e *IOneLoginSDK_Expecter) ListPrivileges(queryParams interface{}) *IOneLoginSDK_ListPrivileges_Call {
-	return &IOneLoginSDK_ListPrivileges_Call{Call: _e.mock.On("ListPrivileges", queryParams)}
+// UpdateClaim is a helper method to define mock.On call
+//   - id int
+//   - claimID int
+//   - claim models.AccessTokenClaim
+func (_e *IOneLoginSDK_Expecter) UpdateClaim(id interface{}, claimID interface{}, claim interface{}) *IOneLoginSDK_UpdateClaim_Call {
+	return &IOneLoginSDK_UpdateClaim_Call{Call: _e.mock.On("UpdateClaim", id, claimID, claim)}
 }
 
-func (_c *IOneLoginSDK_ListPrivileges_Call) Run(run func(queryParams models.Queryable)) *IOneLoginSDK_ListPrivileges_Call {
+func (_c *IOneLoginSDK_UpdateClaim_Call) Run(run func(id int, claimID int, claim models.AccessTokenClaim)) *IOneLoginSDK_UpdateClaim_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Queryable))
+		run(args[0].(int), args[1].(int), args[2].(models.AccessTokenClaim))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListPrivileges_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_ListPrivileges_Call {
+func (_c *IOneLoginSDK_UpdateClaim_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateClaim_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_ListPrivileges_Call) RunAndReturn(run func(models.Queryable) (interface{}, error)) *IOneLoginSDK_ListPrivileges_Call {
+func (_c *IOneLoginSDK_UpdateClaim_Call) RunAndReturn(run func(int, int, models.AccessTokenClaim) (interface{}, error)) *IOneLoginSDK_UpdateClaim_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LockUserAccount provides a mock function with given fields: id
-func (_m *IOneLoginSDK) LockUserAccount(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// UpdateClaimWithContext provides a mock function with given fields: ctx, id, claimID, claim
+func (_m *IOneLoginSDK) UpdateClaimWithContext(ctx context.Context, id int, claimID int, claim models.AccessTokenClaim) (interface{}, error) {
+	ret := _m.Called(ctx, id, claimID, claim)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LockUserAccount")
+		panic("no return value specified for UpdateClaimWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.AccessTokenClaim) (interface{}, error)); ok {
+		return rf(ctx, id, claimID, claim)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.AccessTokenClaim) interface{}); ok {
+		r0 = rf(ctx, id, claimID, claim)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, models.AccessTokenClaim) error); ok {
+		r1 = rf(ctx, id, claimID, claim)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4818,57 +13976,60 @@ func (_m *IOneLoginSDK) LockUserAccount(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_LockUserAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockUserAccount'
-type IOneLoginSDK_LockUserAccount_Call struct {
+// IOneLoginSDK_UpdateClaimWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaimWithContext'
+type IOneLoginSDK_UpdateClaimWithContext_Call struct {
 	*mock.Call
 }
 
-// LockUserAccount is a helper method to define mock.On call
+// UpdateClaimWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-func (_e *IOneLoginSDK_Expecter) LockUserAccount(id interface{}) *IOneLoginSDK_LockUserAccount_Call {
-	return &IOneLoginSDK_LockUserAccount_Call{Call: _e.mock.On("LockUserAccount", id)}
+//   - claimID int
+//   - claim models.AccessTokenClaim
+func (_e *IOneLoginSDK_Expecter) UpdateClaimWithContext(ctx interface{}, id interface{}, claimID interface{}, claim interface{}) *IOneLoginSDK_UpdateClaimWithContext_Call {
+	return &IOneLoginSDK_UpdateClaimWithContext_Call{Call: _e.mock.On("UpdateClaimWithContext", ctx, id, claimID, claim)}
 }
 
-func (_c *IOneLoginSDK_LockUserAccount_Call) Run(run func(id int)) *IOneLoginSDK_LockUserAccount_Call {
+func (_c *IOneLoginSDK_UpdateClaimWithContext_Call) Run(run func(ctx context.Context, id int, claimID int, claim models.AccessTokenClaim)) *IOneLoginSDK_UpdateClaimWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(models.AccessTokenClaim))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_LockUserAccount_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_LockUserAccount_Call {
+func (_c *IOneLoginSDK_UpdateClaimWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateClaimWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_LockUserAccount_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_LockUserAccount_Call {
+func (_c *IOneLoginSDK_UpdateClaimWithContext_Call) RunAndReturn(run func(context.Context, int, int, models.AccessTokenClaim) (interface{}, error)) *IOneLoginSDK_UpdateClaimWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogOutUser provides a mock function with given fields: userID
-func (_m *IOneLoginSDK) LogOutUser(userID int) (interface{}, error) {
-	ret := _m.Called(userID)
+// UpdateClientApp provides a mock function with given fields: id, clientID, clientApp
+func (_m *IOneLoginSDK) UpdateClientApp(id int, clientID int, clientApp models.ClientApp) (interface{}, error) {
+	ret := _m.Called(id, clientID, clientApp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogOutUser")
+		panic("no return value specified for UpdateClientApp")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(int, int, models.ClientApp) (interface{}, error)); ok {
+		return rf(id, clientID, clientApp)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(int, int, models.ClientApp) interface{}); ok {
+		r0 = rf(id, clientID, clientApp)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(int, int, models.ClientApp) error); ok {
+		r1 = rf(id, clientID, clientApp)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4876,57 +14037,59 @@ func (_m *IOneLoginSDK) LogOutUser(userID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_LogOutUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogOutUser'
-type IOneLoginSDK_LogOutUser_Call struct {
+// IOneLoginSDK_UpdateClientApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClientApp'
+type IOneLoginSDK_UpdateClientApp_Call struct {
 	*mock.Call
 }
 
-// LogOutUser is a helper method to define mock.On call
-//   - userID int
-func (_e *IOneLoginSDK_Expecter) LogOutUser(userID interface{}) *IOneLoginSDK_LogOutUser_Call {
-	return &IOneLoginSDK_LogOutUser_Call{Call: _e.mock.On("LogOutUser", userID)}
+// UpdateClientApp is a helper method to define mock.On call
+//   - id int
+//   - clientID int
+//   - clientApp models.ClientApp
+func (_e *IOneLoginSDK_Expecter) UpdateClientApp(id interface{}, clientID interface{}, clientApp interface{}) *IOneLoginSDK_UpdateClientApp_Call {
+	return &IOneLoginSDK_UpdateClientApp_Call{Call: _e.mock.On("UpdateClientApp", id, clientID, clientApp)}
 }
 
-func (_c *IOneLoginSDK_LogOutUser_Call) Run(run func(userID int)) *IOneLoginSDK_LogOutUser_Call {
+func (_c *IOneLoginSDK_UpdateClientApp_Call) Run(run func(id int, clientID int, clientApp models.ClientApp)) *IOneLoginSDK_UpdateClientApp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(int), args[1].(int), args[2].(models.ClientApp))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_LogOutUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_LogOutUser_Call {
+func (_c *IOneLoginSDK_UpdateClientApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateClientApp_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_LogOutUser_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_LogOutUser_Call {
+func (_c *IOneLoginSDK_UpdateClientApp_Call) RunAndReturn(run func(int, int, models.ClientApp) (interface{}, error)) *IOneLoginSDK_UpdateClientApp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveMFAFactor provides a mock function with given fields: userID, deviceID
-func (_m *IOneLoginSDK) RemoveMFAFactor(userID int, deviceID int) (interface{}, error) {
-	ret := _m.Called(userID, deviceID)
+// UpdateClientAppWithContext provides a mock function with given fields: ctx, id, clientID, clientApp
+func (_m *IOneLoginSDK) UpdateClientAppWithContext(ctx context.Context, id int, clientID int, clientApp models.ClientApp) (interface{}, error) {
+	ret := _m.Called(ctx, id, clientID, clientApp)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveMFAFactor")
+		panic("no return value specified for UpdateClientAppWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
-		return rf(userID, deviceID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.ClientApp) (interface{}, error)); ok {
+		return rf(ctx, id, clientID, clientApp)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
-		r0 = rf(userID, deviceID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, models.ClientApp) interface{}); ok {
+		r0 = rf(ctx, id, clientID, clientApp)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(userID, deviceID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, models.ClientApp) error); ok {
+		r1 = rf(ctx, id, clientID, clientApp)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4934,58 +14097,60 @@ func (_m *IOneLoginSDK) RemoveMFAFactor(userID int, deviceID int) (interface{},
 	return r0, r1
 }
 
-// IOneLoginSDK_RemoveMFAFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMFAFactor'
-type IOneLoginSDK_RemoveMFAFactor_Call struct {
+// IOneLoginSDK_UpdateClientAppWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClientAppWithContext'
+type IOneLoginSDK_UpdateClientAppWithContext_Call struct {
 	*mock.Call
 }
 
-// RemoveMFAFactor is a helper method to define mock.On call
-//   - userID int
-//   - deviceID int
-func (_e *IOneLoginSDK_Expecter) RemoveMFAFactor(userID interface{}, deviceID interface{}) *IOneLoginSDK_RemoveMFAFactor_Call {
-	return &IOneLoginSDK_RemoveMFAFactor_Call{Call: _e.mock.On("RemoveMFAFactor", userID, deviceID)}
+// UpdateClientAppWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - clientID int
+//   - clientApp models.ClientApp
+func (_e *IOneLoginSDK_Expecter) UpdateClientAppWithContext(ctx interface{}, id interface{}, clientID interface{}, clientApp interface{}) *IOneLoginSDK_UpdateClientAppWithContext_Call {
+	return &IOneLoginSDK_UpdateClientAppWithContext_Call{Call: _e.mock.On("UpdateClientAppWithContext", ctx, id, clientID, clientApp)}
 }
 
-func (_c *IOneLoginSDK_RemoveMFAFactor_Call) Run(run func(userID int, deviceID int)) *IOneLoginSDK_RemoveMFAFactor_Call {
+func (_c *IOneLoginSDK_UpdateClientAppWithContext_Call) Run(run func(ctx context.Context, id int, clientID int, clientApp models.ClientApp)) *IOneLoginSDK_UpdateClientAppWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(models.ClientApp))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_RemoveMFAFactor_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemoveMFAFactor_Call {
+func (_c *IOneLoginSDK_UpdateClientAppWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateClientAppWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_RemoveMFAFactor_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_RemoveMFAFactor_Call {
+func (_c *IOneLoginSDK_UpdateClientAppWithContext_Call) RunAndReturn(run func(context.Context, int, int, models.ClientApp) (interface{}, error)) *IOneLoginSDK_UpdateClientAppWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemovePrivilegeFromUser provides a mock function with given fields: privilegeID, userID
-func (_m *IOneLoginSDK) RemovePrivilegeFromUser(privilegeID string, userID int) (interface{}, error) {
-	ret := _m.Called(privilegeID, userID)
+// UpdateEnvironmentVariable provides a mock function with given fields: envVarID, name, value
+func (_m *IOneLoginSDK) UpdateEnvironmentVariable(envVarID int, name string, value string) (interface{}, error) {
+	ret := _m.Called(envVarID, name, value)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemovePrivilegeFromUser")
+		panic("no return value specified for UpdateEnvironmentVariable")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) (interface{}, error)); ok {
-		return rf(privilegeID, userID)
+	if rf, ok := ret.Get(0).(func(int, string, string) (interface{}, error)); ok {
+		return rf(envVarID, name, value)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) interface{}); ok {
-		r0 = rf(privilegeID, userID)
+	if rf, ok := ret.Get(0).(func(int, string, string) interface{}); ok {
+		r0 = rf(envVarID, name, value)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(privilegeID, userID)
+	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
+		r1 = rf(envVarID, name, value)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4993,58 +14158,59 @@ func (_m *IOneLoginSDK) RemovePrivilegeFromUser(privilegeID string, userID int)
 	return r0, r1
 }
 
-// IOneLoginSDK_RemovePrivilegeFromUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePrivilegeFromUser'
-type IOneLoginSDK_RemovePrivilegeFromUser_Call struct {
+// IOneLoginSDK_UpdateEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnvironmentVariable'
+type IOneLoginSDK_UpdateEnvironmentVariable_Call struct {
 	*mock.Call
 }
 
-// RemovePrivilegeFromUser is a helper method to define mock.On call
-//   - privilegeID string
-//   - userID int
-func (_e *IOneLoginSDK_Expecter) RemovePrivilegeFromUser(privilegeID interface{}, userID interface{}) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
-	return &IOneLoginSDK_RemovePrivilegeFromUser_Call{Call: _e.mock.On("RemovePrivilegeFromUser", privilegeID, userID)}
+// UpdateEnvironmentVariable is a helper method to define mock.On call
+//   - envVarID int
+//   - name string
+//   - value string
+func (_e *IOneLoginSDK_Expecter) UpdateEnvironmentVariable(envVarID interface{}, name interface{}, value interface{}) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
+	return &IOneLoginSDK_UpdateEnvironmentVariable_Call{Call: _e.mock.On("UpdateEnvironmentVariable", envVarID, name, value)}
 }
 
-func (_c *IOneLoginSDK_RemovePrivilegeFromUser_Call) Run(run func(privilegeID string, userID int)) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+func (_c *IOneLoginSDK_UpdateEnvironmentVariable_Call) Run(run func(envVarID int, name string, value string)) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(int), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_RemovePrivilegeFromUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+func (_c *IOneLoginSDK_UpdateEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_RemovePrivilegeFromUser_Call) RunAndReturn(run func(string, int) (interface{}, error)) *IOneLoginSDK_RemovePrivilegeFromUser_Call {
+func (_c *IOneLoginSDK_UpdateEnvironmentVariable_Call) RunAndReturn(run func(int, string, string) (interface{}, error)) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveUserRole provides a mock function with given fields: userID
-func (_m *IOneLoginSDK) RemoveUserRole(userID int) (interface{}, error) {
-	ret := _m.Called(userID)
+// UpdateEnvironmentVariableWithContext provides a mock function with given fields: ctx, envVarID, name, value
+func (_m *IOneLoginSDK) UpdateEnvironmentVariableWithContext(ctx context.Context, envVarID int, name string, value string) (interface{}, error) {
+	ret := _m.Called(ctx, envVarID, name, value)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveUserRole")
+		panic("no return value specified for UpdateEnvironmentVariableWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) (interface{}, error)); ok {
+		return rf(ctx, envVarID, name, value)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) interface{}); ok {
+		r0 = rf(ctx, envVarID, name, value)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = rf(ctx, envVarID, name, value)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5052,57 +14218,60 @@ func (_m *IOneLoginSDK) RemoveUserRole(userID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_RemoveUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserRole'
-type IOneLoginSDK_RemoveUserRole_Call struct {
+// IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnvironmentVariableWithContext'
+type IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call struct {
 	*mock.Call
 }
 
-// RemoveUserRole is a helper method to define mock.On call
-//   - userID int
-func (_e *IOneLoginSDK_Expecter) RemoveUserRole(userID interface{}) *IOneLoginSDK_RemoveUserRole_Call {
-	return &IOneLoginSDK_RemoveUserRole_Call{Call: _e.mock.On("RemoveUserRole", userID)}
+// UpdateEnvironmentVariableWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - envVarID int
+//   - name string
+//   - value string
+func (_e *IOneLoginSDK_Expecter) UpdateEnvironmentVariableWithContext(ctx interface{}, envVarID interface{}, name interface{}, value interface{}) *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call {
+	return &IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call{Call: _e.mock.On("UpdateEnvironmentVariableWithContext", ctx, envVarID, name, value)}
 }
 
-func (_c *IOneLoginSDK_RemoveUserRole_Call) Run(run func(userID int)) *IOneLoginSDK_RemoveUserRole_Call {
+func (_c *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call) Run(run func(ctx context.Context, envVarID int, name string, value string)) *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_RemoveUserRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_RemoveUserRole_Call {
+func (_c *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_RemoveUserRole_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_RemoveUserRole_Call {
+func (_c *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call) RunAndReturn(run func(context.Context, int, string, string) (interface{}, error)) *IOneLoginSDK_UpdateEnvironmentVariableWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendInviteLink provides a mock function with given fields: email
-func (_m *IOneLoginSDK) SendInviteLink(email string) (interface{}, error) {
-	ret := _m.Called(email)
+// UpdateMapping provides a mock function with given fields: mappingID
+func (_m *IOneLoginSDK) UpdateMapping(mappingID int) (interface{}, error) {
+	ret := _m.Called(mappingID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendInviteLink")
+		panic("no return value specified for UpdateMapping")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(email)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(mappingID)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(email)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(mappingID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(email)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(mappingID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5110,57 +14279,57 @@ func (_m *IOneLoginSDK) SendInviteLink(email string) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_SendInviteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendInviteLink'
-type IOneLoginSDK_SendInviteLink_Call struct {
+// IOneLoginSDK_UpdateMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapping'
+type IOneLoginSDK_UpdateMapping_Call struct {
 	*mock.Call
 }
 
-// SendInviteLink is a helper method to define mock.On call
-//   - email string
-func (_e *IOneLoginSDK_Expecter) SendInviteLink(email interface{}) *IOneLoginSDK_SendInviteLink_Call {
-	return &IOneLoginSDK_SendInviteLink_Call{Call: _e.mock.On("SendInviteLink", email)}
+// UpdateMapping is a helper method to define mock.On call
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) UpdateMapping(mappingID interface{}) *IOneLoginSDK_UpdateMapping_Call {
+	return &IOneLoginSDK_UpdateMapping_Call{Call: _e.mock.On("UpdateMapping", mappingID)}
 }
 
-func (_c *IOneLoginSDK_SendInviteLink_Call) Run(run func(email string)) *IOneLoginSDK_SendInviteLink_Call {
+func (_c *IOneLoginSDK_UpdateMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_UpdateMapping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_SendInviteLink_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SendInviteLink_Call {
+func (_c *IOneLoginSDK_UpdateMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateMapping_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_SendInviteLink_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_SendInviteLink_Call {
+func (_c *IOneLoginSDK_UpdateMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_UpdateMapping_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetCustomAttributes provides a mock function with given fields: userID, attr
-func (_m *IOneLoginSDK) SetCustomAttributes(userID int, attr interface{}) (interface{}, error) {
-	ret := _m.Called(userID, attr)
+// UpdateMappingWithContext provides a mock function with given fields: ctx, mappingID
+func (_m *IOneLoginSDK) UpdateMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
+	ret := _m.Called(ctx, mappingID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetCustomAttributes")
+		panic("no return value specified for UpdateMappingWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, interface{}) (interface{}, error)); ok {
-		return rf(userID, attr)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, mappingID)
 	}
-	if rf, ok := ret.Get(0).(func(int, interface{}) interface{}); ok {
-		r0 = rf(userID, attr)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, mappingID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, interface{}) error); ok {
-		r1 = rf(userID, attr)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, mappingID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5168,58 +14337,58 @@ func (_m *IOneLoginSDK) SetCustomAttributes(userID int, attr interface{}) (inter
 	return r0, r1
 }
 
-// IOneLoginSDK_SetCustomAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCustomAttributes'
-type IOneLoginSDK_SetCustomAttributes_Call struct {
+// IOneLoginSDK_UpdateMappingWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMappingWithContext'
+type IOneLoginSDK_UpdateMappingWithContext_Call struct {
 	*mock.Call
 }
 
-// SetCustomAttributes is a helper method to define mock.On call
-//   - userID int
-//   - attr interface{}
-func (_e *IOneLoginSDK_Expecter) SetCustomAttributes(userID interface{}, attr interface{}) *IOneLoginSDK_SetCustomAttributes_Call {
-	return &IOneLoginSDK_SetCustomAttributes_Call{Call: _e.mock.On("SetCustomAttributes", userID, attr)}
+// UpdateMappingWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingID int
+func (_e *IOneLoginSDK_Expecter) UpdateMappingWithContext(ctx interface{}, mappingID interface{}) *IOneLoginSDK_UpdateMappingWithContext_Call {
+	return &IOneLoginSDK_UpdateMappingWithContext_Call{Call: _e.mock.On("UpdateMappingWithContext", ctx, mappingID)}
 }
 
-func (_c *IOneLoginSDK_SetCustomAttributes_Call) Run(run func(userID int, attr interface{})) *IOneLoginSDK_SetCustomAttributes_Call {
+func (_c *IOneLoginSDK_UpdateMappingWithContext_Call) Run(run func(ctx context.Context, mappingID int)) *IOneLoginSDK_UpdateMappingWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(interface{}))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_SetCustomAttributes_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SetCustomAttributes_Call {
+func (_c *IOneLoginSDK_UpdateMappingWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateMappingWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_SetCustomAttributes_Call) RunAndReturn(run func(int, interface{}) (interface{}, error)) *IOneLoginSDK_SetCustomAttributes_Call {
+func (_c *IOneLoginSDK_UpdateMappingWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_UpdateMappingWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetUserState provides a mock function with given fields: userID, state
-func (_m *IOneLoginSDK) SetUserState(userID int, state int) (interface{}, error) {
-	ret := _m.Called(userID, state)
+// UpdatePasswordInsecure provides a mock function with given fields: id
+func (_m *IOneLoginSDK) UpdatePasswordInsecure(id int) (interface{}, error) {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetUserState")
+		panic("no return value specified for UpdatePasswordInsecure")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) (interface{}, error)); ok {
-		return rf(userID, state)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) interface{}); ok {
-		r0 = rf(userID, state)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(userID, state)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5227,58 +14396,57 @@ func (_m *IOneLoginSDK) SetUserState(userID int, state int) (interface{}, error)
 	return r0, r1
 }
 
-// IOneLoginSDK_SetUserState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserState'
-type IOneLoginSDK_SetUserState_Call struct {
+// IOneLoginSDK_UpdatePasswordInsecure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordInsecure'
+type IOneLoginSDK_UpdatePasswordInsecure_Call struct {
 	*mock.Call
 }
 
-// SetUserState is a helper method to define mock.On call
-//   - userID int
-//   - state int
-func (_e *IOneLoginSDK_Expecter) SetUserState(userID interface{}, state interface{}) *IOneLoginSDK_SetUserState_Call {
-	return &IOneLoginSDK_SetUserState_Call{Call: _e.mock.On("SetUserState", userID, state)}
+// UpdatePasswordInsecure is a helper method to define mock.On call
+//   - id int
+func (_e *IOneLoginSDK_Expecter) UpdatePasswordInsecure(id interface{}) *IOneLoginSDK_UpdatePasswordInsecure_Call {
+	return &IOneLoginSDK_UpdatePasswordInsecure_Call{Call: _e.mock.On("UpdatePasswordInsecure", id)}
 }
 
-func (_c *IOneLoginSDK_SetUserState_Call) Run(run func(userID int, state int)) *IOneLoginSDK_SetUserState_Call {
+func (_c *IOneLoginSDK_UpdatePasswordInsecure_Call) Run(run func(id int)) *IOneLoginSDK_UpdatePasswordInsecure_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_SetUserState_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_SetUserState_Call {
+func (_c *IOneLoginSDK_UpdatePasswordInsecure_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePasswordInsecure_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_SetUserState_Call) RunAndReturn(run func(int, int) (interface{}, error)) *IOneLoginSDK_SetUserState_Call {
+func (_c *IOneLoginSDK_UpdatePasswordInsecure_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_UpdatePasswordInsecure_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateApp provides a mock function with given fields: id, app
-func (_m *IOneLoginSDK) UpdateApp(id int, app models.App) (interface{}, error) {
-	ret := _m.Called(id, app)
+// UpdatePasswordInsecureWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) UpdatePasswordInsecureWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateApp")
+		panic("no return value specified for UpdatePasswordInsecureWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.App) (interface{}, error)); ok {
-		return rf(id, app)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.App) interface{}); ok {
-		r0 = rf(id, app)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.App) error); ok {
-		r1 = rf(id, app)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5286,58 +14454,58 @@ func (_m *IOneLoginSDK) UpdateApp(id int, app models.App) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApp'
-type IOneLoginSDK_UpdateApp_Call struct {
+// IOneLoginSDK_UpdatePasswordInsecureWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordInsecureWithContext'
+type IOneLoginSDK_UpdatePasswordInsecureWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdateApp is a helper method to define mock.On call
+// UpdatePasswordInsecureWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-//   - app models.App
-func (_e *IOneLoginSDK_Expecter) UpdateApp(id interface{}, app interface{}) *IOneLoginSDK_UpdateApp_Call {
-	return &IOneLoginSDK_UpdateApp_Call{Call: _e.mock.On("UpdateApp", id, app)}
+func (_e *IOneLoginSDK_Expecter) UpdatePasswordInsecureWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call {
+	return &IOneLoginSDK_UpdatePasswordInsecureWithContext_Call{Call: _e.mock.On("UpdatePasswordInsecureWithContext", ctx, id)}
 }
 
-func (_c *IOneLoginSDK_UpdateApp_Call) Run(run func(id int, app models.App)) *IOneLoginSDK_UpdateApp_Call {
+func (_c *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.App))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateApp_Call {
+func (_c *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateApp_Call) RunAndReturn(run func(int, models.App) (interface{}, error)) *IOneLoginSDK_UpdateApp_Call {
+func (_c *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_UpdatePasswordInsecureWithContext_Call {
 	_c.Call.Return(run)
 	return _c
-}
-
-// UpdateAppRule provides a mock function with given fields: id, ruleID, appRule, queryParams
-func (_m *IOneLoginSDK) UpdateAppRule(id int, ruleID int, appRule models.AppRule, queryParams map[string]string) (interface{}, error) {
-	ret := _m.Called(id, ruleID, appRule, queryParams)
+}
+
+// UpdatePasswordSecure provides a mock function with given fields: id
+func (_m *IOneLoginSDK) UpdatePasswordSecure(id int) (interface{}, error) {
+	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAppRule")
+		panic("no return value specified for UpdatePasswordSecure")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, models.AppRule, map[string]string) (interface{}, error)); ok {
-		return rf(id, ruleID, appRule, queryParams)
+	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
+		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, models.AppRule, map[string]string) interface{}); ok {
-		r0 = rf(id, ruleID, appRule, queryParams)
+	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
+		r0 = rf(id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, models.AppRule, map[string]string) error); ok {
-		r1 = rf(id, ruleID, appRule, queryParams)
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5345,60 +14513,57 @@ func (_m *IOneLoginSDK) UpdateAppRule(id int, ruleID int, appRule models.AppRule
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateAppRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppRule'
-type IOneLoginSDK_UpdateAppRule_Call struct {
+// IOneLoginSDK_UpdatePasswordSecure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordSecure'
+type IOneLoginSDK_UpdatePasswordSecure_Call struct {
 	*mock.Call
 }
 
-// UpdateAppRule is a helper method to define mock.On call
+// UpdatePasswordSecure is a helper method to define mock.On call
 //   - id int
-//   - ruleID int
-//   - appRule models.AppRule
-//   - queryParams map[string]string
-func (_e *IOneLoginSDK_Expecter) UpdateAppRule(id interface{}, ruleID interface{}, appRule interface{}, queryParams interface{}) *IOneLoginSDK_UpdateAppRule_Call {
-	return &IOneLoginSDK_UpdateAppRule_Call{Call: _e.mock.On("UpdateAppRule", id, ruleID, appRule, queryParams)}
+func (_e *IOneLoginSDK_Expecter) UpdatePasswordSecure(id interface{}) *IOneLoginSDK_UpdatePasswordSecure_Call {
+	return &IOneLoginSDK_UpdatePasswordSecure_Call{Call: _e.mock.On("UpdatePasswordSecure", id)}
 }
 
-func (_c *IOneLoginSDK_UpdateAppRule_Call) Run(run func(id int, ruleID int, appRule models.AppRule, queryParams map[string]string)) *IOneLoginSDK_UpdateAppRule_Call {
+func (_c *IOneLoginSDK_UpdatePasswordSecure_Call) Run(run func(id int)) *IOneLoginSDK_UpdatePasswordSecure_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(models.AppRule), args[3].(map[string]string))
+		run(args[0].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateAppRule_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAppRule_Call {
+func (_c *IOneLoginSDK_UpdatePasswordSecure_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePasswordSecure_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateAppRule_Call) RunAndReturn(run func(int, int, models.AppRule, map[string]string) (interface{}, error)) *IOneLoginSDK_UpdateAppRule_Call {
+func (_c *IOneLoginSDK_UpdatePasswordSecure_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_UpdatePasswordSecure_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAuthServer provides a mock function with given fields: id, authServer
-func (_m *IOneLoginSDK) UpdateAuthServer(id int, authServer models.AuthServer) (interface{}, error) {
-	ret := _m.Called(id, authServer)
+// UpdatePasswordSecureWithContext provides a mock function with given fields: ctx, id
+func (_m *IOneLoginSDK) UpdatePasswordSecureWithContext(ctx context.Context, id int) (interface{}, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAuthServer")
+		panic("no return value specified for UpdatePasswordSecureWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.AuthServer) (interface{}, error)); ok {
-		return rf(id, authServer)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (interface{}, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.AuthServer) interface{}); ok {
-		r0 = rf(id, authServer)
+	if rf, ok := ret.Get(0).(func(context.Context, int) interface{}); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.AuthServer) error); ok {
-		r1 = rf(id, authServer)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5406,58 +14571,58 @@ func (_m *IOneLoginSDK) UpdateAuthServer(id int, authServer models.AuthServer) (
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateAuthServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthServer'
-type IOneLoginSDK_UpdateAuthServer_Call struct {
+// IOneLoginSDK_UpdatePasswordSecureWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordSecureWithContext'
+type IOneLoginSDK_UpdatePasswordSecureWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdateAuthServer is a helper method to define mock.On call
+// UpdatePasswordSecureWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-//   - authServer models.AuthServer
-func (_e *IOneLoginSDK_Expecter) UpdateAuthServer(id interface{}, authServer interface{}) *IOneLoginSDK_UpdateAuthServer_Call {
-	return &IOneLoginSDK_UpdateAuthServer_Call{Call: _e.mock.On("UpdateAuthServer", id, authServer)}
+func (_e *IOneLoginSDK_Expecter) UpdatePasswordSecureWithContext(ctx interface{}, id interface{}) *IOneLoginSDK_UpdatePasswordSecureWithContext_Call {
+	return &IOneLoginSDK_UpdatePasswordSecureWithContext_Call{Call: _e.mock.On("UpdatePasswordSecureWithContext", ctx, id)}
 }
 
-func (_c *IOneLoginSDK_UpdateAuthServer_Call) Run(run func(id int, authServer models.AuthServer)) *IOneLoginSDK_UpdateAuthServer_Call {
+func (_c *IOneLoginSDK_UpdatePasswordSecureWithContext_Call) Run(run func(ctx context.Context, id int)) *IOneLoginSDK_UpdatePasswordSecureWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.AuthServer))
+		run(args[0].(context.Context), args[1].(int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateAuthServer_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAuthServer_Call {
+func (_c *IOneLoginSDK_UpdatePasswordSecureWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePasswordSecureWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateAuthServer_Call) RunAndReturn(run func(int, models.AuthServer) (interface{}, error)) *IOneLoginSDK_UpdateAuthServer_Call {
+func (_c *IOneLoginSDK_UpdatePasswordSecureWithContext_Call) RunAndReturn(run func(context.Context, int) (interface{}, error)) *IOneLoginSDK_UpdatePasswordSecureWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAuthServerScope provides a mock function with given fields: id, scopeID, scope
-func (_m *IOneLoginSDK) UpdateAuthServerScope(id int, scopeID int, scope models.Scope) (interface{}, error) {
-	ret := _m.Called(id, scopeID, scope)
+// UpdatePrivilege provides a mock function with given fields: privilegeID
+func (_m *IOneLoginSDK) UpdatePrivilege(privilegeID string) (interface{}, error) {
+	ret := _m.Called(privilegeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAuthServerScope")
+		panic("no return value specified for UpdatePrivilege")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, models.Scope) (interface{}, error)); ok {
-		return rf(id, scopeID, scope)
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(privilegeID)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, models.Scope) interface{}); ok {
-		r0 = rf(id, scopeID, scope)
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(privilegeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, models.Scope) error); ok {
-		r1 = rf(id, scopeID, scope)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privilegeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5465,59 +14630,57 @@ func (_m *IOneLoginSDK) UpdateAuthServerScope(id int, scopeID int, scope models.
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateAuthServerScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthServerScope'
-type IOneLoginSDK_UpdateAuthServerScope_Call struct {
+// IOneLoginSDK_UpdatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrivilege'
+type IOneLoginSDK_UpdatePrivilege_Call struct {
 	*mock.Call
 }
 
-// UpdateAuthServerScope is a helper method to define mock.On call
-//   - id int
-//   - scopeID int
-//   - scope models.Scope
-func (_e *IOneLoginSDK_Expecter) UpdateAuthServerScope(id interface{}, scopeID interface{}, scope interface{}) *IOneLoginSDK_UpdateAuthServerScope_Call {
-	return &IOneLoginSDK_UpdateAuthServerScope_Call{Call: _e.mock.On("UpdateAuthServerScope", id, scopeID, scope)}
+// UpdatePrivilege is a helper method to define mock.On call
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) UpdatePrivilege(privilegeID interface{}) *IOneLoginSDK_UpdatePrivilege_Call {
+	return &IOneLoginSDK_UpdatePrivilege_Call{Call: _e.mock.On("UpdatePrivilege", privilegeID)}
 }
 
-func (_c *IOneLoginSDK_UpdateAuthServerScope_Call) Run(run func(id int, scopeID int, scope models.Scope)) *IOneLoginSDK_UpdateAuthServerScope_Call {
+func (_c *IOneLoginSDK_UpdatePrivilege_Call) Run(run func(privilegeID string)) *IOneLoginSDK_UpdatePrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(models.Scope))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateAuthServerScope_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateAuthServerScope_Call {
+func (_c *IOneLoginSDK_UpdatePrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePrivilege_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateAuthServerScope_Call) RunAndReturn(run func(int, int, models.Scope) (interface{}, error)) *IOneLoginSDK_UpdateAuthServerScope_Call {
+func (_c *IOneLoginSDK_UpdatePrivilege_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_UpdatePrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateClaim provides a mock function with given fields: id, claimID, claim
-func (_m *IOneLoginSDK) UpdateClaim(id int, claimID int, claim models.AccessTokenClaim) (interface{}, error) {
-	ret := _m.Called(id, claimID, claim)
+// UpdatePrivilegeWithContext provides a mock function with given fields: ctx, privilegeID
+func (_m *IOneLoginSDK) UpdatePrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
+	ret := _m.Called(ctx, privilegeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateClaim")
+		panic("no return value specified for UpdatePrivilegeWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, models.AccessTokenClaim) (interface{}, error)); ok {
-		return rf(id, claimID, claim)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, privilegeID)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, models.AccessTokenClaim) interface{}); ok {
-		r0 = rf(id, claimID, claim)
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, privilegeID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, models.AccessTokenClaim) error); ok {
-		r1 = rf(id, claimID, claim)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, privilegeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5525,59 +14688,58 @@ func (_m *IOneLoginSDK) UpdateClaim(id int, claimID int, claim models.AccessToke
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaim'
-type IOneLoginSDK_UpdateClaim_Call struct {
+// IOneLoginSDK_UpdatePrivilegeWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrivilegeWithContext'
+type IOneLoginSDK_UpdatePrivilegeWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdateClaim is a helper method to define mock.On call
-//   - id int
-//   - claimID int
-//   - claim models.AccessTokenClaim
-func (_e *IOneLoginSDK_Expecter) UpdateClaim(id interface{}, claimID interface{}, claim interface{}) *IOneLoginSDK_UpdateClaim_Call {
-	return &IOneLoginSDK_UpdateClaim_Call{Call: _e.mock.On("UpdateClaim", id, claimID, claim)}
+// UpdatePrivilegeWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - privilegeID string
+func (_e *IOneLoginSDK_Expecter) UpdatePrivilegeWithContext(ctx interface{}, privilegeID interface{}) *IOneLoginSDK_UpdatePrivilegeWithContext_Call {
+	return &IOneLoginSDK_UpdatePrivilegeWithContext_Call{Call: _e.mock.On("UpdatePrivilegeWithContext", ctx, privilegeID)}
 }
 
-func (_c *IOneLoginSDK_UpdateClaim_Call) Run(run func(id int, claimID int, claim models.AccessTokenClaim)) *IOneLoginSDK_UpdateClaim_Call {
+func (_c *IOneLoginSDK_UpdatePrivilegeWithContext_Call) Run(run func(ctx context.Context, privilegeID string)) *IOneLoginSDK_UpdatePrivilegeWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(models.AccessTokenClaim))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateClaim_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateClaim_Call {
+func (_c *IOneLoginSDK_UpdatePrivilegeWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePrivilegeWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateClaim_Call) RunAndReturn(run func(int, int, models.AccessTokenClaim) (interface{}, error)) *IOneLoginSDK_UpdateClaim_Call {
+func (_c *IOneLoginSDK_UpdatePrivilegeWithContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *IOneLoginSDK_UpdatePrivilegeWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateClientApp provides a mock function with given fields: id, clientID, clientApp
-func (_m *IOneLoginSDK) UpdateClientApp(id int, clientID int, clientApp models.ClientApp) (interface{}, error) {
-	ret := _m.Called(id, clientID, clientApp)
+// UpdateRole provides a mock function with given fields: id, role, queryParams
+func (_m *IOneLoginSDK) UpdateRole(id int, role models.Role, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(id, role, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateClientApp")
+		panic("no return value specified for UpdateRole")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, models.ClientApp) (interface{}, error)); ok {
-		return rf(id, clientID, clientApp)
+	if rf, ok := ret.Get(0).(func(int, models.Role, map[string]string) (interface{}, error)); ok {
+		return rf(id, role, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, models.ClientApp) interface{}); ok {
-		r0 = rf(id, clientID, clientApp)
+	if rf, ok := ret.Get(0).(func(int, models.Role, map[string]string) interface{}); ok {
+		r0 = rf(id, role, queryParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, models.ClientApp) error); ok {
-		r1 = rf(id, clientID, clientApp)
+	if rf, ok := ret.Get(1).(func(int, models.Role, map[string]string) error); ok {
+		r1 = rf(id, role, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5585,59 +14747,59 @@ func (_m *IOneLoginSDK) UpdateClientApp(id int, clientID int, clientApp models.C
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateClientApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClientApp'
-type IOneLoginSDK_UpdateClientApp_Call struct {
+// IOneLoginSDK_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type IOneLoginSDK_UpdateRole_Call struct {
 	*mock.Call
 }
 
-// UpdateClientApp is a helper method to define mock.On call
+// UpdateRole is a helper method to define mock.On call
 //   - id int
-//   - clientID int
-//   - clientApp models.ClientApp
-func (_e *IOneLoginSDK_Expecter) UpdateClientApp(id interface{}, clientID interface{}, clientApp interface{}) *IOneLoginSDK_UpdateClientApp_Call {
-	return &IOneLoginSDK_UpdateClientApp_Call{Call: _e.mock.On("UpdateClientApp", id, clientID, clientApp)}
+//   - role models.Role
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) UpdateRole(id interface{}, role interface{}, queryParams interface{}) *IOneLoginSDK_UpdateRole_Call {
+	return &IOneLoginSDK_UpdateRole_Call{Call: _e.mock.On("UpdateRole", id, role, queryParams)}
 }
 
-func (_c *IOneLoginSDK_UpdateClientApp_Call) Run(run func(id int, clientID int, clientApp models.ClientApp)) *IOneLoginSDK_UpdateClientApp_Call {
+func (_c *IOneLoginSDK_UpdateRole_Call) Run(run func(id int, role models.Role, queryParams map[string]string)) *IOneLoginSDK_UpdateRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(models.ClientApp))
+		run(args[0].(int), args[1].(models.Role), args[2].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateClientApp_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateClientApp_Call {
+func (_c *IOneLoginSDK_UpdateRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateClientApp_Call) RunAndReturn(run func(int, int, models.ClientApp) (interface{}, error)) *IOneLoginSDK_UpdateClientApp_Call {
+func (_c *IOneLoginSDK_UpdateRole_Call) RunAndReturn(run func(int, models.Role, map[string]string) (interface{}, error)) *IOneLoginSDK_UpdateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateEnvironmentVariable provides a mock function with given fields: envVarID, name, value
-func (_m *IOneLoginSDK) UpdateEnvironmentVariable(envVarID int, name string, value string) (interface{}, error) {
-	ret := _m.Called(envVarID, name, value)
+// UpdateRoleApps provides a mock function with given fields: roleID, apps
+func (_m *IOneLoginSDK) UpdateRoleApps(roleID int, apps []int) (interface{}, error) {
+	ret := _m.Called(roleID, apps)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEnvironmentVariable")
+		panic("no return value specified for UpdateRoleApps")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, string, string) (interface{}, error)); ok {
-		return rf(envVarID, name, value)
+	if rf, ok := ret.Get(0).(func(int, []int) (interface{}, error)); ok {
+		return rf(roleID, apps)
 	}
-	if rf, ok := ret.Get(0).(func(int, string, string) interface{}); ok {
-		r0 = rf(envVarID, name, value)
+	if rf, ok := ret.Get(0).(func(int, []int) interface{}); ok {
+		r0 = rf(roleID, apps)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
-		r1 = rf(envVarID, name, value)
+	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
+		r1 = rf(roleID, apps)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5645,59 +14807,58 @@ func (_m *IOneLoginSDK) UpdateEnvironmentVariable(envVarID int, name string, val
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateEnvironmentVariable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnvironmentVariable'
-type IOneLoginSDK_UpdateEnvironmentVariable_Call struct {
+// IOneLoginSDK_UpdateRoleApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoleApps'
+type IOneLoginSDK_UpdateRoleApps_Call struct {
 	*mock.Call
 }
 
-// UpdateEnvironmentVariable is a helper method to define mock.On call
-//   - envVarID int
-//   - name string
-//   - value string
-func (_e *IOneLoginSDK_Expecter) UpdateEnvironmentVariable(envVarID interface{}, name interface{}, value interface{}) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
-	return &IOneLoginSDK_UpdateEnvironmentVariable_Call{Call: _e.mock.On("UpdateEnvironmentVariable", envVarID, name, value)}
+// UpdateRoleApps is a helper method to define mock.On call
+//   - roleID int
+//   - apps []int
+func (_e *IOneLoginSDK_Expecter) UpdateRoleApps(roleID interface{}, apps interface{}) *IOneLoginSDK_UpdateRoleApps_Call {
+	return &IOneLoginSDK_UpdateRoleApps_Call{Call: _e.mock.On("UpdateRoleApps", roleID, apps)}
 }
 
-func (_c *IOneLoginSDK_UpdateEnvironmentVariable_Call) Run(run func(envVarID int, name string, value string)) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_UpdateRoleApps_Call) Run(run func(roleID int, apps []int)) *IOneLoginSDK_UpdateRoleApps_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string), args[2].(string))
+		run(args[0].(int), args[1].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateEnvironmentVariable_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_UpdateRoleApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateRoleApps_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateEnvironmentVariable_Call) RunAndReturn(run func(int, string, string) (interface{}, error)) *IOneLoginSDK_UpdateEnvironmentVariable_Call {
+func (_c *IOneLoginSDK_UpdateRoleApps_Call) RunAndReturn(run func(int, []int) (interface{}, error)) *IOneLoginSDK_UpdateRoleApps_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateMapping provides a mock function with given fields: mappingID
-func (_m *IOneLoginSDK) UpdateMapping(mappingID int) (interface{}, error) {
-	ret := _m.Called(mappingID)
+// UpdateRoleAppsWithContext provides a mock function with given fields: ctx, roleID, apps
+func (_m *IOneLoginSDK) UpdateRoleAppsWithContext(ctx context.Context, roleID int, apps []int) (interface{}, error) {
+	ret := _m.Called(ctx, roleID, apps)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateMapping")
+		panic("no return value specified for UpdateRoleAppsWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(mappingID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) (interface{}, error)); ok {
+		return rf(ctx, roleID, apps)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(mappingID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) interface{}); ok {
+		r0 = rf(ctx, roleID, apps)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(mappingID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, roleID, apps)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5705,57 +14866,59 @@ func (_m *IOneLoginSDK) UpdateMapping(mappingID int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapping'
-type IOneLoginSDK_UpdateMapping_Call struct {
+// IOneLoginSDK_UpdateRoleAppsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoleAppsWithContext'
+type IOneLoginSDK_UpdateRoleAppsWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdateMapping is a helper method to define mock.On call
-//   - mappingID int
-func (_e *IOneLoginSDK_Expecter) UpdateMapping(mappingID interface{}) *IOneLoginSDK_UpdateMapping_Call {
-	return &IOneLoginSDK_UpdateMapping_Call{Call: _e.mock.On("UpdateMapping", mappingID)}
+// UpdateRoleAppsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID int
+//   - apps []int
+func (_e *IOneLoginSDK_Expecter) UpdateRoleAppsWithContext(ctx interface{}, roleID interface{}, apps interface{}) *IOneLoginSDK_UpdateRoleAppsWithContext_Call {
+	return &IOneLoginSDK_UpdateRoleAppsWithContext_Call{Call: _e.mock.On("UpdateRoleAppsWithContext", ctx, roleID, apps)}
 }
 
-func (_c *IOneLoginSDK_UpdateMapping_Call) Run(run func(mappingID int)) *IOneLoginSDK_UpdateMapping_Call {
+func (_c *IOneLoginSDK_UpdateRoleAppsWithContext_Call) Run(run func(ctx context.Context, roleID int, apps []int)) *IOneLoginSDK_UpdateRoleAppsWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateMapping_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateMapping_Call {
+func (_c *IOneLoginSDK_UpdateRoleAppsWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateRoleAppsWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateMapping_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_UpdateMapping_Call {
+func (_c *IOneLoginSDK_UpdateRoleAppsWithContext_Call) RunAndReturn(run func(context.Context, int, []int) (interface{}, error)) *IOneLoginSDK_UpdateRoleAppsWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePasswordInsecure provides a mock function with given fields: id
-func (_m *IOneLoginSDK) UpdatePasswordInsecure(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// UpdateRoleWithContext provides a mock function with given fields: ctx, id, role, queryParams
+func (_m *IOneLoginSDK) UpdateRoleWithContext(ctx context.Context, id int, role models.Role, queryParams map[string]string) (interface{}, error) {
+	ret := _m.Called(ctx, id, role, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePasswordInsecure")
+		panic("no return value specified for UpdateRoleWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Role, map[string]string) (interface{}, error)); ok {
+		return rf(ctx, id, role, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.Role, map[string]string) interface{}); ok {
+		r0 = rf(ctx, id, role, queryParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.Role, map[string]string) error); ok {
+		r1 = rf(ctx, id, role, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5763,57 +14926,60 @@ func (_m *IOneLoginSDK) UpdatePasswordInsecure(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdatePasswordInsecure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordInsecure'
-type IOneLoginSDK_UpdatePasswordInsecure_Call struct {
+// IOneLoginSDK_UpdateRoleWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoleWithContext'
+type IOneLoginSDK_UpdateRoleWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdatePasswordInsecure is a helper method to define mock.On call
+// UpdateRoleWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
-func (_e *IOneLoginSDK_Expecter) UpdatePasswordInsecure(id interface{}) *IOneLoginSDK_UpdatePasswordInsecure_Call {
-	return &IOneLoginSDK_UpdatePasswordInsecure_Call{Call: _e.mock.On("UpdatePasswordInsecure", id)}
+//   - role models.Role
+//   - queryParams map[string]string
+func (_e *IOneLoginSDK_Expecter) UpdateRoleWithContext(ctx interface{}, id interface{}, role interface{}, queryParams interface{}) *IOneLoginSDK_UpdateRoleWithContext_Call {
+	return &IOneLoginSDK_UpdateRoleWithContext_Call{Call: _e.mock.On("UpdateRoleWithContext", ctx, id, role, queryParams)}
 }
 
-func (_c *IOneLoginSDK_UpdatePasswordInsecure_Call) Run(run func(id int)) *IOneLoginSDK_UpdatePasswordInsecure_Call {
+func (_c *IOneLoginSDK_UpdateRoleWithContext_Call) Run(run func(ctx context.Context, id int, role models.Role, queryParams map[string]string)) *IOneLoginSDK_UpdateRoleWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.Role), args[3].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdatePasswordInsecure_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePasswordInsecure_Call {
+func (_c *IOneLoginSDK_UpdateRoleWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateRoleWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdatePasswordInsecure_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_UpdatePasswordInsecure_Call {
+func (_c *IOneLoginSDK_UpdateRoleWithContext_Call) RunAndReturn(run func(context.Context, int, models.Role, map[string]string) (interface{}, error)) *IOneLoginSDK_UpdateRoleWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePasswordSecure provides a mock function with given fields: id
-func (_m *IOneLoginSDK) UpdatePasswordSecure(id int) (interface{}, error) {
-	ret := _m.Called(id)
+// UpdateSmartHook provides a mock function with given fields: hookID, hook
+func (_m *IOneLoginSDK) UpdateSmartHook(hookID int, hook models.SmartHook) (interface{}, error) {
+	ret := _m.Called(hookID, hook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePasswordSecure")
+		panic("no return value specified for UpdateSmartHook")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (interface{}, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(int, models.SmartHook) (interface{}, error)); ok {
+		return rf(hookID, hook)
 	}
-	if rf, ok := ret.Get(0).(func(int) interface{}); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int, models.SmartHook) interface{}); ok {
+		r0 = rf(hookID, hook)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(int, models.SmartHook) error); ok {
+		r1 = rf(hookID, hook)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5821,57 +14987,58 @@ func (_m *IOneLoginSDK) UpdatePasswordSecure(id int) (interface{}, error) {
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdatePasswordSecure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordSecure'
-type IOneLoginSDK_UpdatePasswordSecure_Call struct {
+// IOneLoginSDK_UpdateSmartHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSmartHook'
+type IOneLoginSDK_UpdateSmartHook_Call struct {
 	*mock.Call
 }
 
-// UpdatePasswordSecure is a helper method to define mock.On call
-//   - id int
-func (_e *IOneLoginSDK_Expecter) UpdatePasswordSecure(id interface{}) *IOneLoginSDK_UpdatePasswordSecure_Call {
-	return &IOneLoginSDK_UpdatePasswordSecure_Call{Call: _e.mock.On("UpdatePasswordSecure", id)}
+// UpdateSmartHook is a helper method to define mock.On call
+//   - hookID int
+//   - hook models.SmartHook
+func (_e *IOneLoginSDK_Expecter) UpdateSmartHook(hookID interface{}, hook interface{}) *IOneLoginSDK_UpdateSmartHook_Call {
+	return &IOneLoginSDK_UpdateSmartHook_Call{Call: _e.mock.On("UpdateSmartHook", hookID, hook)}
 }
 
-func (_c *IOneLoginSDK_UpdatePasswordSecure_Call) Run(run func(id int)) *IOneLoginSDK_UpdatePasswordSecure_Call {
+func (_c *IOneLoginSDK_UpdateSmartHook_Call) Run(run func(hookID int, hook models.SmartHook)) *IOneLoginSDK_UpdateSmartHook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int))
+		run(args[0].(int), args[1].(models.SmartHook))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdatePasswordSecure_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePasswordSecure_Call {
+func (_c *IOneLoginSDK_UpdateSmartHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateSmartHook_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdatePasswordSecure_Call) RunAndReturn(run func(int) (interface{}, error)) *IOneLoginSDK_UpdatePasswordSecure_Call {
+func (_c *IOneLoginSDK_UpdateSmartHook_Call) RunAndReturn(run func(int, models.SmartHook) (interface{}, error)) *IOneLoginSDK_UpdateSmartHook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePrivilege provides a mock function with given fields: privilegeID
-func (_m *IOneLoginSDK) UpdatePrivilege(privilegeID string) (interface{}, error) {
-	ret := _m.Called(privilegeID)
+// UpdateSmartHookWithContext provides a mock function with given fields: ctx, hookID, hook
+func (_m *IOneLoginSDK) UpdateSmartHookWithContext(ctx context.Context, hookID int, hook models.SmartHook) (interface{}, error) {
+	ret := _m.Called(ctx, hookID, hook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePrivilege")
+		panic("no return value specified for UpdateSmartHookWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.SmartHook) (interface{}, error)); ok {
+		return rf(ctx, hookID, hook)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(privilegeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.SmartHook) interface{}); ok {
+		r0 = rf(ctx, hookID, hook)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(privilegeID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.SmartHook) error); ok {
+		r1 = rf(ctx, hookID, hook)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5879,57 +15046,59 @@ func (_m *IOneLoginSDK) UpdatePrivilege(privilegeID string) (interface{}, error)
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrivilege'
-type IOneLoginSDK_UpdatePrivilege_Call struct {
+// IOneLoginSDK_UpdateSmartHookWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSmartHookWithContext'
+type IOneLoginSDK_UpdateSmartHookWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdatePrivilege is a helper method to define mock.On call
-//   - privilegeID string
-func (_e *IOneLoginSDK_Expecter) UpdatePrivilege(privilegeID interface{}) *IOneLoginSDK_UpdatePrivilege_Call {
-	return &IOneLoginSDK_UpdatePrivilege_Call{Call: _e.mock.On("UpdatePrivilege", privilegeID)}
+// UpdateSmartHookWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hookID int
+//   - hook models.SmartHook
+func (_e *IOneLoginSDK_Expecter) UpdateSmartHookWithContext(ctx interface{}, hookID interface{}, hook interface{}) *IOneLoginSDK_UpdateSmartHookWithContext_Call {
+	return &IOneLoginSDK_UpdateSmartHookWithContext_Call{Call: _e.mock.On("UpdateSmartHookWithContext", ctx, hookID, hook)}
 }
 
-func (_c *IOneLoginSDK_UpdatePrivilege_Call) Run(run func(privilegeID string)) *IOneLoginSDK_UpdatePrivilege_Call {
+func (_c *IOneLoginSDK_UpdateSmartHookWithContext_Call) Run(run func(ctx context.Context, hookID int, hook models.SmartHook)) *IOneLoginSDK_UpdateSmartHookWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.SmartHook))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdatePrivilege_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdatePrivilege_Call {
+func (_c *IOneLoginSDK_UpdateSmartHookWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateSmartHookWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdatePrivilege_Call) RunAndReturn(run func(string) (interface{}, error)) *IOneLoginSDK_UpdatePrivilege_Call {
+func (_c *IOneLoginSDK_UpdateSmartHookWithContext_Call) RunAndReturn(run func(context.Context, int, models.SmartHook) (interface{}, error)) *IOneLoginSDK_UpdateSmartHookWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRole provides a mock function with given fields: id, role, queryParams
-func (_m *IOneLoginSDK) UpdateRole(id int, role models.Role, queryParams map[string]string) (interface{}, error) {
-	ret := _m.Called(id, role, queryParams)
+// UpdateUser provides a mock function with given fields: id, user
+func (_m *IOneLoginSDK) UpdateUser(id int, user models.User) (interface{}, error) {
+	ret := _m.Called(id, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRole")
+		panic("no return value specified for UpdateUser")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.Role, map[string]string) (interface{}, error)); ok {
-		return rf(id, role, queryParams)
+	if rf, ok := ret.Get(0).(func(int, models.User) (interface{}, error)); ok {
+		return rf(id, user)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.Role, map[string]string) interface{}); ok {
-		r0 = rf(id, role, queryParams)
+	if rf, ok := ret.Get(0).(func(int, models.User) interface{}); ok {
+		r0 = rf(id, user)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.Role, map[string]string) error); ok {
-		r1 = rf(id, role, queryParams)
+	if rf, ok := ret.Get(1).(func(int, models.User) error); ok {
+		r1 = rf(id, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5937,59 +15106,58 @@ func (_m *IOneLoginSDK) UpdateRole(id int, role models.Role, queryParams map[str
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
-type IOneLoginSDK_UpdateRole_Call struct {
+// IOneLoginSDK_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type IOneLoginSDK_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// UpdateRole is a helper method to define mock.On call
+// UpdateUser is a helper method to define mock.On call
 //   - id int
-//   - role models.Role
-//   - queryParams map[string]string
-func (_e *IOneLoginSDK_Expecter) UpdateRole(id interface{}, role interface{}, queryParams interface{}) *IOneLoginSDK_UpdateRole_Call {
-	return &IOneLoginSDK_UpdateRole_Call{Call: _e.mock.On("UpdateRole", id, role, queryParams)}
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) UpdateUser(id interface{}, user interface{}) *IOneLoginSDK_UpdateUser_Call {
+	return &IOneLoginSDK_UpdateUser_Call{Call: _e.mock.On("UpdateUser", id, user)}
 }
 
-func (_c *IOneLoginSDK_UpdateRole_Call) Run(run func(id int, role models.Role, queryParams map[string]string)) *IOneLoginSDK_UpdateRole_Call {
+func (_c *IOneLoginSDK_UpdateUser_Call) Run(run func(id int, user models.User)) *IOneLoginSDK_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.Role), args[2].(map[string]string))
+		run(args[0].(int), args[1].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateRole_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateRole_Call {
+func (_c *IOneLoginSDK_UpdateUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateRole_Call) RunAndReturn(run func(int, models.Role, map[string]string) (interface{}, error)) *IOneLoginSDK_UpdateRole_Call {
+func (_c *IOneLoginSDK_UpdateUser_Call) RunAndReturn(run func(int, models.User) (interface{}, error)) *IOneLoginSDK_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRoleApps provides a mock function with given fields: roleID, apps
-func (_m *IOneLoginSDK) UpdateRoleApps(roleID int, apps []int) (interface{}, error) {
-	ret := _m.Called(roleID, apps)
+// UpdateUserTyped provides a mock function with given fields: id, user
+func (_m *IOneLoginSDK) UpdateUserTyped(id int, user models.User) (*models.Response[models.User], error) {
+	ret := _m.Called(id, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRoleApps")
+		panic("no return value specified for UpdateUserTyped")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.User]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, []int) (interface{}, error)); ok {
-		return rf(roleID, apps)
+	if rf, ok := ret.Get(0).(func(int, models.User) (*models.Response[models.User], error)); ok {
+		return rf(id, user)
 	}
-	if rf, ok := ret.Get(0).(func(int, []int) interface{}); ok {
-		r0 = rf(roleID, apps)
+	if rf, ok := ret.Get(0).(func(int, models.User) *models.Response[models.User]); ok {
+		r0 = rf(id, user)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.User])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, []int) error); ok {
-		r1 = rf(roleID, apps)
+	if rf, ok := ret.Get(1).(func(int, models.User) error); ok {
+		r1 = rf(id, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5997,58 +15165,58 @@ func (_m *IOneLoginSDK) UpdateRoleApps(roleID int, apps []int) (interface{}, err
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateRoleApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoleApps'
-type IOneLoginSDK_UpdateRoleApps_Call struct {
+// IOneLoginSDK_UpdateUserTyped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserTyped'
+type IOneLoginSDK_UpdateUserTyped_Call struct {
 	*mock.Call
 }
 
-// UpdateRoleApps is a helper method to define mock.On call
-//   - roleID int
-//   - apps []int
-func (_e *IOneLoginSDK_Expecter) UpdateRoleApps(roleID interface{}, apps interface{}) *IOneLoginSDK_UpdateRoleApps_Call {
-	return &IOneLoginSDK_UpdateRoleApps_Call{Call: _e.mock.On("UpdateRoleApps", roleID, apps)}
+// UpdateUserTyped is a helper method to define mock.On call
+//   - id int
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) UpdateUserTyped(id interface{}, user interface{}) *IOneLoginSDK_UpdateUserTyped_Call {
+	return &IOneLoginSDK_UpdateUserTyped_Call{Call: _e.mock.On("UpdateUserTyped", id, user)}
 }
 
-func (_c *IOneLoginSDK_UpdateRoleApps_Call) Run(run func(roleID int, apps []int)) *IOneLoginSDK_UpdateRoleApps_Call {
+func (_c *IOneLoginSDK_UpdateUserTyped_Call) Run(run func(id int, user models.User)) *IOneLoginSDK_UpdateUserTyped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].([]int))
+		run(args[0].(int), args[1].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateRoleApps_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateRoleApps_Call {
+func (_c *IOneLoginSDK_UpdateUserTyped_Call) Return(_a0 *models.Response[models.User], _a1 error) *IOneLoginSDK_UpdateUserTyped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateRoleApps_Call) RunAndReturn(run func(int, []int) (interface{}, error)) *IOneLoginSDK_UpdateRoleApps_Call {
+func (_c *IOneLoginSDK_UpdateUserTyped_Call) RunAndReturn(run func(int, models.User) (*models.Response[models.User], error)) *IOneLoginSDK_UpdateUserTyped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSmartHook provides a mock function with given fields: hookID, hook
-func (_m *IOneLoginSDK) UpdateSmartHook(hookID int, hook models.SmartHook) (interface{}, error) {
-	ret := _m.Called(hookID, hook)
+// UpdateUserTypedWithContext provides a mock function with given fields: ctx, id, user
+func (_m *IOneLoginSDK) UpdateUserTypedWithContext(ctx context.Context, id int, user models.User) (*models.Response[models.User], error) {
+	ret := _m.Called(ctx, id, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateSmartHook")
+		panic("no return value specified for UpdateUserTypedWithContext")
 	}
 
-	var r0 interface{}
+	var r0 *models.Response[models.User]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.SmartHook) (interface{}, error)); ok {
-		return rf(hookID, hook)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.User) (*models.Response[models.User], error)); ok {
+		return rf(ctx, id, user)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.SmartHook) interface{}); ok {
-		r0 = rf(hookID, hook)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.User) *models.Response[models.User]); ok {
+		r0 = rf(ctx, id, user)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(*models.Response[models.User])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.SmartHook) error); ok {
-		r1 = rf(hookID, hook)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.User) error); ok {
+		r1 = rf(ctx, id, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -6056,58 +15224,59 @@ func (_m *IOneLoginSDK) UpdateSmartHook(hookID int, hook models.SmartHook) (inte
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateSmartHook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSmartHook'
-type IOneLoginSDK_UpdateSmartHook_Call struct {
+// IOneLoginSDK_UpdateUserTypedWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserTypedWithContext'
+type IOneLoginSDK_UpdateUserTypedWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdateSmartHook is a helper method to define mock.On call
-//   - hookID int
-//   - hook models.SmartHook
-func (_e *IOneLoginSDK_Expecter) UpdateSmartHook(hookID interface{}, hook interface{}) *IOneLoginSDK_UpdateSmartHook_Call {
-	return &IOneLoginSDK_UpdateSmartHook_Call{Call: _e.mock.On("UpdateSmartHook", hookID, hook)}
+// UpdateUserTypedWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - user models.User
+func (_e *IOneLoginSDK_Expecter) UpdateUserTypedWithContext(ctx interface{}, id interface{}, user interface{}) *IOneLoginSDK_UpdateUserTypedWithContext_Call {
+	return &IOneLoginSDK_UpdateUserTypedWithContext_Call{Call: _e.mock.On("UpdateUserTypedWithContext", ctx, id, user)}
 }
 
-func (_c *IOneLoginSDK_UpdateSmartHook_Call) Run(run func(hookID int, hook models.SmartHook)) *IOneLoginSDK_UpdateSmartHook_Call {
+func (_c *IOneLoginSDK_UpdateUserTypedWithContext_Call) Run(run func(ctx context.Context, id int, user models.User)) *IOneLoginSDK_UpdateUserTypedWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.SmartHook))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateSmartHook_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateSmartHook_Call {
+func (_c *IOneLoginSDK_UpdateUserTypedWithContext_Call) Return(_a0 *models.Response[models.User], _a1 error) *IOneLoginSDK_UpdateUserTypedWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateSmartHook_Call) RunAndReturn(run func(int, models.SmartHook) (interface{}, error)) *IOneLoginSDK_UpdateSmartHook_Call {
+func (_c *IOneLoginSDK_UpdateUserTypedWithContext_Call) RunAndReturn(run func(context.Context, int, models.User) (*models.Response[models.User], error)) *IOneLoginSDK_UpdateUserTypedWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: id, user
-func (_m *IOneLoginSDK) UpdateUser(id int, user models.User) (interface{}, error) {
-	ret := _m.Called(id, user)
+// UpdateUserWithContext provides a mock function with given fields: ctx, id, user
+func (_m *IOneLoginSDK) UpdateUserWithContext(ctx context.Context, id int, user models.User) (interface{}, error) {
+	ret := _m.Called(ctx, id, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for UpdateUserWithContext")
 	}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, models.User) (interface{}, error)); ok {
-		return rf(id, user)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.User) (interface{}, error)); ok {
+		return rf(ctx, id, user)
 	}
-	if rf, ok := ret.Get(0).(func(int, models.User) interface{}); ok {
-		r0 = rf(id, user)
+	if rf, ok := ret.Get(0).(func(context.Context, int, models.User) interface{}); ok {
+		r0 = rf(ctx, id, user)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(interface{})
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, models.User) error); ok {
-		r1 = rf(id, user)
+	if rf, ok := ret.Get(1).(func(context.Context, int, models.User) error); ok {
+		r1 = rf(ctx, id, user)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -6115,31 +15284,32 @@ func (_m *IOneLoginSDK) UpdateUser(id int, user models.User) (interface{}, error
 	return r0, r1
 }
 
-// IOneLoginSDK_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type IOneLoginSDK_UpdateUser_Call struct {
+// IOneLoginSDK_UpdateUserWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserWithContext'
+type IOneLoginSDK_UpdateUserWithContext_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// UpdateUserWithContext is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id int
 //   - user models.User
-func (_e *IOneLoginSDK_Expecter) UpdateUser(id interface{}, user interface{}) *IOneLoginSDK_UpdateUser_Call {
-	return &IOneLoginSDK_UpdateUser_Call{Call: _e.mock.On("UpdateUser", id, user)}
+func (_e *IOneLoginSDK_Expecter) UpdateUserWithContext(ctx interface{}, id interface{}, user interface{}) *IOneLoginSDK_UpdateUserWithContext_Call {
+	return &IOneLoginSDK_UpdateUserWithContext_Call{Call: _e.mock.On("UpdateUserWithContext", ctx, id, user)}
 }
 
-func (_c *IOneLoginSDK_UpdateUser_Call) Run(run func(id int, user models.User)) *IOneLoginSDK_UpdateUser_Call {
+func (_c *IOneLoginSDK_UpdateUserWithContext_Call) Run(run func(ctx context.Context, id int, user models.User)) *IOneLoginSDK_UpdateUserWithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(models.User))
+		run(args[0].(context.Context), args[1].(int), args[2].(models.User))
 	})
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateUser_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateUser_Call {
+func (_c *IOneLoginSDK_UpdateUserWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_UpdateUserWithContext_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IOneLoginSDK_UpdateUser_Call) RunAndReturn(run func(int, models.User) (interface{}, error)) *IOneLoginSDK_UpdateUser_Call {
+func (_c *IOneLoginSDK_UpdateUserWithContext_Call) RunAndReturn(run func(context.Context, int, models.User) (interface{}, error)) *IOneLoginSDK_UpdateUserWithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6202,6 +15372,65 @@ func (_c *IOneLoginSDK_VerifyFactorSAML_Call) RunAndReturn(run func(models.Verif
 	return _c
 }
 
+// VerifyFactorSAMLWithContext provides a mock function with given fields: ctx, request
+func (_m *IOneLoginSDK) VerifyFactorSAMLWithContext(ctx context.Context, request models.VerifyMFATokenRequest) (interface{}, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyFactorSAMLWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.VerifyMFATokenRequest) (interface{}, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.VerifyMFATokenRequest) interface{}); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.VerifyMFATokenRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_VerifyFactorSAMLWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyFactorSAMLWithContext'
+type IOneLoginSDK_VerifyFactorSAMLWithContext_Call struct {
+	*mock.Call
+}
+
+// VerifyFactorSAMLWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request models.VerifyMFATokenRequest
+func (_e *IOneLoginSDK_Expecter) VerifyFactorSAMLWithContext(ctx interface{}, request interface{}) *IOneLoginSDK_VerifyFactorSAMLWithContext_Call {
+	return &IOneLoginSDK_VerifyFactorSAMLWithContext_Call{Call: _e.mock.On("VerifyFactorSAMLWithContext", ctx, request)}
+}
+
+func (_c *IOneLoginSDK_VerifyFactorSAMLWithContext_Call) Run(run func(ctx context.Context, request models.VerifyMFATokenRequest)) *IOneLoginSDK_VerifyFactorSAMLWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.VerifyMFATokenRequest))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_VerifyFactorSAMLWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_VerifyFactorSAMLWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_VerifyFactorSAMLWithContext_Call) RunAndReturn(run func(context.Context, models.VerifyMFATokenRequest) (interface{}, error)) *IOneLoginSDK_VerifyFactorSAMLWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyMFAEnrollment provides a mock function with given fields: userID, registrationID, otp
 func (_m *IOneLoginSDK) VerifyMFAEnrollment(userID int, registrationID int, otp int) (interface{}, error) {
 	ret := _m.Called(userID, registrationID, otp)
@@ -6262,6 +15491,67 @@ func (_c *IOneLoginSDK_VerifyMFAEnrollment_Call) RunAndReturn(run func(int, int,
 	return _c
 }
 
+// VerifyMFAEnrollmentWithContext provides a mock function with given fields: ctx, userID, registrationID, otp
+func (_m *IOneLoginSDK) VerifyMFAEnrollmentWithContext(ctx context.Context, userID int, registrationID int, otp int) (interface{}, error) {
+	ret := _m.Called(ctx, userID, registrationID, otp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMFAEnrollmentWithContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) (interface{}, error)); ok {
+		return rf(ctx, userID, registrationID, otp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) interface{}); ok {
+		r0 = rf(ctx, userID, registrationID, otp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, userID, registrationID, otp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMFAEnrollmentWithContext'
+type IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call struct {
+	*mock.Call
+}
+
+// VerifyMFAEnrollmentWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - registrationID int
+//   - otp int
+func (_e *IOneLoginSDK_Expecter) VerifyMFAEnrollmentWithContext(ctx interface{}, userID interface{}, registrationID interface{}, otp interface{}) *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call {
+	return &IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call{Call: _e.mock.On("VerifyMFAEnrollmentWithContext", ctx, userID, registrationID, otp)}
+}
+
+func (_c *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call) Run(run func(ctx context.Context, userID int, registrationID int, otp int)) *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call) Return(_a0 interface{}, _a1 error) *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call) RunAndReturn(run func(context.Context, int, int, int) (interface{}, error)) *IOneLoginSDK_VerifyMFAEnrollmentWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIOneLoginSDK creates a new instance of IOneLoginSDK. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIOneLoginSDK(t interface {
@@ -24,9 +24,16 @@ type ResponseWithMetadata struct {
 	Error    error            `json:"error"`
 }
 
+// Response is a decoded API response whose Data holds the concrete model type T,
+// e.g. Response[[]User] for a list of users.
+type Response[T any] struct {
+	Data     T                `json:"data"`
+	Metadata ResponseMetadata `json:"metadata"`
+}
+
 type BaseQueryRequest struct {
-	Limit  string `json:"limit,omitempty"`
-	Page   string `json:"page,omitempty"`
+	Limit  string `json:"limit,omitempty" validate:"numeric"`
+	Page   string `json:"page,omitempty" validate:"numeric"`
 	Cursor string `json:"cursor,omitempty"`
 }
 
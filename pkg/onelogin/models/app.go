@@ -2,8 +2,8 @@ package models
 
 type App struct {
 	ID                 *int32                `json:"id,omitempty"`
-	ConnectorID        *int32                `json:"connector_id"`
-	Name               *string               `json:"name"`
+	ConnectorID        *int32                `json:"connector_id" validate:"min=1"`
+	Name               *string               `json:"name" validate:"notblank"`
 	Description        *string               `json:"description,omitempty"`
 	Notes              *string               `json:"notes,omitempty"`
 	PolicyID           *int                  `json:"policy_id,omitempty"`
@@ -120,9 +120,9 @@ const (
 
 type AppQuery struct {
 	BaseQueryRequest
-	Name        *string `json:"name,omitempty"`
-	ConnectorID *int    `json:"connector_id,omitempty"`
-	AuthMethod  *int    `json:"auth_method,omitempty"`
+	Name        *string `json:"name,omitempty" validate:"notblank"`
+	ConnectorID *int    `json:"connector_id,omitempty" validate:"min=1"`
+	AuthMethod  *int    `json:"auth_method,omitempty" validate:"min=0"`
 }
 
 func (q *AppQuery) GetKeyValidators() map[string]func(interface{}) bool {
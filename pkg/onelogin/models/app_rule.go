@@ -1,13 +1,13 @@
 package models
 
 type Condition struct {
-	Source   string `json:"source"`
-	Operator string `json:"operator"`
+	Source   string `json:"source" validate:"required"`
+	Operator string `json:"operator" validate:"required"`
 	Value    string `json:"value"`
 }
 
 type Action struct {
-	Action     string   `json:"action"`
+	Action     string   `json:"action" validate:"required"`
 	Value      []string `json:"value,omitempty"`
 	Expression string   `json:"expression,omitempty"`
 	Scriplet   string   `json:"scriplet,omitempty"`
@@ -16,9 +16,9 @@ type Action struct {
 
 type AppRule struct {
 	AppID      int         `json:"app_id"`
-	Name       string      `json:"name"`
+	Name       string      `json:"name" validate:"required"`
 	Enabled    bool        `json:"enabled"`
-	Match      string      `json:"match"`
+	Match      string      `json:"match" validate:"oneof=all any"`
 	Position   int         `json:"position,omitempty"`
 	Conditions []Condition `json:"conditions"`
 	Actions    []Action    `json:"actions"`
@@ -27,10 +27,10 @@ type AppRule struct {
 type AppRuleQuery struct {
 	BaseQueryRequest
 	Enabled          bool    `json:"enabled,omitempty"`
-	HasCondition     *string `json:"has_condition,omitempty"`
-	HasConditionType *string `json:"has_condition_type,omitempty"`
-	HasAction        *string `json:"has_action,omitempty"`
-	HasActionType    *string `json:"has_action_type,omitempty"`
+	HasCondition     *string `json:"has_condition,omitempty" validate:"notblank"`
+	HasConditionType *string `json:"has_condition_type,omitempty" validate:"notblank"`
+	HasAction        *string `json:"has_action,omitempty" validate:"notblank"`
+	HasActionType    *string `json:"has_action_type,omitempty" validate:"notblank"`
 }
 
 func (q *AppRuleQuery) GetKeyValidators() map[string]func(interface{}) bool {
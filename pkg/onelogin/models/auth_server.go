@@ -71,18 +71,18 @@ func (q *AuthServerQuery) GetKeyValidators() map[string]func(interface{}) bool {
 
 func (q *ClientAppsQuery) GetKeyValidators() map[string]func(interface{}) bool {
 	return map[string]func(interface{}) bool{
-		"auth_server_id": validateString,
+		"id": validateInt,
 	}
 }
 
 func (q *ScopesQuery) GetKeyValidators() map[string]func(interface{}) bool {
 	return map[string]func(interface{}) bool{
-		"auth_server_id": validateString,
+		"id": validateInt,
 	}
 }
 
 func (q *AccessTokenClaimsQuery) GetKeyValidators() map[string]func(interface{}) bool {
 	return map[string]func(interface{}) bool{
-		"auth_server_id": validateString,
+		"id": validateInt,
 	}
 }
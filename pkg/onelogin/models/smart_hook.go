@@ -32,6 +32,15 @@ type SmartHookQuery struct {
 	Type string `json:"type,omitempty"`
 }
 
+func (q *SmartHookQuery) GetKeyValidators() map[string]func(interface{}) bool {
+	return map[string]func(interface{}) bool{
+		"limit":  validateString,
+		"page":   validateString,
+		"cursor": validateString,
+		"type":   validateString,
+	}
+}
+
 // SmartHook represents a OneLogin SmartHook with associated resource data
 type SmartHook struct {
 	ID             *string           `json:"id,omitempty"`
@@ -60,8 +69,8 @@ type Options struct {
 
 // SmartHookEnvVarQuery represents available query parameters
 type SmartHookEnvVarQuery struct {
-	Limit  string `json:"limit,omitempty"`
-	Page   string `json:"page,omitempty"`
+	Limit  string `json:"limit,omitempty" validate:"numeric"`
+	Page   string `json:"page,omitempty" validate:"numeric"`
 	Cursor string `json:"cursor,omitempty"`
 	Type   string `json:"type,omitempty"`
 }
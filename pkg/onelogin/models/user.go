@@ -30,16 +30,16 @@ type UserQuery struct {
 	UpdatedUntil   *time.Time `json:"updated_until,omitempty"`
 	LastLoginSince *time.Time `json:"last_login_since,omitempty"`
 	LastLoginUntil *time.Time `json:"last_login_until,omitempty"`
-	Firstname      *string    `json:"firstname,omitempty"`
-	Lastname       *string    `json:"lastname,omitempty"`
-	Email          *string    `json:"email,omitempty"`
-	Username       *string    `json:"username,omitempty"`
-	Samaccountname *string    `json:"samaccountname,omitempty"`
-	DirectoryID    *string    `json:"directory_id,omitempty"`
-	ExternalID     *string    `json:"external_id,omitempty"`
-	AppID          *string    `json:"app_id,omitempty"`
-	UserIDs        *string    `json:"user_ids,omitempty"`
-	Fields         *string    `json:"fields,omitempty"`
+	Firstname      *string    `json:"firstname,omitempty" validate:"notblank"`
+	Lastname       *string    `json:"lastname,omitempty" validate:"notblank"`
+	Email          *string    `json:"email,omitempty" validate:"notblank"`
+	Username       *string    `json:"username,omitempty" validate:"notblank"`
+	Samaccountname *string    `json:"samaccountname,omitempty" validate:"notblank"`
+	DirectoryID    *string    `json:"directory_id,omitempty" validate:"numeric"`
+	ExternalID     *string    `json:"external_id,omitempty" validate:"numeric"`
+	AppID          *string    `json:"app_id,omitempty" validate:"numeric"`
+	UserIDs        *string    `json:"user_ids,omitempty" validate:"list"`
+	Fields         *string    `json:"fields,omitempty" validate:"list"`
 }
 
 // User represents a OneLogin User
@@ -47,14 +47,14 @@ type User struct {
 	Firstname            string                 `json:"firstname,omitempty"`
 	Lastname             string                 `json:"lastname,omitempty"`
 	Username             string                 `json:"username,omitempty"`
-	Email                string                 `json:"email,omitempty"`
+	Email                string                 `json:"email,omitempty" validate:"email"`
 	DistinguishedName    string                 `json:"distinguished_name,omitempty"`
 	Samaccountname       string                 `json:"samaccountname,omitempty"`
 	UserPrincipalName    string                 `json:"userprincipalname,omitempty"`
 	MemberOf             string                 `json:"member_of,omitempty"`
 	Phone                string                 `json:"phone,omitempty"`
 	Password             string                 `json:"password,omitempty"`
-	PasswordConfirmation string                 `json:"password_confirmation,omitempty"`
+	PasswordConfirmation string                 `json:"password_confirmation,omitempty" validate:"eqfield=Password"`
 	PasswordAlgorithm    string                 `json:"password_algorithm,omitempty"`
 	Salt                 string                 `json:"salt,omitempty"`
 	Title                string                 `json:"title,omitempty"`
@@ -68,8 +68,8 @@ type User struct {
 	PasswordChangedAt    time.Time              `json:"password_changed_at,omitempty"`
 	LockedUntil          time.Time              `json:"locked_until,omitempty"`
 	InvitationSentAt     time.Time              `json:"invitation_sent_at,omitempty"`
-	State                int32                  `json:"state,omitempty"`
-	Status               int32                  `json:"status,omitempty"`
+	State                int32                  `json:"state,omitempty" validate:"oneof=0 1 2 3"`
+	Status               int32                  `json:"status,omitempty" validate:"oneof=0 1 2 3 4 5 7 8"`
 	InvalidLoginAttempts int32                  `json:"invalid_login_attempts,omitempty"`
 	GroupID              int64                  `json:"group_id,omitempty"`
 	DirectoryID          int64                  `json:"directory_id,omitempty"`
@@ -83,25 +83,25 @@ type User struct {
 
 func (q *UserQuery) GetKeyValidators() map[string]func(interface{}) bool {
 	return map[string]func(interface{}) bool{
-		"limit":          validateString,
-		"page":           validateString,
-		"cursor":         validateString,
-		"createdSince":   validateTime,
-		"createdUntil":   validateTime,
-		"updatedSince":   validateTime,
-		"updatedUntil":   validateTime,
-		"lastLoginSince": validateTime,
-		"lastLoginUntil": validateTime,
-		"firstname":      validateString,
-		"lastname":       validateString,
-		"email":          validateString,
-		"username":       validateString,
-		"samaccountname": validateString,
-		"directoryID":    validateNumericString,
-		"externalID":     validateNumericString,
-		"appID":          validateNumericString,
-		"userIDs":        validateCommaSeparatedList,
-		"fields":         validateString,
+		"limit":            validateString,
+		"page":             validateString,
+		"cursor":           validateString,
+		"created_since":    validateTime,
+		"created_until":    validateTime,
+		"updated_since":    validateTime,
+		"updated_until":    validateTime,
+		"last_login_since": validateTime,
+		"last_login_until": validateTime,
+		"firstname":        validateString,
+		"lastname":         validateString,
+		"email":            validateString,
+		"username":         validateString,
+		"samaccountname":   validateString,
+		"directory_id":     validateNumericString,
+		"external_id":      validateNumericString,
+		"app_id":           validateNumericString,
+		"user_ids":         validateCommaSeparatedList,
+		"fields":           validateString,
 	}
 }
 
@@ -7,7 +7,20 @@ type UserMappingsQuery struct {
 	HasConditionType string `json:"has_condition_type,omitempty"`
 	HasAction        string `json:"has_action,omitempty"`
 	HasActionType    string `json:"has_action_type,omitempty"`
-	Enabled          string `json:"enabled,omitempty"`
+	Enabled          string `json:"enabled,omitempty" validate:"oneof=true false"`
+}
+
+func (q *UserMappingsQuery) GetKeyValidators() map[string]func(interface{}) bool {
+	return map[string]func(interface{}) bool{
+		"limit":              validateString,
+		"page":               validateString,
+		"cursor":             validateString,
+		"has_condition":      validateString,
+		"has_condition_type": validateString,
+		"has_action":         validateString,
+		"has_action_type":    validateString,
+		"enabled":            validateString,
+	}
 }
 
 // UserMapping is the contract for User Mappings.
@@ -36,13 +49,13 @@ type UserMappingActions struct {
 
 func (u *UserMapping) GetKeyValidators() map[string]func(interface{}) bool {
 	return map[string]func(interface{}) bool{
-		"limit":            validateString,
-		"page":             validateString,
-		"cursor":           validateString,
-		"has_condition":    validateString,
-		"has_condition_id": validateString,
-		"has_action":       validateString,
-		"has_action_id":    validateString,
-		"enabled":          validateBool,
+		"limit":              validateString,
+		"page":               validateString,
+		"cursor":             validateString,
+		"has_condition":      validateString,
+		"has_condition_type": validateString,
+		"has_action":         validateString,
+		"has_action_type":    validateString,
+		"enabled":            validateBool,
 	}
 }
@@ -282,7 +282,7 @@ func (s *UserTestSuite) TestUserQueryValidation() {
 				AppID:       strPtr("54321"),
 				UserIDs:     strPtr("1,2,3,4,5"),
 			},
-			validKeys: []string{"email", "username", "directoryID", "externalID", "appID", "userIDs"},
+			validKeys: []string{"email", "username", "directory_id", "external_id", "app_id", "user_ids"},
 			expectErr: false,
 		},
 		{
@@ -291,7 +291,7 @@ func (s *UserTestSuite) TestUserQueryValidation() {
 				CreatedSince: timePtr(now.Add(-24 * time.Hour)),
 				CreatedUntil: timePtr(now),
 			},
-			validKeys: []string{"createdSince", "createdUntil"},
+			validKeys: []string{"created_since", "created_until"},
 			expectErr: false,
 		},
 		{
@@ -307,7 +307,7 @@ func (s *UserTestSuite) TestUserQueryValidation() {
 			query: UserQuery{
 				CreatedSince: timePtr(time.Time{}),
 			},
-			validKeys: []string{"createdSince"},
+			validKeys: []string{"created_since"},
 			expectErr: true,
 		},
 		{
@@ -317,7 +317,7 @@ func (s *UserTestSuite) TestUserQueryValidation() {
 				ExternalID:  strPtr("67890"),
 				AppID:       strPtr("54321"),
 			},
-			validKeys: []string{"directoryID", "externalID", "appID"},
+			validKeys: []string{"directory_id", "external_id", "app_id"},
 			expectErr: false,
 		},
 		{
@@ -325,7 +325,7 @@ func (s *UserTestSuite) TestUserQueryValidation() {
 			query: UserQuery{
 				UserIDs: strPtr("1,2,3,4,5"),
 			},
-			validKeys: []string{"userIDs"},
+			validKeys: []string{"user_ids"},
 			expectErr: false,
 		},
 		{
@@ -335,7 +335,7 @@ func (s *UserTestSuite) TestUserQueryValidation() {
 				ExternalID:  strPtr(""),
 				AppID:       strPtr(""),
 			},
-			validKeys: []string{"directoryID", "externalID", "appID"},
+			validKeys: []string{"directory_id", "external_id", "app_id"},
 			expectErr: true,
 		},
 	}
@@ -384,7 +384,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 				"app_id": "54321",
 				"user_ids": "1,2,3,4,5"
 			}`,
-			fieldsToCheck: []string{"directoryID", "externalID", "appID", "userIDs"},
+			fieldsToCheck: []string{"directory_id", "external_id", "app_id", "user_ids"},
 			wantErr:       false,
 		},
 		{
@@ -399,7 +399,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 				"external_id": "9223372036854775806",
 				"app_id": "9223372036854775805"
 			}`,
-			fieldsToCheck: []string{"directoryID", "externalID", "appID"},
+			fieldsToCheck: []string{"directory_id", "external_id", "app_id"},
 			wantErr:       false,
 		},
 		{
@@ -414,7 +414,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 				"external_id": "ext-456",
 				"app_id": "app-789"
 			}`,
-			fieldsToCheck: []string{"directoryID", "externalID", "appID"},
+			fieldsToCheck: []string{"directory_id", "external_id", "app_id"},
 			wantErr:       true, // Changed to true since these are not valid numeric strings
 		},
 		{
@@ -425,7 +425,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 			expected: `{
 				"user_ids": "1,abc,3,def-456,5"
 			}`,
-			fieldsToCheck: []string{"userIDs"},
+			fieldsToCheck: []string{"user_ids"},
 			wantErr:       false, // UserIDs can contain non-numeric values
 		},
 		{
@@ -442,7 +442,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 				"app_id": "",
 				"user_ids": ""
 			}`,
-			fieldsToCheck: []string{"directoryID", "externalID", "appID", "userIDs"},
+			fieldsToCheck: []string{"directory_id", "external_id", "app_id", "user_ids"},
 			wantErr:       true,
 		},
 		{
@@ -459,7 +459,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 				"app_id": "\n",
 				"user_ids": " , , "
 			}`,
-			fieldsToCheck: []string{"directoryID", "externalID", "appID", "userIDs"},
+			fieldsToCheck: []string{"directory_id", "external_id", "app_id", "user_ids"},
 			wantErr:       true,
 		},
 		{
@@ -474,7 +474,7 @@ func (s *UserTestSuite) TestUserQueryStringConversions() {
 				"external_id": "-9223372036854775809",
 				"app_id": "123.456"
 			}`,
-			fieldsToCheck: []string{"directoryID", "externalID", "appID"},
+			fieldsToCheck: []string{"directory_id", "external_id", "app_id"},
 			wantErr:       true,
 		},
 	}
@@ -548,27 +548,27 @@ func getFieldValue(query *UserQuery, fieldName string) interface{} {
 		return query.Lastname
 	case "samaccountname":
 		return query.Samaccountname
-	case "directoryID":
+	case "directory_id":
 		return query.DirectoryID
-	case "externalID":
+	case "external_id":
 		return query.ExternalID
-	case "appID":
+	case "app_id":
 		return query.AppID
-	case "userIDs":
+	case "user_ids":
 		return query.UserIDs
 	case "fields":
 		return query.Fields
-	case "createdSince":
+	case "created_since":
 		return query.CreatedSince
-	case "createdUntil":
+	case "created_until":
 		return query.CreatedUntil
-	case "updatedSince":
+	case "updated_since":
 		return query.UpdatedSince
-	case "updatedUntil":
+	case "updated_until":
 		return query.UpdatedUntil
-	case "lastLoginSince":
+	case "last_login_since":
 		return query.LastLoginSince
-	case "lastLoginUntil":
+	case "last_login_until":
 		return query.LastLoginUntil
 	case "limit":
 		return query.Limit
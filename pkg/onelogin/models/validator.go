@@ -0,0 +1,280 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Validate checks v against the `validate` tags of its fields and returns an *olerror.ValidationError
+// listing every field that failed, or nil. Values other than structs and pointers to structs are
+// always valid.
+//
+// The tag holds comma-separated rules. Apart from required, rules are only checked when the field is
+// set, i.e. it is a non-nil pointer or a non-zero value:
+//
+//	required       the field must be set
+//	notblank       strings must not be empty or whitespace
+//	numeric        strings must be an integer
+//	list           strings must be a comma-separated list of non-empty values
+//	email          strings must look like an email address
+//	oneof=a b c    the value must be one of the space-separated options
+//	min=n, max=n   numbers must be within the bound
+//	eqfield=Name   the value must equal the named field of the same struct
+//
+// Nested structs and slices of structs are validated as well, and embedded structs such as
+// BaseQueryRequest are flattened. Fields are reported by their json name.
+func Validate(v interface{}) error {
+	var fields []olerror.FieldError
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	validateStruct(rv, "", &fields)
+	if len(fields) > 0 {
+		return olerror.NewValidationError(fields)
+	}
+	return nil
+}
+
+// ValidateQuery validates query like Validate and additionally runs the validators returned by its
+// GetKeyValidators method against every set parameter, keyed by the parameter's json name.
+func ValidateQuery(query Queryable) error {
+	if query == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(query)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+
+	var fields []olerror.FieldError
+	if err := Validate(query); err != nil {
+		fields = append(fields, err.(*olerror.ValidationError).Fields...)
+	}
+
+	failed := map[string]bool{}
+	for _, fe := range fields {
+		failed[fe.Field] = true
+	}
+	validators := query.GetKeyValidators()
+	for _, param := range queryParams(reflect.Indirect(rv)) {
+		validator, ok := validators[param.name]
+		if !ok || failed[param.name] || !param.value.CanInterface() {
+			continue
+		}
+		if !validator(param.value.Interface()) {
+			fields = append(fields, olerror.FieldError{Field: param.name, Message: "is not valid"})
+		}
+	}
+
+	if len(fields) > 0 {
+		return olerror.NewValidationError(fields)
+	}
+	return nil
+}
+
+type queryParam struct {
+	name  string
+	value reflect.Value
+}
+
+// queryParams returns the set fields of a query struct, including those of embedded structs.
+func queryParams(v reflect.Value) []queryParam {
+	var params []queryParam
+	if v.Kind() != reflect.Struct {
+		return params
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		name := jsonName(field)
+		if field.Anonymous && fv.Kind() == reflect.Struct && name == field.Name {
+			params = append(params, queryParams(fv)...)
+			continue
+		}
+		if name == "-" || !field.IsExported() || !isSet(fv) {
+			continue
+		}
+		params = append(params, queryParam{name: name, value: fv})
+	}
+	return params
+}
+
+func validateStruct(v reflect.Value, prefix string, fields *[]olerror.FieldError) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		name := jsonName(field)
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && indirect(fv).Kind() == reflect.Struct && name == field.Name {
+			if ev := indirect(fv); ev.IsValid() {
+				validateStruct(ev, prefix, fields)
+			}
+			continue
+		}
+
+		path := prefix + name
+		if tag := field.Tag.Get("validate"); tag != "" {
+			for _, rule := range strings.Split(tag, ",") {
+				if msg := checkRule(rule, fv, v); msg != "" {
+					*fields = append(*fields, olerror.FieldError{Field: path, Message: msg})
+					break
+				}
+			}
+		}
+
+		switch ev := indirect(fv); {
+		case !ev.IsValid():
+		case ev.Kind() == reflect.Struct && ev.Type() != timeType:
+			validateStruct(ev, path+".", fields)
+		case ev.Kind() == reflect.Slice || ev.Kind() == reflect.Array:
+			for j := 0; j < ev.Len(); j++ {
+				if item := indirect(ev.Index(j)); item.IsValid() && item.Kind() == reflect.Struct && item.Type() != timeType {
+					validateStruct(item, fmt.Sprintf("%s[%d].", path, j), fields)
+				}
+			}
+		}
+	}
+}
+
+// checkRule returns a description of why fv breaks rule, or "" when it doesn't.
+func checkRule(rule string, fv, parent reflect.Value) string {
+	name, arg := rule, ""
+	if i := strings.Index(rule, "="); i >= 0 {
+		name, arg = rule[:i], rule[i+1:]
+	}
+
+	if name == "required" {
+		if !isSet(fv) || (indirect(fv).Kind() == reflect.String && strings.TrimSpace(indirect(fv).String()) == "") {
+			return "is required"
+		}
+		return ""
+	}
+	if !isSet(fv) {
+		return ""
+	}
+	v := indirect(fv)
+	if !v.CanInterface() {
+		return ""
+	}
+
+	switch name {
+	case "notblank":
+		if v.Kind() == reflect.String && strings.TrimSpace(v.String()) == "" {
+			return "must not be blank"
+		}
+	case "numeric":
+		if v.Kind() == reflect.String {
+			if _, err := strconv.ParseInt(strings.TrimSpace(v.String()), 10, 64); err != nil {
+				return "must be a number"
+			}
+		}
+	case "list":
+		if v.Kind() == reflect.String {
+			for _, item := range strings.Split(v.String(), ",") {
+				if strings.TrimSpace(item) == "" {
+					return "must be a comma-separated list of non-empty values"
+				}
+			}
+		}
+	case "email":
+		s := v.String()
+		at := strings.LastIndex(s, "@")
+		if v.Kind() == reflect.String && (at <= 0 || at == len(s)-1 || strings.ContainsAny(s, " \t")) {
+			return "must be an email address"
+		}
+	case "oneof":
+		options := strings.Fields(arg)
+		s := fmt.Sprint(v.Interface())
+		for _, option := range options {
+			if s == option {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %s", strings.Join(options, ", "))
+	case "min", "max":
+		bound, err := strconv.ParseFloat(arg, 64)
+		n, ok := number(v)
+		if err != nil || !ok {
+			return ""
+		}
+		if name == "min" && n < bound {
+			return fmt.Sprintf("must be at least %s", arg)
+		}
+		if name == "max" && n > bound {
+			return fmt.Sprintf("must be at most %s", arg)
+		}
+	case "eqfield":
+		other := indirect(parent.FieldByName(arg))
+		if !other.IsValid() || !other.CanInterface() || !reflect.DeepEqual(v.Interface(), other.Interface()) {
+			if f, ok := parent.Type().FieldByName(arg); ok {
+				return fmt.Sprintf("must match %s", jsonName(f))
+			}
+			return fmt.Sprintf("must match %s", arg)
+		}
+	}
+	return ""
+}
+
+// jsonName returns the name a struct field is encoded with, or the Go field name when it has no json tag.
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// isSet reports whether a field holds a value: a non-nil pointer, interface, slice or map, or a non-zero value.
+func isSet(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return !v.IsNil()
+	default:
+		return !v.IsZero()
+	}
+}
+
+// indirect follows pointers and interfaces, returning the zero Value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func number(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
@@ -1,10 +1,12 @@
 package onelogin
 
 import (
+	"context"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
 	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
-	"time"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+	"net/http"
 )
 
 // OneloginSDK represents the Onelogin SDK.
@@ -16,136 +18,302 @@ type OneloginSDK struct {
 type IOneLoginSDK interface {
 	GetToken() (string, error)
 	GetAccountId() string
+	Close(ctx context.Context) error
 	GenerateInviteLink(email string) (interface{}, error)
+	GenerateInviteLinkWithContext(ctx context.Context, email string) (interface{}, error)
 	ListConnectors() (interface{}, error)
+	ListConnectorsWithContext(ctx context.Context) (interface{}, error)
 	SendInviteLink(email string) (interface{}, error)
+	SendInviteLinkWithContext(ctx context.Context, email string) (interface{}, error)
+	Do(ctx context.Context, method, path string, query, body, out interface{}, opts ...RequestOption) (*mod.ResponseMetadata, error)
 
 	// API Authorizations
 	CreateAuthServer(authServer *mod.AuthServer) (interface{}, error)
+	CreateAuthServerWithContext(ctx context.Context, authServer *mod.AuthServer) (interface{}, error)
+	CreateAuthServerTyped(authServer *mod.AuthServer) (*mod.Response[mod.AuthServer], error)
+	CreateAuthServerTypedWithContext(ctx context.Context, authServer *mod.AuthServer) (*mod.Response[mod.AuthServer], error)
 	GetAuthServers(queryParams mod.Queryable) (interface{}, error)
+	GetAuthServersWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error)
+	GetAuthServersTyped(queryParams mod.Queryable) (*mod.Response[[]mod.AuthServer], error)
+	GetAuthServersTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.AuthServer], error)
 	GetAuthServerByID(id int, queryParams mod.Queryable) (interface{}, error)
+	GetAuthServerByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
 	UpdateAuthServer(id int, authServer mod.AuthServer) (interface{}, error)
+	UpdateAuthServerWithContext(ctx context.Context, id int, authServer mod.AuthServer) (interface{}, error)
 	DeleteAuthServer(id int) (interface{}, error)
+	DeleteAuthServerWithContext(ctx context.Context, id int) (interface{}, error)
 	CreateAuthServerClaim(id int, claim mod.AccessTokenClaim) (interface{}, error)
+	CreateAuthServerClaimWithContext(ctx context.Context, id int, claim mod.AccessTokenClaim) (interface{}, error)
 	DeleteAuthClaim(id, claimID int) (interface{}, error)
+	DeleteAuthClaimWithContext(ctx context.Context, id, claimID int) (interface{}, error)
 	GetAuthClaims(id int, queryParams mod.Queryable) (interface{}, error)
+	GetAuthClaimsWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
 	UpdateClaim(id, claimID int, claim mod.AccessTokenClaim) (interface{}, error)
+	UpdateClaimWithContext(ctx context.Context, id, claimID int, claim mod.AccessTokenClaim) (interface{}, error)
 	CreateAuthServerScope(id int, scope mod.Scope) (interface{}, error)
+	CreateAuthServerScopeWithContext(ctx context.Context, id int, scope mod.Scope) (interface{}, error)
 	DeleteAuthServerScope(id, scopeID int) (interface{}, error)
+	DeleteAuthServerScopeWithContext(ctx context.Context, id, scopeID int) (interface{}, error)
 	GetAuthServerScopes(id int, queryParams mod.Queryable) (interface{}, error)
+	GetAuthServerScopesWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
 	UpdateAuthServerScope(id, scopeID int, scope mod.Scope) (interface{}, error)
+	UpdateAuthServerScopeWithContext(ctx context.Context, id, scopeID int, scope mod.Scope) (interface{}, error)
 	CreateClientApp(id int, clientApp mod.ClientApp) (interface{}, error)
+	CreateClientAppWithContext(ctx context.Context, id int, clientApp mod.ClientApp) (interface{}, error)
 	GetClientApps(id int) (interface{}, error)
+	GetClientAppsWithContext(ctx context.Context, id int) (interface{}, error)
 	DeleteClientApp(id, clientID int) (interface{}, error)
+	DeleteClientAppWithContext(ctx context.Context, id, clientID int) (interface{}, error)
 	UpdateClientApp(id, clientID int, clientApp mod.ClientApp) (interface{}, error)
+	UpdateClientAppWithContext(ctx context.Context, id, clientID int, clientApp mod.ClientApp) (interface{}, error)
 
 	// Apps
 	CreateApp(app mod.App) (interface{}, error)
+	CreateAppWithContext(ctx context.Context, app mod.App) (interface{}, error)
+	CreateAppTyped(app mod.App) (*mod.Response[mod.App], error)
+	CreateAppTypedWithContext(ctx context.Context, app mod.App) (*mod.Response[mod.App], error)
 	GetApps(queryParams mod.Queryable) (interface{}, error)
+	GetAppsWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error)
+	GetAppsTyped(queryParams mod.Queryable) (*mod.Response[[]mod.App], error)
+	GetAppsTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.App], error)
 	GetAppByID(id int, queryParams mod.Queryable) (interface{}, error)
+	GetAppByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
+	GetAppByIDTyped(id int, queryParams mod.Queryable) (*mod.Response[mod.App], error)
+	GetAppByIDTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[mod.App], error)
 	UpdateApp(id int, app mod.App) (interface{}, error)
+	UpdateAppWithContext(ctx context.Context, id int, app mod.App) (interface{}, error)
+	UpdateAppTyped(id int, app mod.App) (*mod.Response[mod.App], error)
+	UpdateAppTypedWithContext(ctx context.Context, id int, app mod.App) (*mod.Response[mod.App], error)
 	DeleteApp(id int) (interface{}, error)
+	DeleteAppWithContext(ctx context.Context, id int) (interface{}, error)
 	CreateAppRule(id int, appRule mod.AppRule) (interface{}, error)
+	CreateAppRuleWithContext(ctx context.Context, id int, appRule mod.AppRule) (interface{}, error)
 	GetAppRules(id int, queryParams mod.Queryable) (interface{}, error)
+	GetAppRulesWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
 	GetAppRuleByID(id, ruleID int, queryParams mod.Queryable) (interface{}, error)
+	GetAppRuleByIDWithContext(ctx context.Context, id, ruleID int, queryParams mod.Queryable) (interface{}, error)
 	UpdateAppRule(id, ruleID int, appRule mod.AppRule, queryParams map[string]string) (interface{}, error)
+	UpdateAppRuleWithContext(ctx context.Context, id, ruleID int, appRule mod.AppRule, queryParams map[string]string) (interface{}, error)
 	DeleteAppRule(id, ruleID int, queryParams map[string]string) (interface{}, error)
+	DeleteAppRuleWithContext(ctx context.Context, id, ruleID int, queryParams map[string]string) (interface{}, error)
 	GetAppUsers(appID int) (interface{}, error)
+	GetAppUsersWithContext(ctx context.Context, appID int) (interface{}, error)
 
 	// Groups
 	GetGroupByID(groupID int) (interface{}, error)
+	GetGroupByIDWithContext(ctx context.Context, groupID int) (interface{}, error)
+	GetGroupByIDTyped(groupID int) (*mod.Response[mod.Group], error)
+	GetGroupByIDTypedWithContext(ctx context.Context, groupID int) (*mod.Response[mod.Group], error)
 	GetGroups(queryParams mod.Queryable) (interface{}, error)
+	GetGroupsWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error)
+	GetGroupsTyped(queryParams mod.Queryable) (*mod.Response[[]mod.Group], error)
+	GetGroupsTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.Group], error)
 
 	// MFAs
 	GetAvailableMFAFactors(userID int) (interface{}, error)
+	GetAvailableMFAFactorsWithContext(ctx context.Context, userID int) (interface{}, error)
 	EnrollMFAFactor(factor mod.EnrollFactorRequest, userID int) (interface{}, error)
+	EnrollMFAFactorWithContext(ctx context.Context, factor mod.EnrollFactorRequest, userID int) (interface{}, error)
 	VerifyMFAEnrollment(userID, registrationID, otp int) (interface{}, error)
+	VerifyMFAEnrollmentWithContext(ctx context.Context, userID, registrationID, otp int) (interface{}, error)
 	ActivateMFAFactor(userID int, request mod.ActivateFactorRequest) (interface{}, error)
+	ActivateMFAFactorWithContext(ctx context.Context, userID int, request mod.ActivateFactorRequest) (interface{}, error)
 	RemoveMFAFactor(userID, deviceID int) (interface{}, error)
+	RemoveMFAFactorWithContext(ctx context.Context, userID, deviceID int) (interface{}, error)
 	GetEnrolledMFAFactors(userID int) (interface{}, error)
+	GetEnrolledMFAFactorsWithContext(ctx context.Context, userID int) (interface{}, error)
 	GenerateMFAToken(userID int, request mod.GenerateMFATokenRequest) (interface{}, error)
+	GenerateMFATokenWithContext(ctx context.Context, userID int, request mod.GenerateMFATokenRequest) (interface{}, error)
 
 	// Privileges
 	ListPrivileges(queryParams mod.Queryable) (interface{}, error)
+	ListPrivilegesWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error)
+	ListPrivilegesTyped(queryParams mod.Queryable) (*mod.Response[[]mod.Privilege], error)
+	ListPrivilegesTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.Privilege], error)
 	CreatePrivilege(privilege mod.Privilege) (interface{}, error)
+	CreatePrivilegeWithContext(ctx context.Context, privilege mod.Privilege) (interface{}, error)
 	GetPrivilege(privilegeID string) (interface{}, error)
+	GetPrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error)
+	GetPrivilegeTyped(privilegeID string) (*mod.Response[mod.Privilege], error)
+	GetPrivilegeTypedWithContext(ctx context.Context, privilegeID string) (*mod.Response[mod.Privilege], error)
 	DeletePrivilege(privilegeID string) (interface{}, error)
+	DeletePrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error)
 	UpdatePrivilege(privilegeID string) (interface{}, error)
+	UpdatePrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error)
 	GetPrivilegeUsers(privilegeID string) (interface{}, error)
+	GetPrivilegeUsersWithContext(ctx context.Context, privilegeID string) (interface{}, error)
 	AssignUsersToPrivilege(privilegeID string, userIds []int) (interface{}, error)
+	AssignUsersToPrivilegeWithContext(ctx context.Context, privilegeID string, userIds []int) (interface{}, error)
 	RemovePrivilegeFromUser(privilegeID string, userID int) (interface{}, error)
+	RemovePrivilegeFromUserWithContext(ctx context.Context, privilegeID string, userID int) (interface{}, error)
 	GetPrivilegeRoles(privilegeID string) (interface{}, error)
+	GetPrivilegeRolesWithContext(ctx context.Context, privilegeID string) (interface{}, error)
 	AddPrivilegeToRole(privilegeID string, roleID int) (interface{}, error)
+	AddPrivilegeToRoleWithContext(ctx context.Context, privilegeID string, roleID int) (interface{}, error)
 	DeleteRoleFromPrivilege(privilegeID string, roleID int) (interface{}, error)
+	DeleteRoleFromPrivilegeWithContext(ctx context.Context, privilegeID string, roleID int) (interface{}, error)
 
 	// Roles
 	CreateRole(role *mod.Role) (interface{}, error)
+	CreateRoleWithContext(ctx context.Context, role *mod.Role) (interface{}, error)
+	CreateRoleTyped(role *mod.Role) (*mod.Response[mod.Role], error)
+	CreateRoleTypedWithContext(ctx context.Context, role *mod.Role) (*mod.Response[mod.Role], error)
 	GetRoles(queryParams mod.Queryable) (interface{}, error)
+	GetRolesWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error)
+	GetRolesTyped(queryParams mod.Queryable) (*mod.Response[[]mod.Role], error)
+	GetRolesTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.Role], error)
 	GetRoleByID(id int, queryParams mod.Queryable) (interface{}, error)
+	GetRoleByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
+	GetRoleByIDTyped(id int, queryParams mod.Queryable) (*mod.Response[mod.Role], error)
+	GetRoleByIDTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[mod.Role], error)
 	UpdateRole(id int, role mod.Role, queryParams map[string]string) (interface{}, error)
+	UpdateRoleWithContext(ctx context.Context, id int, role mod.Role, queryParams map[string]string) (interface{}, error)
 	DeleteRole(id int, queryParams map[string]string) (interface{}, error)
+	DeleteRoleWithContext(ctx context.Context, id int, queryParams map[string]string) (interface{}, error)
 	GetRoleUsers(roleID int, queryParams mod.Queryable) (interface{}, error)
+	GetRoleUsersWithContext(ctx context.Context, roleID int, queryParams mod.Queryable) (interface{}, error)
 	AddRoleUsers(roleID int, users []int) (interface{}, error)
+	AddRoleUsersWithContext(ctx context.Context, roleID int, users []int) (interface{}, error)
 	DeleteRoleUsers(roleID int, users []int) (interface{}, error)
+	DeleteRoleUsersWithContext(ctx context.Context, roleID int, users []int) (interface{}, error)
 	GetRoleAdmins(roleID int) (interface{}, error)
+	GetRoleAdminsWithContext(ctx context.Context, roleID int) (interface{}, error)
 	AddRoleAdmins(roleID int) (interface{}, error)
+	AddRoleAdminsWithContext(ctx context.Context, roleID int) (interface{}, error)
 	DeleteRoleAdmins(roleID int, admins []int) (interface{}, error)
+	DeleteRoleAdminsWithContext(ctx context.Context, roleID int, admins []int) (interface{}, error)
 	GetRoleApps(roleID int) (interface{}, error)
+	GetRoleAppsWithContext(ctx context.Context, roleID int) (interface{}, error)
 	UpdateRoleApps(roleID int, apps []int) (interface{}, error)
+	UpdateRoleAppsWithContext(ctx context.Context, roleID int, apps []int) (interface{}, error)
 
 	// SAML
 	VerifyFactorSAML(request mod.VerifyMFATokenRequest) (interface{}, error)
+	VerifyFactorSAMLWithContext(ctx context.Context, request mod.VerifyMFATokenRequest) (interface{}, error)
 	GenerateSAMLAssertion(request mod.GenerateSAMLTokenRequest) (interface{}, error)
+	GenerateSAMLAssertionWithContext(ctx context.Context, request mod.GenerateSAMLTokenRequest) (interface{}, error)
 
 	// Smart Hooks
 	CreateHook(hook mod.SmartHook) (interface{}, error)
+	CreateHookWithContext(ctx context.Context, hook mod.SmartHook) (interface{}, error)
+	CreateHookTyped(hook mod.SmartHook) (*mod.Response[mod.SmartHook], error)
+	CreateHookTypedWithContext(ctx context.Context, hook mod.SmartHook) (*mod.Response[mod.SmartHook], error)
 	DeleteHook(hookID int) (interface{}, error)
+	DeleteHookWithContext(ctx context.Context, hookID int) (interface{}, error)
 	GetHook(hookID int, query mod.Queryable) (interface{}, error)
+	GetHookWithContext(ctx context.Context, hookID int, query mod.Queryable) (interface{}, error)
+	GetHookTyped(hookID int, query mod.Queryable) (*mod.Response[mod.SmartHook], error)
+	GetHookTypedWithContext(ctx context.Context, hookID int, query mod.Queryable) (*mod.Response[mod.SmartHook], error)
 	ListHooks(query mod.Queryable) (interface{}, error)
+	ListHooksWithContext(ctx context.Context, query mod.Queryable) (interface{}, error)
+	ListHooksTyped(query mod.Queryable) (*mod.Response[[]mod.SmartHook], error)
+	ListHooksTypedWithContext(ctx context.Context, query mod.Queryable) (*mod.Response[[]mod.SmartHook], error)
 	UpdateSmartHook(hookID int, hook mod.SmartHook) (interface{}, error)
+	UpdateSmartHookWithContext(ctx context.Context, hookID int, hook mod.SmartHook) (interface{}, error)
 	ListEnvironmentVariables() (interface{}, error)
+	ListEnvironmentVariablesWithContext(ctx context.Context) (interface{}, error)
 	CreateEnvironmentVariable(name, value string) (interface{}, error)
+	CreateEnvironmentVariableWithContext(ctx context.Context, name, value string) (interface{}, error)
 	GetEnvironmentVariable(envVarID int) (interface{}, error)
+	GetEnvironmentVariableWithContext(ctx context.Context, envVarID int) (interface{}, error)
 	UpdateEnvironmentVariable(envVarID int, name, value string) (interface{}, error)
+	UpdateEnvironmentVariableWithContext(ctx context.Context, envVarID int, name, value string) (interface{}, error)
 	DeleteEnvironmentVariable(envVarID int) (interface{}, error)
+	DeleteEnvironmentVariableWithContext(ctx context.Context, envVarID int) (interface{}, error)
 	GetHookLogs(hookID int, query mod.Queryable) (interface{}, error)
+	GetHookLogsWithContext(ctx context.Context, hookID int, query mod.Queryable) (interface{}, error)
 
 	// User Mappings
 	ListMappings() (interface{}, error)
+	ListMappingsWithContext(ctx context.Context) (interface{}, error)
+	ListMappingsTyped() (*mod.Response[[]mod.UserMapping], error)
+	ListMappingsTypedWithContext(ctx context.Context) (*mod.Response[[]mod.UserMapping], error)
 	CreateMapping(mapping mod.UserMapping) (interface{}, error)
+	CreateMappingWithContext(ctx context.Context, mapping mod.UserMapping) (interface{}, error)
+	CreateMappingTyped(mapping mod.UserMapping) (*mod.Response[mod.UserMapping], error)
+	CreateMappingTypedWithContext(ctx context.Context, mapping mod.UserMapping) (*mod.Response[mod.UserMapping], error)
 	DeleteMapping(mappingID int) (interface{}, error)
+	DeleteMappingWithContext(ctx context.Context, mappingID int) (interface{}, error)
 	GetMapping(mappingID int) (interface{}, error)
+	GetMappingWithContext(ctx context.Context, mappingID int) (interface{}, error)
+	GetMappingTyped(mappingID int) (*mod.Response[mod.UserMapping], error)
+	GetMappingTypedWithContext(ctx context.Context, mappingID int) (*mod.Response[mod.UserMapping], error)
 	ListActions() (interface{}, error)
+	ListActionsWithContext(ctx context.Context) (interface{}, error)
 	UpdateMapping(mappingID int) (interface{}, error)
+	UpdateMappingWithContext(ctx context.Context, mappingID int) (interface{}, error)
 	BulkSortMappings(mappingIDs []int) (interface{}, error)
+	BulkSortMappingsWithContext(ctx context.Context, mappingIDs []int) (interface{}, error)
 	ListActionValues(actionValue string) (interface{}, error)
+	ListActionValuesWithContext(ctx context.Context, actionValue string) (interface{}, error)
 	ListConditionValues(conditionValue string) (interface{}, error)
+	ListConditionValuesWithContext(ctx context.Context, conditionValue string) (interface{}, error)
 	ListConditionOperators(conditionValue string) (interface{}, error)
+	ListConditionOperatorsWithContext(ctx context.Context, conditionValue string) (interface{}, error)
 	DryrunMapping(mappingID int) (interface{}, error)
+	DryrunMappingWithContext(ctx context.Context, mappingID int) (interface{}, error)
 	ListConditions() (interface{}, error)
+	ListConditionsWithContext(ctx context.Context) (interface{}, error)
 
 	// Users
 	CreateUser(user mod.User) (interface{}, error)
+	CreateUserWithContext(ctx context.Context, user mod.User) (interface{}, error)
+	CreateUserTyped(user mod.User) (*mod.Response[mod.User], error)
+	CreateUserTypedWithContext(ctx context.Context, user mod.User) (*mod.Response[mod.User], error)
 	GetUsers(query mod.Queryable) (interface{}, error)
+	GetUsersWithContext(ctx context.Context, query mod.Queryable) (interface{}, error)
+	GetUsersTyped(query mod.Queryable) (*mod.Response[[]mod.User], error)
+	GetUsersTypedWithContext(ctx context.Context, query mod.Queryable) (*mod.Response[[]mod.User], error)
+	StreamUsers(query mod.Queryable, fn func(mod.User) error) error
+	StreamUsersWithContext(ctx context.Context, query mod.Queryable, fn func(mod.User) error) error
 	GetUserByID(id int, queryParams mod.Queryable) (interface{}, error)
+	GetUserByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
+	GetUserByIDTyped(id int, queryParams mod.Queryable) (*mod.Response[mod.User], error)
+	GetUserByIDTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[mod.User], error)
 	GetUserApps(id int, queryParams mod.Queryable) (interface{}, error)
+	GetUserAppsWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error)
+	GetUserAppsTyped(id int, queryParams mod.Queryable) (*mod.Response[[]mod.UserApp], error)
+	GetUserAppsTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[[]mod.UserApp], error)
 	UpdateUser(id int, user mod.User) (interface{}, error)
+	UpdateUserWithContext(ctx context.Context, id int, user mod.User) (interface{}, error)
+	UpdateUserTyped(id int, user mod.User) (*mod.Response[mod.User], error)
+	UpdateUserTypedWithContext(ctx context.Context, id int, user mod.User) (*mod.Response[mod.User], error)
 	DeleteUser(id int) (interface{}, error)
+	DeleteUserWithContext(ctx context.Context, id int) (interface{}, error)
 	UpdatePasswordSecure(id int) (interface{}, error)
+	UpdatePasswordSecureWithContext(ctx context.Context, id int) (interface{}, error)
 	UpdatePasswordInsecure(id int) (interface{}, error)
+	UpdatePasswordInsecureWithContext(ctx context.Context, id int) (interface{}, error)
 	LockUserAccount(id int) (interface{}, error)
+	LockUserAccountWithContext(ctx context.Context, id int) (interface{}, error)
 	GetUserRoles(id int) (interface{}, error)
+	GetUserRolesWithContext(ctx context.Context, id int) (interface{}, error)
 	LogOutUser(userID int) (interface{}, error)
+	LogOutUserWithContext(ctx context.Context, userID int) (interface{}, error)
 	AssignRolesToUser(userID int, roles []int) (interface{}, error)
+	AssignRolesToUserWithContext(ctx context.Context, userID int, roles []int) (interface{}, error)
 	SetUserState(userID, state int) (interface{}, error)
+	SetUserStateWithContext(ctx context.Context, userID, state int) (interface{}, error)
 	RemoveUserRole(userID int) (interface{}, error)
+	RemoveUserRoleWithContext(ctx context.Context, userID int) (interface{}, error)
 	GetCustomAttributes() (interface{}, error)
+	GetCustomAttributesWithContext(ctx context.Context) (interface{}, error)
 	SetCustomAttributes(userID int, attr interface{}) (interface{}, error)
+	SetCustomAttributesWithContext(ctx context.Context, userID int, attr interface{}) (interface{}, error)
+}
+
+// decodeResponse checks the result of an IClient call and decodes the response body into a typed response.
+func decodeResponse[T any](resp *http.Response, err error) (*mod.Response[T], error) {
+	if err != nil {
+		return nil, err
+	}
+	return utl.DecodeResponse[T](resp)
 }
 
 // NewOneloginSDK creates a new instance of the Onelogin SDK.
-func NewOneloginSDK(credentials *mod.APICredentials, timeoutOverride *time.Duration) (*OneloginSDK, error) {
-	client, err := api.NewClient(credentials, timeoutOverride)
+// Settings that are not provided through opts fall back to the ONELOGIN_* environment variables.
+func NewOneloginSDK(opts ...Option) (*OneloginSDK, error) {
+	client, err := api.NewClient(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -166,9 +334,22 @@ func (sdk *OneloginSDK) GetAccountId() string {
 	return sdk.Client.GetAccountId()
 }
 
+// Close revokes the SDK's access token and releases idle connections. Call it on shutdown so the token
+// can't be used after the process exits.
+func (sdk *OneloginSDK) Close(ctx context.Context) error {
+	return sdk.Client.Close(ctx)
+}
+
 func (sdk *OneloginSDK) GenerateInviteLink(email string) (interface{}, error) {
-	p := "api/1/invites/get_invite_link"
-	resp, err := sdk.Client.Post(&p, email)
+	return sdk.GenerateInviteLinkWithContext(context.Background(), email)
+}
+
+func (sdk *OneloginSDK) GenerateInviteLinkWithContext(ctx context.Context, email string) (interface{}, error) {
+	p, err := utl.BuildAPIPath("api/1/invites", "get_invite_link")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := sdk.Client.PostWithContext(ctx, &p, email)
 	if err != nil {
 		return nil, err
 	}
@@ -176,8 +357,15 @@ func (sdk *OneloginSDK) GenerateInviteLink(email string) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) ListConnectors() (interface{}, error) {
-	p := "api/2/connectors"
-	resp, err := sdk.Client.Get(&p, nil)
+	return sdk.ListConnectorsWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) ListConnectorsWithContext(ctx context.Context) (interface{}, error) {
+	p, err := utl.BuildAPIPath("api/2/connectors")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -185,8 +373,15 @@ func (sdk *OneloginSDK) ListConnectors() (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) SendInviteLink(email string) (interface{}, error) {
-	p := "api/1/invites/send_invite_link"
-	resp, err := sdk.Client.Post(&p, email)
+	return sdk.SendInviteLinkWithContext(context.Background(), email)
+}
+
+func (sdk *OneloginSDK) SendInviteLinkWithContext(ctx context.Context, email string) (interface{}, error) {
+	p, err := utl.BuildAPIPath("api/1/invites", "send_invite_link")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := sdk.Client.PostWithContext(ctx, &p, email)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,26 @@
+package onelogin
+
+import "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+
+// Option configures the SDK created by NewOneloginSDK.
+type Option = api.Option
+
+// Options accepted by NewOneloginSDK. See the api package for details.
+var (
+	WithCredentials         = api.WithCredentials
+	WithCredentialsProvider = api.WithCredentialsProvider
+	WithTimeout             = api.WithTimeout
+	WithBaseURL             = api.WithBaseURL
+	WithRegion              = api.WithRegion
+	WithHTTPClient          = api.WithHTTPClient
+	WithTransport           = api.WithTransport
+	WithUserAgent           = api.WithUserAgent
+	WithLogger              = api.WithLogger
+	WithRetryPolicy         = api.WithRetryPolicy
+	WithRateLimiter         = api.WithRateLimiter
+	WithLazyAuth            = api.WithLazyAuth
+	WithMiddleware          = api.WithMiddleware
+	WithTokenStore          = api.WithTokenStore
+	WithProfile             = api.WithProfile
+	WithResponseCache       = api.WithResponseCache
+)
@@ -0,0 +1,165 @@
+package onelogin
+
+import (
+	"context"
+	"strconv"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// PageFunc fetches a single page of a list endpoint for the given query.
+// The typed SDK methods can be used directly, e.g. sdk.GetUsersTypedWithContext.
+type PageFunc[T any] func(ctx context.Context, query mod.Queryable) (*mod.Response[[]T], error)
+
+// RawPageFunc adapts a raw SDK list method, e.g. sdk.ListHooksWithContext, to a PageFunc.
+// Items are returned as decoded JSON values; bodies with a "data" section are unwrapped.
+func RawPageFunc(fetch func(ctx context.Context, query mod.Queryable) (interface{}, error)) PageFunc[interface{}] {
+	return func(ctx context.Context, query mod.Queryable) (*mod.Response[[]interface{}], error) {
+		result, err := fetch(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		raw, ok := result.(*mod.ResponseWithMetadata)
+		if !ok || raw == nil {
+			return nil, olerror.NewSDKError("unexpected response type for a list endpoint")
+		}
+		res := &mod.Response[[]interface{}]{Metadata: raw.Metadata}
+		switch data := raw.Data.(type) {
+		case []interface{}:
+			res.Data = data
+		case map[string]interface{}:
+			items, ok := data["data"].([]interface{})
+			if !ok {
+				return nil, olerror.NewSDKError("response does not contain a list of items")
+			}
+			res.Data = items
+		default:
+			return nil, olerror.NewSDKError("response does not contain a list of items")
+		}
+		return res, nil
+	}
+}
+
+// Paginator walks the pages of a list endpoint.
+// It follows the next cursor from the response headers or the body "pagination" section and
+// falls back to page numbers when the endpoint only reports Current-Page and Total-Pages.
+// The cursor is passed back through the query's SetCursor, so GroupQuery's after_cursor is honored.
+type Paginator[T any] struct {
+	fetch PageFunc[T]
+	query mod.Queryable
+
+	done   bool
+	cursor string
+	page   *mod.Response[[]T]
+	items  []T
+	index  int
+	err    error
+}
+
+// NewPaginator returns a paginator that fetches pages of query with fetch.
+// The query is modified as the paginator advances; a nil query fetches a single page.
+func NewPaginator[T any](query mod.Queryable, fetch PageFunc[T]) *Paginator[T] {
+	return &Paginator[T]{fetch: fetch, query: query}
+}
+
+// HasMorePages reports whether NextPage can return another page.
+func (p *Paginator[T]) HasMorePages() bool {
+	return !p.done && p.err == nil
+}
+
+// NextPage fetches the next page and returns its items.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if !p.HasMorePages() {
+		if p.err != nil {
+			return nil, p.err
+		}
+		return nil, olerror.NewSDKError("no more pages")
+	}
+
+	res, err := p.fetch(ctx, p.query)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+	p.page = res
+	p.advance(res)
+	return res.Data, nil
+}
+
+// advance prepares the query for the page after res, or marks the paginator done.
+func (p *Paginator[T]) advance(res *mod.Response[[]T]) {
+	cursor, more := advanceQuery(p.query, res.Metadata, len(res.Data), p.cursor)
+	p.cursor, p.done = cursor, !more
+}
+
+// advanceQuery prepares query for the page after one that returned count items with metadata md,
+// where cursor is the cursor that page was requested with. It returns the cursor of the next page
+// and whether there is one.
+func advanceQuery(query mod.Queryable, md mod.ResponseMetadata, count int, cursor string) (string, bool) {
+	switch {
+	case query == nil || count == 0:
+		return cursor, false
+	case md.NextCursor != "":
+		if md.NextCursor == cursor {
+			// the endpoint returned the same cursor twice; stop rather than loop forever
+			return cursor, false
+		}
+		query.SetCursor(md.NextCursor)
+		return md.NextCursor, true
+	case md.TotalPages > 0 && md.CurrentPage > 0 && md.CurrentPage < md.TotalPages:
+		query.SetPage(strconv.Itoa(md.CurrentPage + 1))
+		return cursor, true
+	default:
+		return cursor, false
+	}
+}
+
+// Metadata returns the metadata of the most recently fetched page.
+func (p *Paginator[T]) Metadata() mod.ResponseMetadata {
+	if p.page == nil {
+		return mod.ResponseMetadata{}
+	}
+	return p.page.Metadata
+}
+
+// Next advances to the next item, fetching pages as needed, and reports whether one is available.
+// Use Item to read it and Err to check for a failure once Next returns false.
+func (p *Paginator[T]) Next(ctx context.Context) bool {
+	for p.index >= len(p.items) {
+		if !p.HasMorePages() {
+			return false
+		}
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return false
+		}
+		p.items, p.index = items, 0
+	}
+	p.index++
+	return true
+}
+
+// Item returns the current item after a successful call to Next.
+func (p *Paginator[T]) Item() T {
+	return p.items[p.index-1]
+}
+
+// Err returns the error that stopped the paginator, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// CollectAll fetches every page of query with fetch and returns all items.
+func CollectAll[T any](ctx context.Context, query mod.Queryable, fetch PageFunc[T]) ([]T, error) {
+	var all []T
+	p := NewPaginator(query, fetch)
+	for p.HasMorePages() {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
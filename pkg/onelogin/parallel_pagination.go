@@ -0,0 +1,176 @@
+package onelogin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// DefaultParallelWorkers is the number of pages fetched at once when no worker count is given.
+const DefaultParallelWorkers = 4
+
+// PageResult is the outcome of fetching one page in FetchPagesParallel.
+type PageResult[T any] struct {
+	Page     int
+	Items    []T
+	Metadata mod.ResponseMetadata
+	Err      error
+}
+
+// PageError reports the failure of a single page.
+type PageError struct {
+	Page int
+	Err  error
+}
+
+func (e *PageError) Error() string {
+	return fmt.Sprintf("page %d: %v", e.Page, e.Err)
+}
+
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// PagesError lists the pages that failed in CollectAllParallel, in page order.
+type PagesError struct {
+	Pages []*PageError
+}
+
+func (e *PagesError) Error() string {
+	msgs := make([]string, len(e.Pages))
+	for i, p := range e.Pages {
+		msgs[i] = p.Error()
+	}
+	return fmt.Sprintf("%d pages failed: %s", len(e.Pages), strings.Join(msgs, "; "))
+}
+
+// FetchPagesParallel fetches the first page of query, then fetches the remaining pages reported by
+// the Total-Pages header with up to workers concurrent requests. Each request still goes through the
+// client's rate limiter, so the workers share the tenant's request budget.
+//
+// Results are returned in page order, and a failed page is reported in its PageResult.Err.
+// The returned error is only set when the first page cannot be fetched.
+// Endpoints that page with cursors instead of page numbers are fetched sequentially.
+func FetchPagesParallel[T any](ctx context.Context, query mod.Queryable, fetch PageFunc[T], workers int) ([]PageResult[T], error) {
+	if workers <= 0 {
+		workers = DefaultParallelWorkers
+	}
+	base, err := cloneQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err := fetch(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	current := first.Metadata.CurrentPage
+	if current <= 0 {
+		current = 1
+	}
+	results := []PageResult[T]{{Page: current, Items: first.Data, Metadata: first.Metadata}}
+
+	if first.Metadata.TotalPages == 0 && first.Metadata.NextCursor != "" {
+		return fetchPagesSequential(ctx, base, fetch, first, results), nil
+	}
+	if first.Metadata.TotalPages <= current {
+		return results, nil
+	}
+
+	remaining := make([]PageResult[T], first.Metadata.TotalPages-current)
+	pages := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(remaining); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pages {
+				remaining[page-current-1] = fetchPage(ctx, query, fetch, page)
+			}
+		}()
+	}
+	for page := current + 1; page <= first.Metadata.TotalPages; page++ {
+		pages <- page
+	}
+	close(pages)
+	wg.Wait()
+
+	return append(results, remaining...), nil
+}
+
+// CollectAllParallel fetches every page like FetchPagesParallel and returns the items in page order.
+// When some pages fail, the items of the other pages are returned together with a *PagesError.
+func CollectAllParallel[T any](ctx context.Context, query mod.Queryable, fetch PageFunc[T], workers int) ([]T, error) {
+	results, err := FetchPagesParallel(ctx, query, fetch, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []T
+	var failed []*PageError
+	for _, r := range results {
+		if r.Err != nil {
+			failed = append(failed, &PageError{Page: r.Page, Err: r.Err})
+			continue
+		}
+		all = append(all, r.Items...)
+	}
+	if len(failed) > 0 {
+		return all, &PagesError{Pages: failed}
+	}
+	return all, nil
+}
+
+func fetchPage[T any](ctx context.Context, query mod.Queryable, fetch PageFunc[T], page int) PageResult[T] {
+	result := PageResult[T]{Page: page}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	q, err := cloneQuery(query)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	q.SetPage(strconv.Itoa(page))
+
+	res, err := fetch(ctx, q)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Items = res.Data
+	result.Metadata = res.Metadata
+	return result
+}
+
+// fetchPagesSequential continues a cursor-paged endpoint after its first page.
+func fetchPagesSequential[T any](ctx context.Context, query mod.Queryable, fetch PageFunc[T], first *mod.Response[[]T], results []PageResult[T]) []PageResult[T] {
+	p := NewPaginator(query, fetch)
+	p.advance(first)
+	for page := results[0].Page + 1; p.HasMorePages(); page++ {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return append(results, PageResult[T]{Page: page, Err: err})
+		}
+		results = append(results, PageResult[T]{Page: page, Items: items, Metadata: p.Metadata()})
+	}
+	return results
+}
+
+// cloneQuery returns a shallow copy of query so that concurrent requests don't share its page field.
+func cloneQuery(query mod.Queryable) (mod.Queryable, error) {
+	v := reflect.ValueOf(query)
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, olerror.NewSDKError("parallel fetching requires a pointer to a query struct")
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	return c.Interface().(mod.Queryable), nil
+}
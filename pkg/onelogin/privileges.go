@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) ListPrivileges(queryParams models.Queryable) (interface{}, error) {
+	return sdk.ListPrivilegesWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) ListPrivilegesWithContext(ctx context.Context, queryParams models.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -22,11 +27,15 @@ func (sdk *OneloginSDK) ListPrivileges(queryParams models.Queryable) (interface{
 }
 
 func (sdk *OneloginSDK) CreatePrivilege(privilege models.Privilege) (interface{}, error) {
+	return sdk.CreatePrivilegeWithContext(context.Background(), privilege)
+}
+
+func (sdk *OneloginSDK) CreatePrivilegeWithContext(ctx context.Context, privilege models.Privilege) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, privilege)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, privilege)
 	if err != nil {
 		return nil, err
 	}
@@ -34,11 +43,15 @@ func (sdk *OneloginSDK) CreatePrivilege(privilege models.Privilege) (interface{}
 }
 
 func (sdk *OneloginSDK) GetPrivilege(privilegeID string) (interface{}, error) {
+	return sdk.GetPrivilegeWithContext(context.Background(), privilegeID)
+}
+
+func (sdk *OneloginSDK) GetPrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +59,15 @@ func (sdk *OneloginSDK) GetPrivilege(privilegeID string) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) DeletePrivilege(privilegeID string) (interface{}, error) {
+	return sdk.DeletePrivilegeWithContext(context.Background(), privilegeID)
+}
+
+func (sdk *OneloginSDK) DeletePrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -58,11 +75,15 @@ func (sdk *OneloginSDK) DeletePrivilege(privilegeID string) (interface{}, error)
 }
 
 func (sdk *OneloginSDK) UpdatePrivilege(privilegeID string) (interface{}, error) {
+	return sdk.UpdatePrivilegeWithContext(context.Background(), privilegeID)
+}
+
+func (sdk *OneloginSDK) UpdatePrivilegeWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +91,15 @@ func (sdk *OneloginSDK) UpdatePrivilege(privilegeID string) (interface{}, error)
 }
 
 func (sdk *OneloginSDK) GetPrivilegeUsers(privilegeID string) (interface{}, error) {
+	return sdk.GetPrivilegeUsersWithContext(context.Background(), privilegeID)
+}
+
+func (sdk *OneloginSDK) GetPrivilegeUsersWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID, "users")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,11 +107,15 @@ func (sdk *OneloginSDK) GetPrivilegeUsers(privilegeID string) (interface{}, erro
 }
 
 func (sdk *OneloginSDK) AssignUsersToPrivilege(privilegeID string, userIds []int) (interface{}, error) {
+	return sdk.AssignUsersToPrivilegeWithContext(context.Background(), privilegeID, userIds)
+}
+
+func (sdk *OneloginSDK) AssignUsersToPrivilegeWithContext(ctx context.Context, privilegeID string, userIds []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID, "users")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, map[string][]int{"users": userIds})
+	resp, err := sdk.Client.PostWithContext(ctx, &p, map[string][]int{"users": userIds})
 	if err != nil {
 		return nil, err
 	}
@@ -94,11 +123,15 @@ func (sdk *OneloginSDK) AssignUsersToPrivilege(privilegeID string, userIds []int
 }
 
 func (sdk *OneloginSDK) RemovePrivilegeFromUser(privilegeID string, userID int) (interface{}, error) {
+	return sdk.RemovePrivilegeFromUserWithContext(context.Background(), privilegeID, userID)
+}
+
+func (sdk *OneloginSDK) RemovePrivilegeFromUserWithContext(ctx context.Context, privilegeID string, userID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID, "users", userID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -106,11 +139,15 @@ func (sdk *OneloginSDK) RemovePrivilegeFromUser(privilegeID string, userID int)
 }
 
 func (sdk *OneloginSDK) GetPrivilegeRoles(privilegeID string) (interface{}, error) {
+	return sdk.GetPrivilegeRolesWithContext(context.Background(), privilegeID)
+}
+
+func (sdk *OneloginSDK) GetPrivilegeRolesWithContext(ctx context.Context, privilegeID string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID, "roles")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -118,11 +155,15 @@ func (sdk *OneloginSDK) GetPrivilegeRoles(privilegeID string) (interface{}, erro
 }
 
 func (sdk *OneloginSDK) AddPrivilegeToRole(privilegeID string, roleID int) (interface{}, error) {
+	return sdk.AddPrivilegeToRoleWithContext(context.Background(), privilegeID, roleID)
+}
+
+func (sdk *OneloginSDK) AddPrivilegeToRoleWithContext(ctx context.Context, privilegeID string, roleID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID, "roles", roleID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,13 +171,43 @@ func (sdk *OneloginSDK) AddPrivilegeToRole(privilegeID string, roleID int) (inte
 }
 
 func (sdk *OneloginSDK) DeleteRoleFromPrivilege(privilegeID string, roleID int) (interface{}, error) {
+	return sdk.DeleteRoleFromPrivilegeWithContext(context.Background(), privilegeID, roleID)
+}
+
+func (sdk *OneloginSDK) DeleteRoleFromPrivilegeWithContext(ctx context.Context, privilegeID string, roleID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID, "roles", roleID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) ListPrivilegesTyped(queryParams models.Queryable) (*models.Response[[]models.Privilege], error) {
+	return sdk.ListPrivilegesTypedWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) ListPrivilegesTypedWithContext(ctx context.Context, queryParams models.Queryable) (*models.Response[[]models.Privilege], error) {
+	p, err := utl.BuildAPIPath(PrivilegesPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]models.Privilege](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
+
+func (sdk *OneloginSDK) GetPrivilegeTyped(privilegeID string) (*models.Response[models.Privilege], error) {
+	return sdk.GetPrivilegeTypedWithContext(context.Background(), privilegeID)
+}
+
+func (sdk *OneloginSDK) GetPrivilegeTypedWithContext(ctx context.Context, privilegeID string) (*models.Response[models.Privilege], error) {
+	p, err := utl.BuildAPIPath(PrivilegesPath, privilegeID)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[models.Privilege](sdk.Client.GetWithContext(ctx, &p, nil))
+}
@@ -0,0 +1,62 @@
+package onelogin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+// RequestOption configures a single call to Do.
+type RequestOption func(*requestConfig)
+
+type requestConfig struct {
+	allowUnknownPath bool
+}
+
+// AllowUnknownPath lets Do call a path that isn't in utilities.DefaultRoutes, e.g. an endpoint released
+// after this version of the SDK. Registering the endpoint with utilities.RegisterRoute is preferred when
+// it is called often, as it keeps the path checked.
+func AllowUnknownPath() RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.allowUnknownPath = true
+	}
+}
+
+// Do sends a request to any OneLogin API endpoint, including those the SDK has no method for, with the
+// same authentication, retries, rate limiting and error handling as the other methods.
+//
+// path is the API path without host or query, e.g. "/api/2/users/123/apps", and must match a known route
+// unless AllowUnknownPath is given. query is encoded like the query of the other methods and may be nil,
+// a mod.Queryable, a tagged struct or a map. A non-nil body is sent as JSON. The response body is decoded
+// into out like the typed methods do, unwrapping the v1 envelope, and is discarded when out is nil.
+// Unsuccessful responses are returned as *olerror.APIError.
+func (sdk *OneloginSDK) Do(ctx context.Context, method, path string, query, body, out interface{}, opts ...RequestOption) (*mod.ResponseMetadata, error) {
+	cfg := &requestConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	// The path is appended to the tenant's base URL, so anything but an absolute path could change the host
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.ContainsAny(path, "?#") {
+		return nil, olerror.NewSDKError(fmt.Sprintf("Invalid path %q", path))
+	}
+	if !cfg.allowUnknownPath && !utl.IsPathValid(path) {
+		return nil, olerror.NewSDKError(fmt.Sprintf("Invalid path %q", path))
+	}
+
+	resp, err := sdk.Client.Do(ctx, strings.ToUpper(method), &path, query, body)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := utl.DecodeResponseInto(resp, out)
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ var (
 )
 
 func (sdk *OneloginSDK) CreateRole(role *mod.Role) (interface{}, error) {
+	return sdk.CreateRoleWithContext(context.Background(), role)
+}
+
+func (sdk *OneloginSDK) CreateRoleWithContext(ctx context.Context, role *mod.Role) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, role)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, role)
 	if err != nil {
 		return nil, err
 	}
@@ -23,11 +28,15 @@ func (sdk *OneloginSDK) CreateRole(role *mod.Role) (interface{}, error) {
 
 // was ListRoles
 func (sdk *OneloginSDK) GetRoles(queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetRolesWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetRolesWithContext(ctx context.Context, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -35,11 +44,15 @@ func (sdk *OneloginSDK) GetRoles(queryParams mod.Queryable) (interface{}, error)
 }
 
 func (sdk *OneloginSDK) GetRoleByID(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetRoleByIDWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetRoleByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -47,11 +60,15 @@ func (sdk *OneloginSDK) GetRoleByID(id int, queryParams mod.Queryable) (interfac
 }
 
 func (sdk *OneloginSDK) UpdateRole(id int, role mod.Role, queryParams map[string]string) (interface{}, error) {
+	return sdk.UpdateRoleWithContext(context.Background(), id, role, queryParams)
+}
+
+func (sdk *OneloginSDK) UpdateRoleWithContext(ctx context.Context, id int, role mod.Role, queryParams map[string]string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, role)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, role)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +76,15 @@ func (sdk *OneloginSDK) UpdateRole(id int, role mod.Role, queryParams map[string
 }
 
 func (sdk *OneloginSDK) DeleteRole(id int, queryParams map[string]string) (interface{}, error) {
+	return sdk.DeleteRoleWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) DeleteRoleWithContext(ctx context.Context, id int, queryParams map[string]string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -72,11 +93,15 @@ func (sdk *OneloginSDK) DeleteRole(id int, queryParams map[string]string) (inter
 
 // was ListRoleUsers
 func (sdk *OneloginSDK) GetRoleUsers(roleID int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetRoleUsersWithContext(context.Background(), roleID, queryParams)
+}
+
+func (sdk *OneloginSDK) GetRoleUsersWithContext(ctx context.Context, roleID int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "users")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -84,11 +109,15 @@ func (sdk *OneloginSDK) GetRoleUsers(roleID int, queryParams mod.Queryable) (int
 }
 
 func (sdk *OneloginSDK) AddRoleUsers(roleID int, users []int) (interface{}, error) {
+	return sdk.AddRoleUsersWithContext(context.Background(), roleID, users)
+}
+
+func (sdk *OneloginSDK) AddRoleUsersWithContext(ctx context.Context, roleID int, users []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "users")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, users)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, users)
 	if err != nil {
 		return nil, err
 	}
@@ -97,11 +126,15 @@ func (sdk *OneloginSDK) AddRoleUsers(roleID int, users []int) (interface{}, erro
 
 // was removeRoleUsers
 func (sdk *OneloginSDK) DeleteRoleUsers(roleID int, users []int) (interface{}, error) {
+	return sdk.DeleteRoleUsersWithContext(context.Background(), roleID, users)
+}
+
+func (sdk *OneloginSDK) DeleteRoleUsersWithContext(ctx context.Context, roleID int, users []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "users")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.DeleteWithBody(&p, users)
+	resp, err := sdk.Client.DeleteWithBodyWithContext(ctx, &p, users)
 	if err != nil {
 		return nil, err
 	}
@@ -109,11 +142,15 @@ func (sdk *OneloginSDK) DeleteRoleUsers(roleID int, users []int) (interface{}, e
 }
 
 func (sdk *OneloginSDK) GetRoleAdmins(roleID int) (interface{}, error) {
+	return sdk.GetRoleAdminsWithContext(context.Background(), roleID)
+}
+
+func (sdk *OneloginSDK) GetRoleAdminsWithContext(ctx context.Context, roleID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "admins")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -121,11 +158,15 @@ func (sdk *OneloginSDK) GetRoleAdmins(roleID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) AddRoleAdmins(roleID int) (interface{}, error) {
+	return sdk.AddRoleAdminsWithContext(context.Background(), roleID)
+}
+
+func (sdk *OneloginSDK) AddRoleAdminsWithContext(ctx context.Context, roleID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "admins")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, nil)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -134,11 +175,15 @@ func (sdk *OneloginSDK) AddRoleAdmins(roleID int) (interface{}, error) {
 
 // was removeRoleAdmins
 func (sdk *OneloginSDK) DeleteRoleAdmins(roleID int, admins []int) (interface{}, error) {
+	return sdk.DeleteRoleAdminsWithContext(context.Background(), roleID, admins)
+}
+
+func (sdk *OneloginSDK) DeleteRoleAdminsWithContext(ctx context.Context, roleID int, admins []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "admins")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.DeleteWithBody(&p, admins)
+	resp, err := sdk.Client.DeleteWithBodyWithContext(ctx, &p, admins)
 	if err != nil {
 		return nil, err
 	}
@@ -146,11 +191,15 @@ func (sdk *OneloginSDK) DeleteRoleAdmins(roleID int, admins []int) (interface{},
 }
 
 func (sdk *OneloginSDK) GetRoleApps(roleID int) (interface{}, error) {
+	return sdk.GetRoleAppsWithContext(context.Background(), roleID)
+}
+
+func (sdk *OneloginSDK) GetRoleAppsWithContext(ctx context.Context, roleID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "apps")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -159,13 +208,55 @@ func (sdk *OneloginSDK) GetRoleApps(roleID int) (interface{}, error) {
 
 // was setRoleApps
 func (sdk *OneloginSDK) UpdateRoleApps(roleID int, apps []int) (interface{}, error) {
+	return sdk.UpdateRoleAppsWithContext(context.Background(), roleID, apps)
+}
+
+func (sdk *OneloginSDK) UpdateRoleAppsWithContext(ctx context.Context, roleID int, apps []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(RolePath, roleID, "apps")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, apps)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, apps)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) CreateRoleTyped(role *mod.Role) (*mod.Response[mod.Role], error) {
+	return sdk.CreateRoleTypedWithContext(context.Background(), role)
+}
+
+func (sdk *OneloginSDK) CreateRoleTypedWithContext(ctx context.Context, role *mod.Role) (*mod.Response[mod.Role], error) {
+	p, err := utl.BuildAPIPath(RolePath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.Role](sdk.Client.PostWithContext(ctx, &p, role))
+}
+
+func (sdk *OneloginSDK) GetRolesTyped(queryParams mod.Queryable) (*mod.Response[[]mod.Role], error) {
+	return sdk.GetRolesTypedWithContext(context.Background(), queryParams)
+}
+
+func (sdk *OneloginSDK) GetRolesTypedWithContext(ctx context.Context, queryParams mod.Queryable) (*mod.Response[[]mod.Role], error) {
+	p, err := utl.BuildAPIPath(RolePath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.Role](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
+
+func (sdk *OneloginSDK) GetRoleByIDTyped(id int, queryParams mod.Queryable) (*mod.Response[mod.Role], error) {
+	return sdk.GetRoleByIDTypedWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetRoleByIDTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[mod.Role], error) {
+	p, err := utl.BuildAPIPath(RolePath, id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.Role](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
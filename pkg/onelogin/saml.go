@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) VerifyFactorSAML(request models.VerifyMFATokenRequest) (interface{}, error) {
+	return sdk.VerifyFactorSAMLWithContext(context.Background(), request)
+}
+
+func (sdk *OneloginSDK) VerifyFactorSAMLWithContext(ctx context.Context, request models.VerifyMFATokenRequest) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SAMLPath, "verify_factor")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, request)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, request)
 	if err != nil {
 		return nil, err
 	}
@@ -22,11 +27,15 @@ func (sdk *OneloginSDK) VerifyFactorSAML(request models.VerifyMFATokenRequest) (
 }
 
 func (sdk *OneloginSDK) GenerateSAMLAssertion(request models.GenerateSAMLTokenRequest) (interface{}, error) {
+	return sdk.GenerateSAMLAssertionWithContext(context.Background(), request)
+}
+
+func (sdk *OneloginSDK) GenerateSAMLAssertionWithContext(ctx context.Context, request models.GenerateSAMLTokenRequest) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SAMLPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, request)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, request)
 	if err != nil {
 		return nil, err
 	}
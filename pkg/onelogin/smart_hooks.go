@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) CreateHook(hook models.SmartHook) (interface{}, error) {
+	return sdk.CreateHookWithContext(context.Background(), hook)
+}
+
+func (sdk *OneloginSDK) CreateHookWithContext(ctx context.Context, hook models.SmartHook) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, hook)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, hook)
 	if err != nil {
 		return nil, err
 	}
@@ -22,11 +27,15 @@ func (sdk *OneloginSDK) CreateHook(hook models.SmartHook) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) DeleteHook(hookID int) (interface{}, error) {
+	return sdk.DeleteHookWithContext(context.Background(), hookID)
+}
+
+func (sdk *OneloginSDK) DeleteHookWithContext(ctx context.Context, hookID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, hookID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -34,11 +43,15 @@ func (sdk *OneloginSDK) DeleteHook(hookID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetHook(hookID int, query models.Queryable) (interface{}, error) {
+	return sdk.GetHookWithContext(context.Background(), hookID, query)
+}
+
+func (sdk *OneloginSDK) GetHookWithContext(ctx context.Context, hookID int, query models.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, hookID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, query)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, query)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +59,15 @@ func (sdk *OneloginSDK) GetHook(hookID int, query models.Queryable) (interface{}
 }
 
 func (sdk *OneloginSDK) ListHooks(query models.Queryable) (interface{}, error) {
+	return sdk.ListHooksWithContext(context.Background(), query)
+}
+
+func (sdk *OneloginSDK) ListHooksWithContext(ctx context.Context, query models.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, query)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, query)
 	if err != nil {
 		return nil, err
 	}
@@ -58,11 +75,15 @@ func (sdk *OneloginSDK) ListHooks(query models.Queryable) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) UpdateSmartHook(hookID int, hook models.SmartHook) (interface{}, error) {
+	return sdk.UpdateSmartHookWithContext(context.Background(), hookID, hook)
+}
+
+func (sdk *OneloginSDK) UpdateSmartHookWithContext(ctx context.Context, hookID int, hook models.SmartHook) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, hookID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, hook)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, hook)
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +91,15 @@ func (sdk *OneloginSDK) UpdateSmartHook(hookID int, hook models.SmartHook) (inte
 }
 
 func (sdk *OneloginSDK) ListEnvironmentVariables() (interface{}, error) {
+	return sdk.ListEnvironmentVariablesWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) ListEnvironmentVariablesWithContext(ctx context.Context) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, "envs")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +107,10 @@ func (sdk *OneloginSDK) ListEnvironmentVariables() (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) CreateEnvironmentVariable(name, value string) (interface{}, error) {
+	return sdk.CreateEnvironmentVariableWithContext(context.Background(), name, value)
+}
+
+func (sdk *OneloginSDK) CreateEnvironmentVariableWithContext(ctx context.Context, name, value string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, "envs")
 	if err != nil {
 		return nil, err
@@ -90,7 +119,7 @@ func (sdk *OneloginSDK) CreateEnvironmentVariable(name, value string) (interface
 		"name":  name,
 		"value": value,
 	}
-	resp, err := sdk.Client.Post(&p, envVar)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, envVar)
 	if err != nil {
 		return nil, err
 	}
@@ -98,11 +127,15 @@ func (sdk *OneloginSDK) CreateEnvironmentVariable(name, value string) (interface
 }
 
 func (sdk *OneloginSDK) GetEnvironmentVariable(envVarID int) (interface{}, error) {
+	return sdk.GetEnvironmentVariableWithContext(context.Background(), envVarID)
+}
+
+func (sdk *OneloginSDK) GetEnvironmentVariableWithContext(ctx context.Context, envVarID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, "envs", envVarID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +143,10 @@ func (sdk *OneloginSDK) GetEnvironmentVariable(envVarID int) (interface{}, error
 }
 
 func (sdk *OneloginSDK) UpdateEnvironmentVariable(envVarID int, name, value string) (interface{}, error) {
+	return sdk.UpdateEnvironmentVariableWithContext(context.Background(), envVarID, name, value)
+}
+
+func (sdk *OneloginSDK) UpdateEnvironmentVariableWithContext(ctx context.Context, envVarID int, name, value string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, "envs", envVarID)
 	if err != nil {
 		return nil, err
@@ -118,7 +155,7 @@ func (sdk *OneloginSDK) UpdateEnvironmentVariable(envVarID int, name, value stri
 		"name":  name,
 		"value": value,
 	}
-	resp, err := sdk.Client.Put(&p, envVar)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, envVar)
 	if err != nil {
 		return nil, err
 	}
@@ -126,11 +163,15 @@ func (sdk *OneloginSDK) UpdateEnvironmentVariable(envVarID int, name, value stri
 }
 
 func (sdk *OneloginSDK) DeleteEnvironmentVariable(envVarID int) (interface{}, error) {
+	return sdk.DeleteEnvironmentVariableWithContext(context.Background(), envVarID)
+}
+
+func (sdk *OneloginSDK) DeleteEnvironmentVariableWithContext(ctx context.Context, envVarID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, "envs", envVarID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -138,13 +179,55 @@ func (sdk *OneloginSDK) DeleteEnvironmentVariable(envVarID int) (interface{}, er
 }
 
 func (sdk *OneloginSDK) GetHookLogs(hookID int, query models.Queryable) (interface{}, error) {
+	return sdk.GetHookLogsWithContext(context.Background(), hookID, query)
+}
+
+func (sdk *OneloginSDK) GetHookLogsWithContext(ctx context.Context, hookID int, query models.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(SmartHooksPath, hookID, "logs")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, query)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, query)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) CreateHookTyped(hook models.SmartHook) (*models.Response[models.SmartHook], error) {
+	return sdk.CreateHookTypedWithContext(context.Background(), hook)
+}
+
+func (sdk *OneloginSDK) CreateHookTypedWithContext(ctx context.Context, hook models.SmartHook) (*models.Response[models.SmartHook], error) {
+	p, err := utl.BuildAPIPath(SmartHooksPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[models.SmartHook](sdk.Client.PostWithContext(ctx, &p, hook))
+}
+
+func (sdk *OneloginSDK) GetHookTyped(hookID int, query models.Queryable) (*models.Response[models.SmartHook], error) {
+	return sdk.GetHookTypedWithContext(context.Background(), hookID, query)
+}
+
+func (sdk *OneloginSDK) GetHookTypedWithContext(ctx context.Context, hookID int, query models.Queryable) (*models.Response[models.SmartHook], error) {
+	p, err := utl.BuildAPIPath(SmartHooksPath, hookID)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[models.SmartHook](sdk.Client.GetWithContext(ctx, &p, query))
+}
+
+func (sdk *OneloginSDK) ListHooksTyped(query models.Queryable) (*models.Response[[]models.SmartHook], error) {
+	return sdk.ListHooksTypedWithContext(context.Background(), query)
+}
+
+func (sdk *OneloginSDK) ListHooksTypedWithContext(ctx context.Context, query models.Queryable) (*models.Response[[]models.SmartHook], error) {
+	p, err := utl.BuildAPIPath(SmartHooksPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]models.SmartHook](sdk.Client.GetWithContext(ctx, &p, query))
+}
@@ -0,0 +1,41 @@
+package onelogin
+
+import (
+	"context"
+	"net/http"
+
+	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+// StreamFunc sends the request for a single page of a list endpoint and returns the undecoded response,
+// e.g. a closure around sdk.Client.GetWithContext.
+type StreamFunc func(ctx context.Context, query mod.Queryable) (*http.Response, error)
+
+// StreamAll walks every page of query like CollectAll, but decodes the items of each page one at a time
+// with utilities.StreamResponse and passes them to fn instead of collecting them, so memory use doesn't
+// grow with the size of the list. It stops at the first error returned by send or fn and returns it.
+// The query is modified as pages are fetched; a nil query fetches a single page.
+func StreamAll[T any](ctx context.Context, query mod.Queryable, send StreamFunc, fn func(T) error) error {
+	var cursor string
+	for {
+		resp, err := send(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		md, err := utl.StreamResponse(resp, func(item T) error {
+			count++
+			return fn(item)
+		})
+		if err != nil {
+			return err
+		}
+
+		var more bool
+		if cursor, more = advanceQuery(query, md, count, cursor); !more {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package onelogin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+)
+
+// DefaultFanOutWorkers is the number of tenants FanOut works on at once when no worker count is configured.
+const DefaultFanOutWorkers = 8
+
+// TenantManager creates and caches one OneloginSDK per tenant, e.g. for a managed service provider that
+// administers many OneLogin accounts. The SDK of a tenant is created on first use. All tenants send their
+// requests through one shared HTTP transport, while each tenant has its own rate limiter, so a busy tenant
+// can't use up the request budget of another. It is safe for concurrent use.
+type TenantManager struct {
+	mu       sync.Mutex
+	tenants  map[string]*tenant
+	defaults []Option
+	failFast bool
+	workers  int
+
+	transport http.RoundTripper
+}
+
+// tenant is a registered tenant and its SDK, once created.
+type tenant struct {
+	opts    []Option
+	limiter *api.RateLimiter
+
+	lock chan struct{} // Held while the SDK is created, so it is created once; waiting for it can be cancelled
+	sdk  *OneloginSDK
+}
+
+// acquire takes t.lock, giving up when ctx is done.
+func (t *tenant) acquire(ctx context.Context) error {
+	select {
+	case t.lock <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *tenant) release() {
+	<-t.lock
+}
+
+// TenantManagerOption configures a TenantManager created by NewTenantManager.
+type TenantManagerOption func(m *TenantManager)
+
+// WithTenantDefaults applies opts to the SDK of every tenant, before the options the tenant was added with.
+func WithTenantDefaults(opts ...Option) TenantManagerOption {
+	return func(m *TenantManager) {
+		m.defaults = append(m.defaults, opts...)
+	}
+}
+
+// WithTenantTransport shares transport between all tenants instead of a transport created by NewTenantManager.
+func WithTenantTransport(transport http.RoundTripper) TenantManagerOption {
+	return func(m *TenantManager) {
+		m.transport = transport
+	}
+}
+
+// WithTenantFailFast makes a tenant's requests fail with an *olerror.RateLimitError once its request
+// budget is exhausted, instead of waiting for the rate limit window to reset.
+func WithTenantFailFast() TenantManagerOption {
+	return func(m *TenantManager) {
+		m.failFast = true
+	}
+}
+
+// WithFanOutWorkers sets how many tenants FanOut works on at once, DefaultFanOutWorkers by default.
+func WithFanOutWorkers(workers int) TenantManagerOption {
+	return func(m *TenantManager) {
+		m.workers = workers
+	}
+}
+
+// NewTenantManager creates a TenantManager without tenants.
+func NewTenantManager(opts ...TenantManagerOption) *TenantManager {
+	m := &TenantManager{tenants: map[string]*tenant{}}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(m)
+		}
+	}
+	if m.workers <= 0 {
+		m.workers = DefaultFanOutWorkers
+	}
+	if m.transport == nil {
+		// A DefaultTransport wrapped by the application, e.g. for tracing, is shared as it is
+		m.transport = http.DefaultTransport
+		if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+			transport = transport.Clone()
+			transport.MaxIdleConnsPerHost = m.workers
+			m.transport = transport
+		}
+	}
+	return m
+}
+
+// Add registers a tenant under name. opts configure its SDK, typically WithCredentials or WithProfile;
+// they take precedence over the manager's defaults. The SDK is not created until it is first used.
+func (m *TenantManager) Add(name string, opts ...Option) error {
+	if strings.TrimSpace(name) == "" {
+		return olerror.NewSDKError("tenant name is required")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tenants[name]; ok {
+		return olerror.NewSDKError(fmt.Sprintf("tenant %s is already registered", name))
+	}
+	m.tenants[name] = &tenant{opts: opts, limiter: api.NewRateLimiter(m.failFast), lock: make(chan struct{}, 1)}
+	return nil
+}
+
+// Remove unregisters the tenant called name and closes its SDK if it was created, revoking its token.
+func (m *TenantManager) Remove(ctx context.Context, name string) error {
+	m.mu.Lock()
+	t, ok := m.tenants[name]
+	delete(m.tenants, name)
+	m.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return t.close(ctx)
+}
+
+// Tenants returns the names of the registered tenants in sorted order.
+func (m *TenantManager) Tenants() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	names := make([]string, 0, len(m.tenants))
+	for name := range m.tenants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SDK returns the SDK of the tenant called name, creating it on first use. An SDK that fails to be
+// created, e.g. because its token request failed, is not cached, so the next call tries again.
+func (m *TenantManager) SDK(name string) (*OneloginSDK, error) {
+	return m.SDKWithContext(context.Background(), name)
+}
+
+// SDKWithContext is SDK with the token request of a new SDK, and the wait for another caller creating it,
+// aborted when ctx is cancelled.
+func (m *TenantManager) SDKWithContext(ctx context.Context, name string) (*OneloginSDK, error) {
+	m.mu.Lock()
+	t, ok := m.tenants[name]
+	m.mu.Unlock()
+	if !ok {
+		return nil, olerror.NewSDKError(fmt.Sprintf("tenant %s is not registered", name))
+	}
+
+	if err := t.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer t.release()
+	if t.sdk != nil {
+		return t.sdk, nil
+	}
+
+	// The shared transport and the tenant's rate limiter come first, so the tenant's options can replace them.
+	// The token is requested below rather than by NewOneloginSDK, so that it is bound to ctx.
+	opts := []Option{WithTransport(m.transport), WithRateLimiter(t.limiter)}
+	opts = append(opts, m.defaults...)
+	opts = append(opts, t.opts...)
+	opts = append(opts, WithLazyAuth())
+	sdk, err := NewOneloginSDK(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if client, ok := sdk.Client.(*api.Client); ok {
+		if err := client.Auth.GenerateTokenWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+	t.sdk = sdk
+	return sdk, nil
+}
+
+// RateLimiter returns the rate limiter of the tenant called name, or nil if it is not registered.
+func (m *TenantManager) RateLimiter(name string) *api.RateLimiter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t, ok := m.tenants[name]; ok {
+		return t.limiter
+	}
+	return nil
+}
+
+// Close closes the SDKs created so far, revoking their tokens, and releases the shared transport's idle
+// connections. The tenants stay registered, and their SDKs are created again when next used.
+func (m *TenantManager) Close(ctx context.Context) error {
+	names := m.Tenants()
+	var failed []*TenantError
+	for _, name := range names {
+		m.mu.Lock()
+		t, ok := m.tenants[name]
+		m.mu.Unlock()
+		if !ok {
+			continue
+		}
+		if err := t.close(ctx); err != nil {
+			failed = append(failed, &TenantError{Tenant: name, Err: err})
+		}
+	}
+	if closer, ok := m.transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+	if len(failed) > 0 {
+		return &TenantsError{Tenants: failed}
+	}
+	return nil
+}
+
+func (t *tenant) close(ctx context.Context) error {
+	if err := t.acquire(ctx); err != nil {
+		return err
+	}
+	sdk := t.sdk
+	t.sdk = nil
+	t.release()
+	if sdk == nil {
+		return nil
+	}
+	return sdk.Close(ctx)
+}
+
+// TenantFunc is run by FanOut for a single tenant.
+type TenantFunc[T any] func(ctx context.Context, name string, sdk *OneloginSDK) (T, error)
+
+// TenantResult is the outcome of running a TenantFunc for one tenant in FanOut.
+type TenantResult[T any] struct {
+	Tenant string
+	Value  T
+	Err    error
+}
+
+// TenantError reports the failure of a single tenant.
+type TenantError struct {
+	Tenant string
+	Err    error
+}
+
+func (e *TenantError) Error() string {
+	return fmt.Sprintf("tenant %s: %v", e.Tenant, e.Err)
+}
+
+func (e *TenantError) Unwrap() error {
+	return e.Err
+}
+
+// TenantsError lists the tenants that failed in FanOut or TenantManager.Close, in tenant name order.
+type TenantsError struct {
+	Tenants []*TenantError
+}
+
+func (e *TenantsError) Error() string {
+	msgs := make([]string, len(e.Tenants))
+	for i, t := range e.Tenants {
+		msgs[i] = t.Error()
+	}
+	return fmt.Sprintf("%d tenants failed: %s", len(e.Tenants), strings.Join(msgs, "; "))
+}
+
+// FanOut runs fn for every registered tenant, working on up to the manager's fan-out workers at once,
+// and returns one result per tenant in tenant name order. A tenant whose SDK can't be created, or whose
+// fn returns an error, is reported in its TenantResult.Err; when any tenant fails, the results are
+// returned together with a *TenantsError. Tenants not yet started when ctx is cancelled fail with its error.
+func FanOut[T any](ctx context.Context, m *TenantManager, fn TenantFunc[T]) ([]TenantResult[T], error) {
+	names := m.Tenants()
+	results := make([]TenantResult[T], len(names))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < m.workers && w < len(names); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = runTenant(ctx, m, names[i], fn)
+			}
+		}()
+	}
+	for i := range names {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var failed []*TenantError
+	for _, r := range results {
+		if r.Err != nil {
+			failed = append(failed, &TenantError{Tenant: r.Tenant, Err: r.Err})
+		}
+	}
+	if len(failed) > 0 {
+		return results, &TenantsError{Tenants: failed}
+	}
+	return results, nil
+}
+
+func runTenant[T any](ctx context.Context, m *TenantManager, name string, fn TenantFunc[T]) TenantResult[T] {
+	result := TenantResult[T]{Tenant: name}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	sdk, err := m.SDKWithContext(ctx, name)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Value, result.Err = fn(ctx, name, sdk)
+	return result
+}
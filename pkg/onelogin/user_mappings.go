@@ -1,6 +1,7 @@
 package onelogin
 
 import (
+	"context"
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
 )
@@ -10,11 +11,15 @@ const (
 )
 
 func (sdk *OneloginSDK) ListMappings() (interface{}, error) {
+	return sdk.ListMappingsWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) ListMappingsWithContext(ctx context.Context) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -22,11 +27,15 @@ func (sdk *OneloginSDK) ListMappings() (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) CreateMapping(mapping mod.UserMapping) (interface{}, error) {
+	return sdk.CreateMappingWithContext(context.Background(), mapping)
+}
+
+func (sdk *OneloginSDK) CreateMappingWithContext(ctx context.Context, mapping mod.UserMapping) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, mapping)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, mapping)
 	if err != nil {
 		return nil, err
 	}
@@ -34,11 +43,15 @@ func (sdk *OneloginSDK) CreateMapping(mapping mod.UserMapping) (interface{}, err
 }
 
 func (sdk *OneloginSDK) DeleteMapping(mappingID int) (interface{}, error) {
+	return sdk.DeleteMappingWithContext(context.Background(), mappingID)
+}
+
+func (sdk *OneloginSDK) DeleteMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, mappingID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -46,11 +59,15 @@ func (sdk *OneloginSDK) DeleteMapping(mappingID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetMapping(mappingID int) (interface{}, error) {
+	return sdk.GetMappingWithContext(context.Background(), mappingID)
+}
+
+func (sdk *OneloginSDK) GetMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, mappingID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -58,11 +75,15 @@ func (sdk *OneloginSDK) GetMapping(mappingID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) ListActions() (interface{}, error) {
+	return sdk.ListActionsWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) ListActionsWithContext(ctx context.Context) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, "actions")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +91,15 @@ func (sdk *OneloginSDK) ListActions() (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) UpdateMapping(mappingID int) (interface{}, error) {
+	return sdk.UpdateMappingWithContext(context.Background(), mappingID)
+}
+
+func (sdk *OneloginSDK) UpdateMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, mappingID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,11 +107,15 @@ func (sdk *OneloginSDK) UpdateMapping(mappingID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) BulkSortMappings(mappingIDs []int) (interface{}, error) {
+	return sdk.BulkSortMappingsWithContext(context.Background(), mappingIDs)
+}
+
+func (sdk *OneloginSDK) BulkSortMappingsWithContext(ctx context.Context, mappingIDs []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, "bulk_sort")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, mappingIDs)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, mappingIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -94,11 +123,15 @@ func (sdk *OneloginSDK) BulkSortMappings(mappingIDs []int) (interface{}, error)
 }
 
 func (sdk *OneloginSDK) ListActionValues(actionValue string) (interface{}, error) {
+	return sdk.ListActionValuesWithContext(context.Background(), actionValue)
+}
+
+func (sdk *OneloginSDK) ListActionValuesWithContext(ctx context.Context, actionValue string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, "actions", actionValue, "values")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,11 +139,15 @@ func (sdk *OneloginSDK) ListActionValues(actionValue string) (interface{}, error
 }
 
 func (sdk *OneloginSDK) ListConditionValues(conditionValue string) (interface{}, error) {
+	return sdk.ListConditionValuesWithContext(context.Background(), conditionValue)
+}
+
+func (sdk *OneloginSDK) ListConditionValuesWithContext(ctx context.Context, conditionValue string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, "conditions", conditionValue, "values")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -118,11 +155,15 @@ func (sdk *OneloginSDK) ListConditionValues(conditionValue string) (interface{},
 }
 
 func (sdk *OneloginSDK) ListConditionOperators(conditionValue string) (interface{}, error) {
+	return sdk.ListConditionOperatorsWithContext(context.Background(), conditionValue)
+}
+
+func (sdk *OneloginSDK) ListConditionOperatorsWithContext(ctx context.Context, conditionValue string) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, "conditions", conditionValue, "operators")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +171,15 @@ func (sdk *OneloginSDK) ListConditionOperators(conditionValue string) (interface
 }
 
 func (sdk *OneloginSDK) DryrunMapping(mappingID int) (interface{}, error) {
+	return sdk.DryrunMappingWithContext(context.Background(), mappingID)
+}
+
+func (sdk *OneloginSDK) DryrunMappingWithContext(ctx context.Context, mappingID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, mappingID, "dryrun")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Post(&p, nil)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -143,13 +188,55 @@ func (sdk *OneloginSDK) DryrunMapping(mappingID int) (interface{}, error) {
 
 // https://<subdomain>/api/2/mappings/conditions
 func (sdk *OneloginSDK) ListConditions() (interface{}, error) {
+	return sdk.ListConditionsWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) ListConditionsWithContext(ctx context.Context) (interface{}, error) {
 	p, err := utl.BuildAPIPath(MappingsPath, "conditions")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) ListMappingsTyped() (*mod.Response[[]mod.UserMapping], error) {
+	return sdk.ListMappingsTypedWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) ListMappingsTypedWithContext(ctx context.Context) (*mod.Response[[]mod.UserMapping], error) {
+	p, err := utl.BuildAPIPath(MappingsPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.UserMapping](sdk.Client.GetWithContext(ctx, &p, nil))
+}
+
+func (sdk *OneloginSDK) CreateMappingTyped(mapping mod.UserMapping) (*mod.Response[mod.UserMapping], error) {
+	return sdk.CreateMappingTypedWithContext(context.Background(), mapping)
+}
+
+func (sdk *OneloginSDK) CreateMappingTypedWithContext(ctx context.Context, mapping mod.UserMapping) (*mod.Response[mod.UserMapping], error) {
+	p, err := utl.BuildAPIPath(MappingsPath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.UserMapping](sdk.Client.PostWithContext(ctx, &p, mapping))
+}
+
+func (sdk *OneloginSDK) GetMappingTyped(mappingID int) (*mod.Response[mod.UserMapping], error) {
+	return sdk.GetMappingTypedWithContext(context.Background(), mappingID)
+}
+
+func (sdk *OneloginSDK) GetMappingTypedWithContext(ctx context.Context, mappingID int) (*mod.Response[mod.UserMapping], error) {
+	p, err := utl.BuildAPIPath(MappingsPath, mappingID)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.UserMapping](sdk.Client.GetWithContext(ctx, &p, nil))
+}
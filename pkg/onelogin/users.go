@@ -1,8 +1,9 @@
 package onelogin
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
+	"net/http"
 
 	mod "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
@@ -15,12 +16,16 @@ const (
 
 // Users V2
 func (sdk *OneloginSDK) CreateUser(user mod.User) (interface{}, error) {
+	return sdk.CreateUserWithContext(context.Background(), user)
+}
+
+func (sdk *OneloginSDK) CreateUserWithContext(ctx context.Context, user mod.User) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV2)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := sdk.Client.Post(&p, user)
+	resp, err := sdk.Client.PostWithContext(ctx, &p, user)
 	if err != nil {
 		return nil, err
 	}
@@ -30,18 +35,16 @@ func (sdk *OneloginSDK) CreateUser(user mod.User) (interface{}, error) {
 
 // was ListUsers
 func (sdk *OneloginSDK) GetUsers(query mod.Queryable) (interface{}, error) {
+	return sdk.GetUsersWithContext(context.Background(), query)
+}
+
+func (sdk *OneloginSDK) GetUsersWithContext(ctx context.Context, query mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV2)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate query parameters
-	validators := query.GetKeyValidators()
-	if !utl.ValidateQueryParams(query, validators) {
-		return nil, errors.New("invalid query parameters")
-	}
-
-	resp, err := sdk.Client.Get(&p, query)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, query)
 	if err != nil {
 		return nil, err
 	}
@@ -49,11 +52,15 @@ func (sdk *OneloginSDK) GetUsers(query mod.Queryable) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetUserByID(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetUserByIDWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetUserByIDWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV2, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -61,11 +68,15 @@ func (sdk *OneloginSDK) GetUserByID(id int, queryParams mod.Queryable) (interfac
 }
 
 func (sdk *OneloginSDK) GetUserApps(id int, queryParams mod.Queryable) (interface{}, error) {
+	return sdk.GetUserAppsWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetUserAppsWithContext(ctx context.Context, id int, queryParams mod.Queryable) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV2, id, "apps")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, queryParams)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, queryParams)
 	if err != nil {
 		return nil, err
 	}
@@ -73,11 +84,15 @@ func (sdk *OneloginSDK) GetUserApps(id int, queryParams mod.Queryable) (interfac
 }
 
 func (sdk *OneloginSDK) UpdateUser(id int, user mod.User) (interface{}, error) {
+	return sdk.UpdateUserWithContext(context.Background(), id, user)
+}
+
+func (sdk *OneloginSDK) UpdateUserWithContext(ctx context.Context, id int, user mod.User) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV2, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, user)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, user)
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +100,15 @@ func (sdk *OneloginSDK) UpdateUser(id int, user mod.User) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) DeleteUser(id int) (interface{}, error) {
+	return sdk.DeleteUserWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) DeleteUserWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV2, id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Delete(&p)
+	resp, err := sdk.Client.DeleteWithContext(ctx, &p)
 	if err != nil {
 		return nil, err
 	}
@@ -98,11 +117,15 @@ func (sdk *OneloginSDK) DeleteUser(id int) (interface{}, error) {
 
 // Users V1
 func (sdk *OneloginSDK) UpdatePasswordSecure(id int) (interface{}, error) {
+	return sdk.UpdatePasswordSecureWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) UpdatePasswordSecureWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, "set_password_using_salt", id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,11 +133,15 @@ func (sdk *OneloginSDK) UpdatePasswordSecure(id int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) UpdatePasswordInsecure(id int) (interface{}, error) {
+	return sdk.UpdatePasswordInsecureWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) UpdatePasswordInsecureWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, "set_password_clear", id)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -122,11 +149,15 @@ func (sdk *OneloginSDK) UpdatePasswordInsecure(id int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) LockUserAccount(id int) (interface{}, error) {
+	return sdk.LockUserAccountWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) LockUserAccountWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, id, "lock_user")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -134,11 +165,15 @@ func (sdk *OneloginSDK) LockUserAccount(id int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetUserRoles(id int) (interface{}, error) {
+	return sdk.GetUserRolesWithContext(context.Background(), id)
+}
+
+func (sdk *OneloginSDK) GetUserRolesWithContext(ctx context.Context, id int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, id, "roles")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -146,11 +181,15 @@ func (sdk *OneloginSDK) GetUserRoles(id int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) LogOutUser(userID int) (interface{}, error) {
+	return sdk.LogOutUserWithContext(context.Background(), userID)
+}
+
+func (sdk *OneloginSDK) LogOutUserWithContext(ctx context.Context, userID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, userID, "logout")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +197,10 @@ func (sdk *OneloginSDK) LogOutUser(userID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) AssignRolesToUser(userID int, roles []int) (interface{}, error) {
+	return sdk.AssignRolesToUserWithContext(context.Background(), userID, roles)
+}
+
+func (sdk *OneloginSDK) AssignRolesToUserWithContext(ctx context.Context, userID int, roles []int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, userID, "add_roles")
 	if err != nil {
 		return nil, err
@@ -167,7 +210,7 @@ func (sdk *OneloginSDK) AssignRolesToUser(userID int, roles []int) (interface{},
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, string(payloadMarshalled))
+	resp, err := sdk.Client.PutWithContext(ctx, &p, string(payloadMarshalled))
 	if err != nil {
 		return nil, err
 	}
@@ -175,12 +218,16 @@ func (sdk *OneloginSDK) AssignRolesToUser(userID int, roles []int) (interface{},
 }
 
 func (sdk *OneloginSDK) SetUserState(userID, state int) (interface{}, error) {
+	return sdk.SetUserStateWithContext(context.Background(), userID, state)
+}
+
+func (sdk *OneloginSDK) SetUserStateWithContext(ctx context.Context, userID, state int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, userID, "set_state")
 	if err != nil {
 		return nil, err
 	}
 	payload := map[string]int{"state": state}
-	resp, err := sdk.Client.Put(&p, payload)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -188,11 +235,15 @@ func (sdk *OneloginSDK) SetUserState(userID, state int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) RemoveUserRole(userID int) (interface{}, error) {
+	return sdk.RemoveUserRoleWithContext(context.Background(), userID)
+}
+
+func (sdk *OneloginSDK) RemoveUserRoleWithContext(ctx context.Context, userID int) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, userID, "remove_roles")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, nil)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -200,11 +251,15 @@ func (sdk *OneloginSDK) RemoveUserRole(userID int) (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) GetCustomAttributes() (interface{}, error) {
+	return sdk.GetCustomAttributesWithContext(context.Background())
+}
+
+func (sdk *OneloginSDK) GetCustomAttributesWithContext(ctx context.Context) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, "custom_attributes")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Get(&p, nil)
+	resp, err := sdk.Client.GetWithContext(ctx, &p, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -212,13 +267,96 @@ func (sdk *OneloginSDK) GetCustomAttributes() (interface{}, error) {
 }
 
 func (sdk *OneloginSDK) SetCustomAttributes(userID int, attr interface{}) (interface{}, error) {
+	return sdk.SetCustomAttributesWithContext(context.Background(), userID, attr)
+}
+
+func (sdk *OneloginSDK) SetCustomAttributesWithContext(ctx context.Context, userID int, attr interface{}) (interface{}, error) {
 	p, err := utl.BuildAPIPath(UserPathV1, userID, "set_custom_attributes")
 	if err != nil {
 		return nil, err
 	}
-	resp, err := sdk.Client.Put(&p, attr)
+	resp, err := sdk.Client.PutWithContext(ctx, &p, attr)
 	if err != nil {
 		return nil, err
 	}
 	return utl.CheckHTTPResponse(resp)
 }
+
+// Typed responses
+
+func (sdk *OneloginSDK) CreateUserTyped(user mod.User) (*mod.Response[mod.User], error) {
+	return sdk.CreateUserTypedWithContext(context.Background(), user)
+}
+
+func (sdk *OneloginSDK) CreateUserTypedWithContext(ctx context.Context, user mod.User) (*mod.Response[mod.User], error) {
+	p, err := utl.BuildAPIPath(UserPathV2)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.User](sdk.Client.PostWithContext(ctx, &p, user))
+}
+
+func (sdk *OneloginSDK) GetUsersTyped(query mod.Queryable) (*mod.Response[[]mod.User], error) {
+	return sdk.GetUsersTypedWithContext(context.Background(), query)
+}
+
+func (sdk *OneloginSDK) GetUsersTypedWithContext(ctx context.Context, query mod.Queryable) (*mod.Response[[]mod.User], error) {
+	p, err := utl.BuildAPIPath(UserPathV2)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.User](sdk.Client.GetWithContext(ctx, &p, query))
+}
+
+func (sdk *OneloginSDK) GetUserByIDTyped(id int, queryParams mod.Queryable) (*mod.Response[mod.User], error) {
+	return sdk.GetUserByIDTypedWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetUserByIDTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[mod.User], error) {
+	p, err := utl.BuildAPIPath(UserPathV2, id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.User](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
+
+func (sdk *OneloginSDK) GetUserAppsTyped(id int, queryParams mod.Queryable) (*mod.Response[[]mod.UserApp], error) {
+	return sdk.GetUserAppsTypedWithContext(context.Background(), id, queryParams)
+}
+
+func (sdk *OneloginSDK) GetUserAppsTypedWithContext(ctx context.Context, id int, queryParams mod.Queryable) (*mod.Response[[]mod.UserApp], error) {
+	p, err := utl.BuildAPIPath(UserPathV2, id, "apps")
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[[]mod.UserApp](sdk.Client.GetWithContext(ctx, &p, queryParams))
+}
+
+func (sdk *OneloginSDK) UpdateUserTyped(id int, user mod.User) (*mod.Response[mod.User], error) {
+	return sdk.UpdateUserTypedWithContext(context.Background(), id, user)
+}
+
+func (sdk *OneloginSDK) UpdateUserTypedWithContext(ctx context.Context, id int, user mod.User) (*mod.Response[mod.User], error) {
+	p, err := utl.BuildAPIPath(UserPathV2, id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse[mod.User](sdk.Client.PutWithContext(ctx, &p, user))
+}
+
+// Streaming
+
+// StreamUsers passes every user matching query to fn, one page at a time, without holding a whole page in memory.
+func (sdk *OneloginSDK) StreamUsers(query mod.Queryable, fn func(mod.User) error) error {
+	return sdk.StreamUsersWithContext(context.Background(), query, fn)
+}
+
+func (sdk *OneloginSDK) StreamUsersWithContext(ctx context.Context, query mod.Queryable, fn func(mod.User) error) error {
+	p, err := utl.BuildAPIPath(UserPathV2)
+	if err != nil {
+		return err
+	}
+	return StreamAll(ctx, query, func(ctx context.Context, query mod.Queryable) (*http.Response, error) {
+		return sdk.Client.GetWithContext(ctx, &p, query)
+	}, fn)
+}
@@ -0,0 +1,147 @@
+package utilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+)
+
+// errorBody covers the error formats returned by the v1 and v2 OneLogin APIs.
+type errorBody struct {
+	// v2: {"statusCode": 404, "name": "NotFound", "message": "..."}
+	StatusCode int             `json:"statusCode"`
+	Name       string          `json:"name"`
+	Message    json.RawMessage `json:"message"`
+	Code       json.RawMessage `json:"code"`
+	Field      string          `json:"field"`
+	Errors     json.RawMessage `json:"errors"`
+	// v1: {"status": {"error": true, "code": 400, "type": "bad request", "message": "..."}}
+	Status *struct {
+		Code    int             `json:"code"`
+		Type    string          `json:"type"`
+		Message json.RawMessage `json:"message"`
+	} `json:"status"`
+}
+
+// NewAPIErrorFromResponse converts a non-2xx response and its body into an *olerror.APIError.
+func NewAPIErrorFromResponse(resp *http.Response, body []byte) *olerror.APIError {
+	md := parseResponseHeadersToMetadata(resp).Metadata
+	apiErr := &olerror.APIError{
+		Code:               resp.StatusCode,
+		RequestID:          resp.Header.Get("X-Request-Id"),
+		Header:             resp.Header,
+		Body:               body,
+		RateLimitLimit:     md.RateLimitLimit,
+		RateLimitRemaining: md.RateLimitRemaining,
+		RateLimitReset:     md.RateLimitReset,
+	}
+	if wait, ok := RetryAfter(resp); ok {
+		apiErr.RetryAfter = wait
+	}
+
+	var eb errorBody
+	if err := json.Unmarshal(body, &eb); err == nil {
+		apiErr.Type = eb.Name
+		apiErr.Message, apiErr.FieldErrors = parseErrorMessage(eb.Message)
+		if code := parseErrorCode(eb.Code); code != 0 {
+			apiErr.ErrorCode = code
+		} else if eb.StatusCode != 0 && eb.StatusCode != resp.StatusCode {
+			apiErr.ErrorCode = eb.StatusCode
+		}
+		if eb.Status != nil {
+			if apiErr.Type == "" {
+				apiErr.Type = eb.Status.Type
+			}
+			if apiErr.Message == "" {
+				apiErr.Message, apiErr.FieldErrors = parseErrorMessage(eb.Status.Message)
+			}
+			if apiErr.ErrorCode == 0 && eb.Status.Code != resp.StatusCode {
+				apiErr.ErrorCode = eb.Status.Code
+			}
+		}
+		if eb.Field != "" {
+			apiErr.FieldErrors = append(apiErr.FieldErrors, olerror.FieldError{Field: eb.Field, Message: apiErr.Message})
+		}
+		apiErr.FieldErrors = append(apiErr.FieldErrors, parseFieldErrors(eb.Errors)...)
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
+
+// parseErrorMessage handles messages that are plain strings, lists of strings, or objects
+// mapping field names to problems.
+func parseErrorMessage(raw json.RawMessage) (string, []olerror.FieldError) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return strings.Join(list, "; "), nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err == nil {
+		fieldErrors := make([]olerror.FieldError, 0, len(fields))
+		for field, problem := range fields {
+			fieldErrors = append(fieldErrors, olerror.FieldError{Field: field, Message: joinProblem(problem)})
+		}
+		sort.Slice(fieldErrors, func(i, j int) bool { return fieldErrors[i].Field < fieldErrors[j].Field })
+		return "invalid fields", fieldErrors
+	}
+	return string(raw), nil
+}
+
+// parseFieldErrors handles [{"field": "email", "message": "is taken"}] style error lists.
+func parseFieldErrors(raw json.RawMessage) []olerror.FieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+	var list []struct {
+		Field   string      `json:"field"`
+		Message interface{} `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil
+	}
+	fieldErrors := make([]olerror.FieldError, 0, len(list))
+	for _, fe := range list {
+		fieldErrors = append(fieldErrors, olerror.FieldError{Field: fe.Field, Message: joinProblem(fe.Message)})
+	}
+	return fieldErrors
+}
+
+func parseErrorCode(raw json.RawMessage) int {
+	var code int
+	if len(raw) == 0 || json.Unmarshal(raw, &code) != nil {
+		return 0
+	}
+	return code
+}
+
+func joinProblem(problem interface{}) string {
+	switch p := problem.(type) {
+	case string:
+		return p
+	case []interface{}:
+		parts := make([]string, 0, len(p))
+		for _, item := range p {
+			parts = append(parts, fmt.Sprint(item))
+		}
+		return strings.Join(parts, "; ")
+	default:
+		return fmt.Sprint(p)
+	}
+}
@@ -0,0 +1,72 @@
+package utilities
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+)
+
+// regionHosts maps the supported regions to their API hosts.
+var regionHosts = map[string]string{
+	"us": "api.us.onelogin.com",
+	"eu": "api.eu.onelogin.com",
+}
+
+// subdomainPattern matches a single DNS label, so that a subdomain can't redirect requests to another host.
+var subdomainPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// BaseURL returns the base URL of API and token requests. Without a region this is the tenant's own
+// host, https://<subdomain>.onelogin.com; with a region ("us" or "eu") it is the regional API host, e.g.
+// https://api.eu.onelogin.com. An invalid subdomain or an unknown region is reported as an
+// *olerror.ValidationError.
+func BaseURL(region, subdomain string) (string, error) {
+	subdomain = strings.ToLower(strings.TrimSpace(subdomain))
+	if subdomain != "" || region == "" {
+		if err := ValidateSubdomain(subdomain); err != nil {
+			return "", err
+		}
+	}
+	if region == "" {
+		return fmt.Sprintf("https://%s.onelogin.com", subdomain), nil
+	}
+
+	host, ok := regionHosts[strings.ToLower(strings.TrimSpace(region))]
+	if !ok {
+		return "", olerror.NewValidationError([]olerror.FieldError{
+			{Field: "region", Message: fmt.Sprintf("%q is not a supported region, expected us or eu", region)},
+		})
+	}
+	return "https://" + host, nil
+}
+
+// ValidateSubdomain reports a subdomain that is empty or not a valid DNS label as an *olerror.ValidationError.
+// A full host name such as acme.onelogin.com is rejected, the subdomain being only acme.
+func ValidateSubdomain(subdomain string) error {
+	var message string
+	switch {
+	case subdomain == "":
+		message = "is required, set ONELOGIN_SUBDOMAIN or pass it with the credentials"
+	case strings.Contains(subdomain, "."):
+		message = fmt.Sprintf("%q must be the subdomain only, e.g. acme for acme.onelogin.com", subdomain)
+	case !subdomainPattern.MatchString(strings.ToLower(subdomain)):
+		message = fmt.Sprintf("%q may only contain letters, digits and hyphens", subdomain)
+	default:
+		return nil
+	}
+	return olerror.NewValidationError([]olerror.FieldError{{Field: "subdomain", Message: message}})
+}
+
+// NormalizeBaseURL checks that an explicitly configured base URL is an absolute http or https URL without
+// a query or fragment, and strips its trailing slash so that paths can be appended to it.
+func NormalizeBaseURL(baseURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return "", olerror.NewValidationError([]olerror.FieldError{
+			{Field: "base URL", Message: fmt.Sprintf("%q must be an absolute http or https URL without a query", baseURL)},
+		})
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
@@ -0,0 +1,107 @@
+package utilities
+
+// Path templates of the OneLogin API endpoints used by the SDK, see Route for the syntax
+var defaultRouteTemplates = []string{
+	"/api/2/api_authorizations",
+	"/api/2/api_authorizations/{api_authorization_id:int}",
+	"/api/2/api_authorizations/{api_authorization_id:int}/scopes",
+	"/api/2/api_authorizations/{api_authorization_id:int}/scopes/{scope_id:int}",
+	"/api/2/api_authorizations/{api_authorization_id:int}/claims",
+	"/api/2/api_authorizations/{api_authorization_id:int}/claims/{claim_id:int}",
+	"/api/2/api_authorizations/{api_authorization_id:int}/clients",
+	"/api/2/api_authorizations/{api_authorization_id:int}/clients/{client_id:int}",
+	"/api/1/events",
+	"/api/1/events/{event_id:int}",
+	"/api/1/events/types",
+	"/api/1/groups",
+	"/api/1/groups/{group_id:int}",
+	"/api/1/invites/get_invite_link",
+	"/api/1/invites/send_invite_link",
+	"/api/1/users",
+	"/api/1/users/{user_id:int}",
+	"/api/1/users/{user_id:int}/apps",
+	"/api/1/users/{user_id:int}/roles",
+	"/api/1/users/set_password_clear_text/{user_id:int}",
+	"/api/1/users/set_password_using_salt/{user_id:int}",
+	"/api/1/users/custom_attributes",
+	"/api/1/users/{user_id:int}/set_custom_attributes",
+	"/api/1/users/{user_id:int}/auth_factor",
+	"/api/1/users/{user_id:int}/logout",
+	"/api/1/users/{user_id:int}/lock_user",
+	"/api/1/users/{user_id:int}/otp_devices",
+	"/api/1/users/{user_id:int}/mfa_token",
+	"/api/1/users/{user_id:int}/add_roles",
+	"/api/1/users/{user_id:int}/set_state",
+	"/api/1/users/{user_id:int}/remove_roles",
+	"/api/1/users/{user_id:int}/otp_devices/{device_id:int}",
+	"/api/1/users/{user_id:int}/otp_devices/{device_id:int}/verify",
+	"/api/1/users/{user_id:int}/otp_devices/{device_id:int}/trigger",
+	"/api/1/privileges",
+	"/api/1/privileges/{privilege_id:int}",
+	"/api/1/privileges/{privilege_id:int}/roles",
+	"/api/1/privileges/{privilege_id:int}/roles/{role_id:int}",
+	"/api/1/privileges/{privilege_id:int}/users",
+	"/api/1/privileges/{privilege_id:int}/users/{user_id:int}",
+	"/api/1/roles",
+	"/api/1/roles/{role_id:int}",
+	"/api/1/saml_assertion",
+	"/api/1/saml_assertion/verify_factor",
+	"/api/2/saml_assertion",
+	"/api/2/saml_assertion/verify_factor",
+	"/api/2/mappings",
+	"/api/2/mappings/{mapping_id:int}",
+	"/api/2/mappings/conditions",
+	"/api/2/mappings/conditions/{condition:alnum}/operators",
+	"/api/2/mappings/conditions/{condition:alnum}/values",
+	"/api/2/mappings/actions",
+	"/api/2/mappings/actions/{action:alnum}/values",
+	"/api/2/mappings/sort",
+	"/api/2/apps",
+	"/api/2/apps/{app_id:int}",
+	"/api/2/apps/{app_id:int}/parameters/{parameter:alnum}",
+	"/api/2/apps/{app_id:int}/users",
+	"/api/2/apps/{app_id:int}/rules",
+	"/api/2/apps/{app_id:int}/rules/{rule_id:alnum}",
+	"/api/2/apps/{app_id:int}/rules/conditions",
+	"/api/2/apps/{app_id:int}/rules/conditions/{condition:alnum}/operators",
+	"/api/2/apps/{app_id:int}/rules/conditions/{condition:alnum}/values",
+	"/api/2/apps/{app_id:int}/rules/actions",
+	"/api/2/apps/{app_id:int}/rules/actions/{action:alnum}/values",
+	"/api/2/apps/{app_id:int}/rules/sort",
+	"/api/2/connectors",
+	"/api/2/risk/rules",
+	"/api/2/risk/rules/{rule_id:int}",
+	"/api/2/risk/events",
+	"/api/2/risk/scores",
+	"/api/2/risk/verify",
+	"/api/2/mfa/users/{user_id:int}/registrations/{registration_id:int}",
+	"/api/2/mfa/users/{user_id:int}/registrations",
+	"/api/2/mfa/users/{user_id:int}/devices",
+	"/api/2/mfa/users/{user_id:int}/devices/{device_id:alnum}",
+	"/api/2/mfa/users/{user_id:int}/verifications/{verification_id:alnum}",
+	"/api/2/mfa/users/{user_id:int}/verifications",
+	"/api/2/mfa/users/{user_id:int}/factors",
+	"/api/2/mfa/users/{user_id:int}/mfa_token",
+	"/api/2/roles",
+	"/api/2/roles/{role_id:int}",
+	"/api/2/roles/{role_id:int}/apps",
+	"/api/2/roles/{role_id:int}/users",
+	"/api/2/roles/{role_id:int}/admins",
+	"/api/2/hooks",
+	"/api/2/hooks/{hook_id:int}",
+	"/api/2/hooks/{hook_id:int}/logs",
+	"/api/2/hooks/envs",
+	"/api/2/hooks/envs/{env_id:alnum}",
+	"/api/2/users",
+	"/api/2/users/{user_id:int}",
+	"/api/2/users/{user_id:int}/apps",
+	"/api/2/branding/brands",
+	"/api/2/branding/brands/{brand_id:int}",
+	"/api/2/branding/brands/{brand_id:int}/templates",
+	"/api/2/branding/brands/{brand_id:int}/templates/{template_id:int}",
+	"/api/2/branding/brands/{brand_id:int}/apps",
+	"/api/2/branding/email_settings",
+	"/api/2/branding/email_settings/test",
+	"/api/2/branding/brands/{brand_id:int}/templates/{template_type:alpha}/{locale:alpha}",
+	"/api/2/branding/brands/master/templates/{template_type:alpha}",
+}
@@ -0,0 +1,187 @@
+package utilities
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// EncodeQuery converts a query struct or map into URL query values.
+//
+// Struct fields are named by their `url` tag, or their `json` tag when there is no `url` tag, and
+// follow the json conventions: "-" skips the field, omitempty skips zero values, nil pointers are
+// always skipped and embedded structs such as BaseQueryRequest are flattened, with fields of the
+// outer struct taking precedence. Strings, integers, floats and bools are formatted with strconv,
+// time.Time values as RFC3339, and slices and arrays are joined with commas.
+// url.Values.Encode sorts the keys, so the encoded query string is deterministic.
+func EncodeQuery(query interface{}) (url.Values, error) {
+	values := url.Values{}
+	if query == nil {
+		return values, nil
+	}
+
+	v := reflect.ValueOf(query)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return values, nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if err := encodeStruct(values, v); err != nil {
+			return nil, err
+		}
+	case reflect.Map:
+		if err := encodeMap(values, v); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported query type %s", v.Type())
+	}
+	return values, nil
+}
+
+func encodeStruct(values url.Values, v reflect.Value) error {
+	t := v.Type()
+	var embedded []reflect.Value
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, ok := queryFieldName(field)
+		if !ok {
+			continue
+		}
+
+		fv := v.Field(i)
+		if field.Anonymous && name == "" {
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct && fv.Type() != timeType {
+				embedded = append(embedded, fv)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s, present, err := encodeQueryValue(fv, omitEmpty)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %w", name, err)
+		}
+		if present {
+			values.Set(name, s)
+		}
+	}
+
+	// Fields of embedded structs don't override fields of the outer struct
+	for _, ev := range embedded {
+		inner := url.Values{}
+		if err := encodeStruct(inner, ev); err != nil {
+			return err
+		}
+		for key, vals := range inner {
+			if _, exists := values[key]; !exists {
+				values[key] = vals
+			}
+		}
+	}
+	return nil
+}
+
+func encodeMap(values url.Values, v reflect.Value) error {
+	if v.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("unsupported query map key type %s", v.Type().Key())
+	}
+	for _, key := range v.MapKeys() {
+		s, present, err := encodeQueryValue(v.MapIndex(key), false)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %w", key.String(), err)
+		}
+		if present {
+			values.Set(key.String(), s)
+		}
+	}
+	return nil
+}
+
+// queryFieldName returns the query parameter name of a struct field and whether it is omitempty.
+// ok is false when the field is skipped with "-".
+func queryFieldName(field reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	tag, hasTag := field.Tag.Lookup("url")
+	if !hasTag {
+		tag = field.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, true
+}
+
+// encodeQueryValue formats a single value; present is false when the value should be left out.
+func encodeQueryValue(v reflect.Value, omitEmpty bool) (s string, present bool, err error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+		// a pointer to a zero value is an explicit value and is always sent
+		omitEmpty = false
+	}
+	if omitEmpty && v.IsZero() {
+		return "", false, nil
+	}
+
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return "", false, nil
+		}
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			part, ok, err := encodeQueryValue(v.Index(i), false)
+			if err != nil {
+				return "", false, err
+			}
+			if ok {
+				parts = append(parts, part)
+			}
+		}
+		return strings.Join(parts, ","), true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
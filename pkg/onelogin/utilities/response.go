@@ -0,0 +1,80 @@
+package utilities
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// v1Envelope is the wrapper the v1 API puts around response bodies.
+type v1Envelope struct {
+	Status     *json.RawMessage `json:"status"`
+	Pagination *v1Pagination    `json:"pagination"`
+	Data       json.RawMessage  `json:"data"`
+}
+
+// v1Pagination is the "pagination" section of the v1 envelope.
+type v1Pagination struct {
+	BeforeCursor string `json:"before_cursor"`
+	AfterCursor  string `json:"after_cursor"`
+}
+
+// apply copies the cursors to metadata, keeping those from the headers when the section has none.
+func (p *v1Pagination) apply(metadata *models.ResponseMetadata) {
+	if p.BeforeCursor != "" {
+		metadata.PrevCursor = p.BeforeCursor
+	}
+	if p.AfterCursor != "" {
+		metadata.NextCursor = p.AfterCursor
+	}
+}
+
+// DecodeResponse checks the response like CheckHTTPResponse and decodes the body into a models.Response[T].
+// Bodies wrapped in the v1 envelope are unwrapped, and a single-element v1 data array is unwrapped when T is not a slice.
+// Unsuccessful responses are returned as *olerror.APIError.
+func DecodeResponse[T any](resp *http.Response) (*models.Response[T], error) {
+	res := &models.Response[T]{}
+	metadata, err := DecodeResponseInto(resp, &res.Data)
+	if err != nil {
+		return nil, err
+	}
+	res.Metadata = metadata
+	return res, nil
+}
+
+// DecodeResponseInto is the untyped form of DecodeResponse: it decodes the body into out, which must be a
+// pointer, and returns the response metadata. The body is only checked and discarded when out is nil.
+func DecodeResponseInto(resp *http.Response, out interface{}) (models.ResponseMetadata, error) {
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return models.ResponseMetadata{}, err
+	}
+
+	metadata := ParseResponseMetadata(resp)
+	body = bytes.TrimSpace(body)
+	if out == nil || len(body) == 0 {
+		return metadata, nil
+	}
+
+	if body[0] == '{' {
+		var env v1Envelope
+		if err := json.Unmarshal(body, &env); err == nil && env.Data != nil && (env.Status != nil || env.Pagination != nil) {
+			if env.Pagination != nil {
+				env.Pagination.apply(&metadata)
+			}
+			body = env.Data
+		}
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		// v1 endpoints return single resources as a one-element array
+		var items []json.RawMessage
+		if json.Unmarshal(body, &items) != nil || len(items) != 1 || json.Unmarshal(items[0], out) != nil {
+			return models.ResponseMetadata{}, fmt.Errorf("failed to unmarshal response body into %T: %w", out, err)
+		}
+	}
+	return metadata, nil
+}
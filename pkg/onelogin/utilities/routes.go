@@ -0,0 +1,358 @@
+package utilities
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+)
+
+// Route is a compiled path template of the OneLogin API such as "/api/2/users/{user_id:int}/apps".
+// Parameters are written as {name:kind}, where kind is one of
+//
+//	int     digits only
+//	alnum   letters and digits
+//	alpha   letters only
+//	string  any non-empty segment, the default when the kind is omitted
+type Route struct {
+	Template string
+	Version  int    // API version, e.g. 2 for /api/2/...
+	Resource string // first segment after the version, e.g. "users"
+
+	segments []routeSegment
+}
+
+type paramKind int
+
+const (
+	paramString paramKind = iota
+	paramInt
+	paramAlnum
+	paramAlpha
+)
+
+var paramKinds = map[string]paramKind{
+	"":       paramString,
+	"string": paramString,
+	"int":    paramInt,
+	"alnum":  paramAlnum,
+	"alpha":  paramAlpha,
+}
+
+type routeSegment struct {
+	literal string
+	param   string
+	kind    paramKind
+}
+
+func (s routeSegment) isParam() bool {
+	return s.param != ""
+}
+
+// matches reports whether a path segment is accepted by a parameter of kind k.
+func (k paramKind) matches(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, r := range segment {
+		isDigit := r >= '0' && r <= '9'
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		switch {
+		case k == paramInt && !isDigit,
+			k == paramAlnum && !isDigit && !isLetter,
+			k == paramAlpha && !isLetter:
+			return false
+		}
+	}
+	return true
+}
+
+// ParseRoute compiles a path template.
+func ParseRoute(template string) (*Route, error) {
+	if !strings.HasPrefix(template, "/") || (len(template) > 1 && strings.HasSuffix(template, "/")) {
+		return nil, olerror.NewSDKError(fmt.Sprintf("invalid route template %q", template))
+	}
+
+	route := &Route{Template: template}
+	names := map[string]bool{}
+	for _, part := range strings.Split(template[1:], "/") {
+		if !strings.HasPrefix(part, "{") {
+			if part == "" || strings.ContainsAny(part, "{}") {
+				return nil, olerror.NewSDKError(fmt.Sprintf("invalid route template %q", template))
+			}
+			route.segments = append(route.segments, routeSegment{literal: part})
+			continue
+		}
+		if !strings.HasSuffix(part, "}") {
+			return nil, olerror.NewSDKError(fmt.Sprintf("invalid route template %q", template))
+		}
+		name, kindName := part[1:len(part)-1], ""
+		if i := strings.Index(name, ":"); i >= 0 {
+			name, kindName = name[:i], name[i+1:]
+		}
+		kind, ok := paramKinds[kindName]
+		if name == "" || !ok || names[name] {
+			return nil, olerror.NewSDKError(fmt.Sprintf("invalid parameter %q in route template %q", part, template))
+		}
+		names[name] = true
+		route.segments = append(route.segments, routeSegment{param: name, kind: kind})
+	}
+
+	if len(route.segments) >= 3 && route.segments[0].literal == "api" {
+		route.Version, _ = strconv.Atoi(route.segments[1].literal)
+		route.Resource = route.segments[2].literal
+	}
+	return route, nil
+}
+
+// Build fills the template's parameters from params and returns the path.
+// Every parameter must be given and must match its kind.
+func (r *Route) Build(params map[string]interface{}) (string, error) {
+	var b strings.Builder
+	for _, seg := range r.segments {
+		b.WriteByte('/')
+		if !seg.isParam() {
+			b.WriteString(seg.literal)
+			continue
+		}
+		value, ok := params[seg.param]
+		if !ok {
+			return "", olerror.NewSDKError(fmt.Sprintf("missing parameter %q for route %s", seg.param, r.Template))
+		}
+		s := fmt.Sprint(value)
+		if !seg.kind.matches(s) {
+			return "", olerror.NewSDKError(fmt.Sprintf("invalid value %q for parameter %q of route %s", s, seg.param, r.Template))
+		}
+		b.WriteString(s)
+	}
+	return b.String(), nil
+}
+
+// Params returns the parameter names of the template in order.
+func (r *Route) Params() []string {
+	var names []string
+	for _, seg := range r.segments {
+		if seg.isParam() {
+			names = append(names, seg.param)
+		}
+	}
+	return names
+}
+
+// RouteTable is a trie of compiled routes used to validate and identify API paths.
+// It is safe for concurrent use.
+type RouteTable struct {
+	mu   sync.RWMutex
+	root *routeNode
+}
+
+type routeNode struct {
+	literals map[string]*routeNode
+	params   []*routeParamNode
+	route    *Route
+}
+
+type routeParamNode struct {
+	segment routeSegment
+	node    *routeNode
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{literals: map[string]*routeNode{}}
+}
+
+// NewRouteTable returns a table holding the given templates.
+func NewRouteTable(templates ...string) (*RouteTable, error) {
+	t := &RouteTable{root: newRouteNode()}
+	for _, template := range templates {
+		if _, err := t.Register(template); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Register compiles template and adds it to the table. Registering a template twice returns the
+// existing route, while a template that only differs from a registered one in its parameter names
+// is rejected, since paths can't tell them apart.
+func (t *RouteTable) Register(template string) (*Route, error) {
+	route, err := ParseRoute(template)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	node := t.root
+	for _, seg := range route.segments {
+		if !seg.isParam() {
+			next, ok := node.literals[seg.literal]
+			if !ok {
+				next = newRouteNode()
+				node.literals[seg.literal] = next
+			}
+			node = next
+			continue
+		}
+
+		var next *routeNode
+		for _, p := range node.params {
+			// parameters of the same kind share a node whatever their name
+			if p.segment.kind == seg.kind {
+				next = p.node
+				break
+			}
+		}
+		if next == nil {
+			next = newRouteNode()
+			node.params = append(node.params, &routeParamNode{segment: seg, node: next})
+		}
+		node = next
+	}
+	if node.route == nil {
+		node.route = route
+	} else if !sameParams(node.route.Params(), route.Params()) {
+		return nil, olerror.NewSDKError(fmt.Sprintf("route template %q conflicts with %q", template, node.route.Template))
+	}
+	return node.route, nil
+}
+
+// Unregister removes the route of template from the table and reports whether it was registered.
+func (t *RouteTable) Unregister(template string) bool {
+	route, err := ParseRoute(template)
+	if err != nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	node := t.root
+	for _, seg := range route.segments {
+		var next *routeNode
+		if !seg.isParam() {
+			next = node.literals[seg.literal]
+		} else {
+			for _, p := range node.params {
+				if p.segment.kind == seg.kind {
+					next = p.node
+					break
+				}
+			}
+		}
+		if next == nil {
+			return false
+		}
+		node = next
+	}
+	if node.route == nil || !sameParams(node.route.Params(), route.Params()) {
+		return false
+	}
+	node.route = nil
+	return true
+}
+
+func sameParams(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Match returns the route that path belongs to and the values of its parameters.
+// Literal segments take precedence over parameters, so "/api/2/hooks/envs" is not matched as a hook ID.
+func (t *RouteTable) Match(path string) (*Route, map[string]string, bool) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, nil, false
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	route, values := t.root.match(strings.Split(path[1:], "/"), nil)
+	if route == nil {
+		return nil, nil, false
+	}
+
+	params := map[string]string{}
+	for i, name := range route.Params() {
+		params[name] = values[i]
+	}
+	return route, params, true
+}
+
+func (n *routeNode) match(segments []string, values []string) (*Route, []string) {
+	if len(segments) == 0 {
+		return n.route, values
+	}
+	head, rest := segments[0], segments[1:]
+	if next, ok := n.literals[head]; ok {
+		if route, vals := next.match(rest, values); route != nil {
+			return route, vals
+		}
+	}
+	for _, p := range n.params {
+		if !p.segment.kind.matches(head) {
+			continue
+		}
+		if route, vals := p.node.match(rest, append(values[:len(values):len(values)], head)); route != nil {
+			return route, vals
+		}
+	}
+	return nil, nil
+}
+
+// Routes returns the registered routes ordered by template.
+func (t *RouteTable) Routes() []*Route {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var routes []*Route
+	var walk func(n *routeNode)
+	walk = func(n *routeNode) {
+		if n.route != nil {
+			routes = append(routes, n.route)
+		}
+		for _, next := range n.literals {
+			walk(next)
+		}
+		for _, p := range n.params {
+			walk(p.node)
+		}
+	}
+	walk(t.root)
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Template < routes[j].Template })
+	return routes
+}
+
+// DefaultRoutes holds the OneLogin API routes known to the SDK. BuildAPIPath only builds paths that
+// match one of them.
+var DefaultRoutes = mustRouteTable(defaultRouteTemplates...)
+
+func mustRouteTable(templates ...string) *RouteTable {
+	t, err := NewRouteTable(templates...)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// RegisterRoute adds a route to DefaultRoutes, e.g. for an endpoint the SDK doesn't know about yet.
+func RegisterRoute(template string) (*Route, error) {
+	return DefaultRoutes.Register(template)
+}
+
+// UnregisterRoute removes a route added to DefaultRoutes and reports whether it was registered.
+func UnregisterRoute(template string) bool {
+	return DefaultRoutes.Unregister(template)
+}
+
+// LookupRoute returns the route of DefaultRoutes that path belongs to and its parameter values.
+func LookupRoute(path string) (*Route, map[string]string, bool) {
+	return DefaultRoutes.Match(path)
+}
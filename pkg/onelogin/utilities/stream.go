@@ -0,0 +1,207 @@
+package utilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// StreamDecoder decodes the items of a list response one at a time, so the body is never held in memory
+// as a whole. It accepts a JSON array and the v1 envelope, whose "data" array is streamed while its
+// "pagination" cursors are copied to the metadata.
+//
+//	d, err := utilities.NewStreamDecoder[models.User](resp)
+//	if err != nil {
+//		return err
+//	}
+//	defer d.Close()
+//	for d.Next() {
+//		user := d.Item()
+//		// ...
+//	}
+//	return d.Err()
+type StreamDecoder[T any] struct {
+	body     io.ReadCloser
+	dec      *json.Decoder
+	metadata models.ResponseMetadata
+	object   bool
+	seenData bool
+	done     bool
+	item     T
+	err      error
+}
+
+// NewStreamDecoder checks the response like CheckHTTPResponse and returns a decoder positioned at the
+// first item. Unsuccessful responses are returned as *olerror.APIError.
+func NewStreamDecoder[T any](resp *http.Response) (*StreamDecoder[T], error) {
+	if !isSuccessful(resp) {
+		_, err := readResponseBody(resp)
+		return nil, err
+	}
+
+	d := &StreamDecoder[T]{
+		body:     resp.Body,
+		dec:      json.NewDecoder(resp.Body),
+		metadata: ParseResponseMetadata(resp),
+	}
+	if err := d.open(); err != nil {
+		d.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+// open reads up to the opening bracket of the items array.
+func (d *StreamDecoder[T]) open() error {
+	tok, err := d.dec.Token()
+	if err == io.EOF {
+		// empty body, e.g. 204 No Content
+		d.done = true
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	switch tok {
+	case json.Delim('['):
+		return nil
+	case json.Delim('{'):
+		d.object = true
+		return d.readKeys()
+	}
+	return olerror.NewSDKError("response does not contain a list of items")
+}
+
+// readKeys reads the keys of the envelope until it reaches the "data" array or the end of the object.
+func (d *StreamDecoder[T]) readKeys() error {
+	for d.dec.More() {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response body: %w", err)
+		}
+
+		switch key, _ := tok.(string); {
+		case key == "data" && !d.seenData:
+			d.seenData = true
+			tok, err := d.dec.Token()
+			if err != nil {
+				return fmt.Errorf("failed to decode response body: %w", err)
+			}
+			if tok == json.Delim('[') {
+				return nil
+			}
+			if tok != nil {
+				return olerror.NewSDKError("response does not contain a list of items")
+			}
+		case key == "pagination":
+			var pagination *v1Pagination
+			if err := d.dec.Decode(&pagination); err != nil {
+				return fmt.Errorf("failed to decode response body: %w", err)
+			}
+			if pagination != nil {
+				pagination.apply(&d.metadata)
+			}
+		default:
+			var skip json.RawMessage
+			if err := d.dec.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to decode response body: %w", err)
+			}
+		}
+	}
+
+	// closing brace of the envelope
+	if _, err := d.dec.Token(); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	if !d.seenData {
+		return olerror.NewSDKError("response does not contain a list of items")
+	}
+	d.done = true
+	return nil
+}
+
+// Next decodes the next item and reports whether one is available. The body is closed once the
+// last item has been read. Use Item to read it and Err to check for a failure once Next returns false.
+func (d *StreamDecoder[T]) Next() bool {
+	if d.done || d.err != nil {
+		return false
+	}
+
+	if d.dec.More() {
+		var item T
+		if err := d.dec.Decode(&item); err != nil {
+			d.fail(fmt.Errorf("failed to unmarshal response item into %T: %w", item, err))
+			return false
+		}
+		d.item = item
+		return true
+	}
+
+	// closing bracket of the array, followed by the rest of the envelope
+	if _, err := d.dec.Token(); err != nil {
+		d.fail(fmt.Errorf("failed to decode response body: %w", err))
+		return false
+	}
+	if d.object {
+		if err := d.readKeys(); err != nil {
+			d.fail(err)
+			return false
+		}
+	}
+	d.done = true
+	d.Close()
+	return false
+}
+
+func (d *StreamDecoder[T]) fail(err error) {
+	d.err = err
+	d.Close()
+}
+
+// Item returns the current item after a successful call to Next.
+func (d *StreamDecoder[T]) Item() T {
+	return d.item
+}
+
+// Metadata returns the pagination and rate limit information of the response. Cursors that the v1
+// envelope lists after its data are only known once Next has returned false.
+func (d *StreamDecoder[T]) Metadata() models.ResponseMetadata {
+	return d.metadata
+}
+
+// Err returns the error that stopped the decoder, if any.
+func (d *StreamDecoder[T]) Err() error {
+	return d.err
+}
+
+// Close closes the response body. It is safe to call more than once.
+func (d *StreamDecoder[T]) Close() error {
+	if d.body == nil {
+		return nil
+	}
+	body := d.body
+	d.body = nil
+	return body.Close()
+}
+
+// StreamResponse decodes the items of a list response one at a time and passes each to fn.
+// It stops at the first error returned by fn and returns it. The body is always closed.
+func StreamResponse[T any](resp *http.Response, fn func(T) error) (models.ResponseMetadata, error) {
+	d, err := NewStreamDecoder[T](resp)
+	if err != nil {
+		return models.ResponseMetadata{}, err
+	}
+	defer d.Close()
+
+	for d.Next() {
+		if err := fn(d.Item()); err != nil {
+			return d.Metadata(), err
+		}
+	}
+	return d.Metadata(), d.Err()
+}
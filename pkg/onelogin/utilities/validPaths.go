@@ -1,107 +0,0 @@
-package utilities
-
-// A list of regex patterns for valid paths
-var validPaths = []string{
-	"^/api/2/api_authorizations$",
-	"^/api/2/api_authorizations/[0-9]+$",
-	"^/api/2/api_authorizations/[0-9]+/scopes$",
-	"^/api/2/api_authorizations/[0-9]+/scopes/[0-9]+$",
-	"^/api/2/api_authorizations/[0-9]+/claims$",
-	"^/api/2/api_authorizations/[0-9]+/claims/[0-9]+$",
-	"^/api/2/api_authorizations/[0-9]+/clients$",
-	"^/api/2/api_authorizations/[0-9]+/clients/[0-9]+$",
-	"^/api/1/events$",
-	"^/api/1/events/[0-9]+$",
-	"^/api/1/events/types$",
-	"^/api/1/groups$",
-	"^/api/1/groups/[0-9]+$",
-	"^/api/1/invites/get_invite_link$",
-	"^/api/1/invites/send_invite_link$",
-	"^/api/1/users$",
-	"^/api/1/users/[0-9]+$",
-	"^/api/1/users/[0-9]+/apps$",
-	"^/api/1/users/[0-9]+/roles$",
-	"^/api/1/users/set_password_clear_text/[0-9]+$",
-	"^/api/1/users/set_password_using_salt/[0-9]+$",
-	"^/api/1/users/custom_attributes$",
-	"^/api/1/users/[0-9]+/set_custom_attributes$",
-	"^/api/1/users/[0-9]+/auth_factor$",
-	"^/api/1/users/[0-9]+/logout$",
-	"^/api/1/users/[0-9]+/lock_user$",
-	"^/api/1/users/[0-9]+/otp_devices$",
-	"^/api/1/users/[0-9]+/mfa_token$",
-	"^/api/1/users/[0-9]+/add_roles$",
-	"^/api/1/users/[0-9]+/set_state$",
-	"^/api/1/users/[0-9]+/remove_roles$",
-	"^/api/1/users/[0-9]+/otp_devices/[0-9]+$",
-	"^/api/1/users/[0-9]+/otp_devices/[0-9]+/verify$",
-	"^/api/1/users/[0-9]+/otp_devices/[0-9]+/trigger$",
-	"^/api/1/privileges$",
-	"^/api/1/privileges/[0-9]+$",
-	"^/api/1/privileges/[0-9]+/roles$",
-	"^/api/1/privileges/[0-9]+/roles/[0-9]+$",
-	"^/api/1/privileges/[0-9]+/users$",
-	"^/api/1/privileges/[0-9]+/users/[0-9]+$",
-	"^/api/1/roles$",
-	"^/api/1/roles/[0-9]+$",
-	"^/api/1/saml_assertion$",
-	"^/api/1/saml_assertion/verify_factor$",
-	"^/api/2/saml_assertion$",
-	"^/api/2/saml_assertion/verify_factor$",
-	"^/api/2/mappings$",
-	"^/api/2/mappings/[0-9]+$",
-	"^/api/2/mappings/conditions$",
-	"^/api/2/mappings/conditions/[a-zA-Z0-9]+/operators$",
-	"^/api/2/mappings/conditions/[a-zA-Z0-9]+/values$",
-	"^/api/2/mappings/actions$",
-	"^/api/2/mappings/actions/[a-zA-Z0-9]+/values$",
-	"^/api/2/mappings/sort$",
-	"^/api/2/apps$",
-	"^/api/2/apps/[0-9]+$",
-	"^/api/2/apps/[0-9]+/parameters/[a-zA-Z0-9]+$",
-	"^/api/2/apps/[0-9]+/users$",
-	"^/api/2/apps/[0-9]+/rules$",
-	"^/api/2/apps/[0-9]+/rules/[a-zA-Z0-9]+$",
-	"^/api/2/apps/[0-9]+/rules/conditions$",
-	"^/api/2/apps/[0-9]+/rules/conditions/[a-zA-Z0-9]+/operators$",
-	"^/api/2/apps/[0-9]+/rules/conditions/[a-zA-Z0-9]+/values$",
-	"^/api/2/apps/[0-9]+/rules/actions$",
-	"^/api/2/apps/[0-9]+/rules/actions/[a-zA-Z0-9]+/values$",
-	"^/api/2/apps/[0-9]+/rules/sort$",
-	"^/api/2/connectors$",
-	"^/api/2/risk/rules$",
-	"^/api/2/risk/rules/[0-9]+$",
-	"^/api/2/risk/events$",
-	"^/api/2/risk/scores$",
-	"^/api/2/risk/verify$",
-	"^/api/2/mfa/users/[0-9]+/registrations/[0-9]+$",
-	"^/api/2/mfa/users/[0-9]+/registrations$",
-	"^/api/2/mfa/users/[0-9]+/devices$",
-	"^/api/2/mfa/users/[0-9]+/devices/[a-zA-Z0-9]+$",
-	"^/api/2/mfa/users/[0-9]+/verifications/[a-zA-Z0-9]+$",
-	"^/api/2/mfa/users/[0-9]+/verifications$",
-	"^/api/2/mfa/users/[0-9]+/factors$",
-	"^/api/2/mfa/users/[0-9]+/mfa_token$",
-	"^/api/2/roles$",
-	"^/api/2/roles/[0-9]+$",
-	"^/api/2/roles/[0-9]+/apps$",
-	"^/api/2/roles/[0-9]+/users$",
-	"^/api/2/roles/[0-9]+/admins$",
-	"^/api/2/hooks$",
-	"^/api/2/hooks/[0-9]+$",
-	"^/api/2/hooks/[0-9]+/logs$",
-	"^/api/2/hooks/envs$",
-	"^/api/2/hooks/envs/[a-zA-Z0-9]+$",
-	"^/api/2/users$",
-	"^/api/2/users/[0-9]+$",
-	"^/api/2/users/[0-9]+/apps$",
-	"^/api/2/branding/brands$",
-	"^/api/2/branding/brands/[0-9]+$",
-	"^/api/2/branding/brands/[0-9]+/templates$",
-	"^/api/2/branding/brands/[0-9]+/templates/[0-9]+$",
-	"^/api/2/branding/brands/[0-9]+/apps$",
-	"^/api/2/branding/email_settings$",
-	"^/api/2/branding/email_settings/test$",
-	"^/api/2/branding/brands/[0-9]+/templates/[a-zA-Z]+/[a-zA-Z]+$",
-	"^/api/2/branding/brands/master/templates/[a-zA-Z]+$",
-}
@@ -2,11 +2,12 @@ package utilities
 
 import (
 	"reflect"
-	"regexp"
 	"strings"
 )
 
 // ValidateQueryParams validates the query parameters based on the provided validators.
+//
+// Deprecated: use models.ValidateQuery, which also checks the `validate` tags and reports every invalid field.
 func ValidateQueryParams(query interface{}, validators map[string]func(interface{}) bool) bool {
 	queryValue := reflect.ValueOf(query)
 	if queryValue.Kind() == reflect.Ptr {
@@ -41,13 +42,8 @@ func ValidateQueryParams(query interface{}, validators map[string]func(interface
 	return true
 }
 
-// Check if the constructed path matches any of the allowed path patterns
+// Check if the constructed path matches any of the routes in DefaultRoutes
 func IsPathValid(path string) bool {
-	for _, pattern := range validPaths {
-		match, _ := regexp.MatchString(pattern, path)
-		if match {
-			return true
-		}
-	}
-	return false
+	_, _, ok := DefaultRoutes.Match(path)
+	return ok
 }
@@ -6,9 +6,9 @@ import (
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
 	"io/ioutil"
 	"net/http"
-	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
 )
@@ -48,29 +48,47 @@ func parseResponseHeadersToMetadata(resp *http.Response) models.ResponseWithMeta
 	return res
 }
 
-// receive http response, check error code status, if good return json of resp.Body
-// else return error
-func CheckHTTPResponse(resp *http.Response) (*models.ResponseWithMetadata, error) {
-	// Check if the request was successful
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
-		errMessage := fmt.Sprintf("request failed with status: %d", resp.StatusCode)
-		body, err := ioutil.ReadAll(resp.Body)
-		if err == nil {
-			errMessage = fmt.Sprintf("Error data: %v. %s", string(body), errMessage)
+// ParseResponseMetadata extracts pagination and rate limit information from the response headers.
+func ParseResponseMetadata(resp *http.Response) models.ResponseMetadata {
+	return parseResponseHeadersToMetadata(resp).Metadata
+}
+
+// RetryAfter returns how long the server asked the client to wait before sending another request.
+// The Retry-After header (seconds or HTTP date) is preferred; otherwise X-RateLimit-Reset is used
+// when the rate limit budget is exhausted.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(ra); err == nil {
+			wait := time.Until(date)
+			if wait < 0 {
+				wait = 0
+			}
+			return wait, true
 		}
-		return nil, fmt.Errorf(errMessage)
 	}
 
-	// Read the response body
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+	if resp.Header.Get("X-RateLimit-Reset") == "" {
+		return 0, false
+	}
+	md := parseResponseHeadersToMetadata(resp).Metadata
+	if md.RateLimitRemaining > 0 && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if md.RateLimitReset < 0 {
+		return 0, false
 	}
+	return time.Duration(md.RateLimitReset) * time.Second, true
+}
 
-	// Close the response body
-	err = resp.Body.Close()
+// receive http response, check error code status, if good return json of resp.Body
+// else return an *olerror.APIError describing the failure
+func CheckHTTPResponse(resp *http.Response) (*models.ResponseWithMetadata, error) {
+	body, err := readResponseBody(resp)
 	if err != nil {
-		return nil, fmt.Errorf("failed to close response body: %w", err)
+		return nil, err
 	}
 
 	// Try to unmarshal the response body into a map[string]interface{} or []interface{}
@@ -115,6 +133,34 @@ func CheckHTTPResponse(resp *http.Response) (*models.ResponseWithMetadata, error
 	return &res, nil
 }
 
+// readResponseBody reads and closes the response body, returning an *olerror.APIError for unsuccessful responses.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	// Check if the request was successful
+	if !isSuccessful(resp) {
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, NewAPIErrorFromResponse(resp, body)
+	}
+
+	// Read the response body
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// Close the response body
+	err = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to close response body: %w", err)
+	}
+	return body, nil
+}
+
+// isSuccessful reports whether resp has one of the status codes the API uses for success.
+func isSuccessful(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusNoContent
+}
+
 func BuildAPIPath(parts ...interface{}) (string, error) {
 	var path string
 	for _, part := range parts {
@@ -137,14 +183,14 @@ func BuildAPIPath(parts ...interface{}) (string, error) {
 	return path, nil
 }
 
-// AddQueryToPath adds the model as a JSON-encoded query parameter to the path and returns the new path.
+// AddQueryToPath encodes the query with EncodeQuery, appends it to the path and returns the new path.
 func AddQueryToPath(path string, query interface{}) (string, error) {
 	if query == nil {
 		return path, nil
 	}
 
 	// Convert query parameters to URL-encoded string
-	values, err := queryToValues(query)
+	values, err := EncodeQuery(query)
 	if err != nil {
 		return "", err
 	}
@@ -156,24 +202,3 @@ func AddQueryToPath(path string, query interface{}) (string, error) {
 
 	return path, nil
 }
-
-func queryToValues(query interface{}) (url.Values, error) {
-	values := url.Values{}
-
-	// Convert query parameters to URL-encoded string
-	if query != nil {
-		queryBytes, err := json.Marshal(query)
-		if err != nil {
-			return nil, err
-		}
-		var data map[string]string
-		if err := json.Unmarshal(queryBytes, &data); err != nil {
-			return nil, err
-		}
-		for key, value := range data {
-			values.Set(key, value)
-		}
-	}
-
-	return values, nil
-}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"bytes"
+	"context"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/mocks"
 	"io/ioutil"
 	"net/http"
@@ -101,3 +102,22 @@ func TestClientDeleteWithBody(t *testing.T) {
 		t.Fatalf("Expected ``, got %s", string(body))
 	}
 }
+
+func TestClientGetWithContext(t *testing.T) {
+	client := mocks.CreateMockClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client.HttpClient.(*mocks.MockHttpClient).DoFunc = func(req *http.Request) (*http.Response, error) {
+		if req.Context() != ctx {
+			t.Fatalf("Expected request to carry the caller's context")
+		}
+		return nil, req.Context().Err()
+	}
+
+	_, err := client.GetWithContext(ctx, new(string), nil)
+	if err != context.Canceled {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+}
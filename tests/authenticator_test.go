@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// stubTokenEndpoint replaces the default transport for the duration of the test with one
+// that issues numbered tokens valid for expiresIn seconds.
+func stubTokenEndpoint(t *testing.T, expiresIn int, delay time.Duration) *int32 {
+	var calls int32
+	original := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&calls, 1)
+		time.Sleep(delay)
+		body := fmt.Sprintf(`{"access_token":"token-%d","account_id":42,"expires_in":%d}`, n, expiresIn)
+		return jsonResponse(http.StatusOK, nil, body), nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = original })
+	return &calls
+}
+
+func testCredentials() *models.APICredentials {
+	return &models.APICredentials{ClientID: "id", ClientSecret: "secret", Subdomain: "test"}
+}
+
+func TestAuthenticatorCollapsesConcurrentRefreshes(t *testing.T) {
+	calls := stubTokenEndpoint(t, 3600, 50*time.Millisecond)
+	auth := authentication.NewAuthenticator("test", testCredentials())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := auth.GenerateToken(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(calls); n != 1 {
+		t.Fatalf("Expected 1 token request, got %d", n)
+	}
+	if tk, _ := auth.GetToken(); tk != "token-1" {
+		t.Fatalf("Expected token-1, got %s", tk)
+	}
+	if auth.GetAccountId() != "42" {
+		t.Fatalf("Expected account ID 42, got %s", auth.GetAccountId())
+	}
+}
+
+func TestAuthenticatorRefreshesAheadOfExpiry(t *testing.T) {
+	calls := stubTokenEndpoint(t, 30, 0)
+	auth := authentication.NewAuthenticator("test", testCredentials())
+
+	if err := auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+	if until := time.Until(auth.ExpiresAt()); until <= 0 || until > 30*time.Second {
+		t.Fatalf("Unexpected expiry %v", auth.ExpiresAt())
+	}
+
+	// The token expires inside the refresh window, so GetToken must fetch a new one
+	tk, err := auth.GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk != "token-2" {
+		t.Fatalf("Expected token-2, got %s", tk)
+	}
+	if n := atomic.LoadInt32(calls); n != 2 {
+		t.Fatalf("Expected 2 token requests, got %d", n)
+	}
+}
+
+func TestAuthenticatorReusesValidToken(t *testing.T) {
+	calls := stubTokenEndpoint(t, 3600, 0)
+	auth := authentication.NewAuthenticator("test", testCredentials())
+
+	if err := auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if tk, err := auth.GetToken(); err != nil || tk != "token-1" {
+				t.Errorf("Expected token-1, got %s (%v)", tk, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(calls); n != 1 {
+		t.Fatalf("Expected 1 token request, got %d", n)
+	}
+}
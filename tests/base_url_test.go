@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	utl "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+func TestBaseURL(t *testing.T) {
+	tests := []struct {
+		region, subdomain, want string
+	}{
+		{"", "acme", "https://acme.onelogin.com"},
+		{"", "Acme-Dev", "https://acme-dev.onelogin.com"},
+		{"eu", "acme", "https://api.eu.onelogin.com"},
+		{"EU", "", "https://api.eu.onelogin.com"},
+		{"us", "acme", "https://api.us.onelogin.com"},
+	}
+	for _, tt := range tests {
+		got, err := utl.BaseURL(tt.region, tt.subdomain)
+		if err != nil || got != tt.want {
+			t.Fatalf("Expected %s for region %q and subdomain %q, got %s (%v)", tt.want, tt.region, tt.subdomain, got, err)
+		}
+	}
+}
+
+func TestBaseURLRejectsInvalidSettings(t *testing.T) {
+	tests := []struct {
+		region, subdomain string
+	}{
+		{"", ""},
+		{"", "acme.onelogin.com"},
+		{"", "evil.example/"},
+		{"", "acme@evil"},
+		{"", "-acme"},
+		{"ap", "acme"},
+		{"eu", "acme/../x"},
+	}
+	for _, tt := range tests {
+		if _, err := utl.BaseURL(tt.region, tt.subdomain); !olerror.IsValidation(err) {
+			t.Fatalf("Expected a validation error for region %q and subdomain %q, got %v", tt.region, tt.subdomain, err)
+		}
+	}
+}
+
+func TestNormalizeBaseURL(t *testing.T) {
+	if got, err := utl.NormalizeBaseURL("https://sso.example.com/"); err != nil || got != "https://sso.example.com" {
+		t.Fatalf("Expected the trailing slash to be removed, got %s (%v)", got, err)
+	}
+	if got, err := utl.NormalizeBaseURL("http://127.0.0.1:8080/onelogin"); err != nil || got != "http://127.0.0.1:8080/onelogin" {
+		t.Fatalf("Expected the URL to be kept, got %s (%v)", got, err)
+	}
+	for _, baseURL := range []string{"acme.onelogin.com", "ftp://acme.onelogin.com", "https://acme.onelogin.com?x=1", "https://"} {
+		if _, err := utl.NormalizeBaseURL(baseURL); !olerror.IsValidation(err) {
+			t.Fatalf("Expected a validation error for %q, got %v", baseURL, err)
+		}
+	}
+}
+
+func TestNewClientRegion(t *testing.T) {
+	clearCredentialsEnv(t)
+	client, err := api.NewClient(api.WithLazyAuth(), api.WithCredentials(testCredentials()), api.WithRegion("eu"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := client.(*api.Client)
+	if c.OLdomain != "https://api.eu.onelogin.com" {
+		t.Fatalf("Expected https://api.eu.onelogin.com, got %s", c.OLdomain)
+	}
+
+	if _, err := api.NewClient(api.WithLazyAuth(), api.WithCredentials(testCredentials()), api.WithRegion("mars")); !olerror.IsValidation(err) {
+		t.Fatalf("Expected an unknown region to be rejected, got %v", err)
+	}
+	bad := &models.APICredentials{ClientID: "id", ClientSecret: "secret", Subdomain: "acme.onelogin.com"}
+	if _, err := api.NewClient(api.WithLazyAuth(), api.WithCredentials(bad)); !olerror.IsValidation(err) {
+		t.Fatalf("Expected a full host name to be rejected as subdomain, got %v", err)
+	}
+}
+
+func TestBaseURLOverridesRegion(t *testing.T) {
+	server := newOAuthStandIn(t)
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithRegion("eu"),
+		onelogin.WithBaseURL(server.URL+"/"),
+		onelogin.WithHTTPClient(server.Client()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain := sdk.Client.(*api.Client).OLdomain; domain != server.URL {
+		t.Fatalf("Expected %s, got %s", server.URL, domain)
+	}
+	if issued, _ := server.counts(); issued != 1 {
+		t.Fatalf("Expected the token to be requested from the stand-in, got %d requests", issued)
+	}
+}
+
+func TestAuthenticatorRejectsInvalidSubdomain(t *testing.T) {
+	auth := authentication.NewAuthenticator("acme.example.com/steal", testCredentials())
+	err := auth.GenerateToken()
+	var validation *olerror.ValidationError
+	if !errors.As(err, &validation) || validation.Fields[0].Field != "subdomain" {
+		t.Fatalf("Expected the subdomain to be rejected before any request, got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package tests
+
+import (
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+func TestResponseCacheServesRepeatedGets(t *testing.T) {
+	var requests int32
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		return jsonResponse(http.StatusOK, nil, `[{"id": 1, "name": "Admins"}]`), nil
+	})
+	sdk.Client.(*api.Client).Cache = api.NewResponseCache(map[string]time.Duration{"/api/2/roles": time.Minute})
+
+	for i := 0; i < 3; i++ {
+		roles, err := sdk.GetRoles(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if roles == nil {
+			t.Fatal("Expected the roles")
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("Expected 1 request, got %d", n)
+	}
+
+	// paths without a TTL are not cached
+	sdk.GetApps(nil)
+	sdk.GetApps(nil)
+	if n := atomic.LoadInt32(&requests); n != 3 {
+		t.Fatalf("Expected uncached paths to be requested every time, got %d requests", n)
+	}
+}
+
+func TestResponseCacheRevalidatesWithETag(t *testing.T) {
+	var requests, revalidations int32
+	cache := api.NewResponseCache(nil)
+	cache.DefaultTTL = 20 * time.Millisecond
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&revalidations, 1)
+			return jsonResponse(http.StatusNotModified, nil, ""), nil
+		}
+		return jsonResponse(http.StatusOK, http.Header{"Etag": {`"v1"`}}, `[{"id": 1}]`), nil
+	})
+	sdk.Client.(*api.Client).Cache = cache
+
+	if _, err := sdk.GetRoles(nil); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(40 * time.Millisecond)
+	roles, err := sdk.GetRoles(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if roles == nil {
+		t.Fatal("Expected the cached roles after a 304")
+	}
+	if requests != 2 || revalidations != 1 {
+		t.Fatalf("Expected 1 revalidation out of 2 requests, got %d of %d", revalidations, requests)
+	}
+
+	// the revalidated response is fresh again
+	if _, err := sdk.GetRoles(nil); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Fatalf("Expected the revalidated response to be served from the cache, got %d requests", requests)
+	}
+}
+
+func TestResponseCacheInvalidatesAfterMutation(t *testing.T) {
+	var gets int32
+	cache := api.NewResponseCache(map[string]time.Duration{"/api/2": time.Minute})
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			atomic.AddInt32(&gets, 1)
+		}
+		return jsonResponse(http.StatusOK, nil, `[{"id": 1}]`), nil
+	})
+	sdk.Client.(*api.Client).Cache = cache
+
+	sdk.GetRoles(nil)
+	sdk.GetRoleByID(1, nil)
+	sdk.GetApps(nil)
+	if gets != 3 {
+		t.Fatalf("Expected 3 requests, got %d", gets)
+	}
+
+	name := "Owners"
+	if _, err := sdk.UpdateRole(1, models.Role{Name: &name}, nil); err != nil {
+		t.Fatal(err)
+	}
+	sdk.GetRoles(nil)
+	sdk.GetRoleByID(1, nil)
+	sdk.GetApps(nil)
+	if gets != 5 {
+		t.Fatalf("Expected the roles to be fetched again and the apps to stay cached, got %d requests", gets)
+	}
+}
+
+func TestResponseCacheSeparatesClientsSharingStorage(t *testing.T) {
+	storage := api.NewMemoryCacheStorage(0)
+	var gets int32
+	tenantSDK := func(subdomain string) *onelogin.OneloginSDK {
+		sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				atomic.AddInt32(&gets, 1)
+			}
+			return jsonResponse(http.StatusOK, nil, `{"tenant": "`+subdomain+`"}`), nil
+		})
+		sdk.Client.(*api.Client).Cache = &api.ResponseCache{Storage: storage, DefaultTTL: time.Minute}
+		// both tenants use the same regional host, but authenticate with their own credentials
+		client := sdk.Client.(*api.Client)
+		client.Auth = authentication.NewAuthenticator(subdomain, &models.APICredentials{ClientID: subdomain + "-id", ClientSecret: "secret"})
+		return sdk
+	}
+	stubTokenEndpoint(t, 3600, 0)
+	acme, globex := tenantSDK("acme"), tenantSDK("globex")
+
+	get := func(sdk *onelogin.OneloginSDK) string {
+		path := "/api/2/roles"
+		resp, err := sdk.Client.Get(&path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return string(body)
+	}
+	if body := get(acme); body != `{"tenant": "acme"}` {
+		t.Fatalf("Expected acme's roles, got %s", body)
+	}
+	if body := get(globex); body != `{"tenant": "globex"}` {
+		t.Fatalf("Expected globex's roles instead of acme's cached ones, got %s", body)
+	}
+	if get(acme); gets != 2 {
+		t.Fatalf("Expected acme's roles to stay cached, got %d requests", gets)
+	}
+
+	// a mutation only invalidates the responses cached for its own credentials
+	name := "Owners"
+	if _, err := globex.UpdateRole(1, models.Role{Name: &name}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if get(acme); gets != 2 {
+		t.Fatalf("Expected acme's roles to stay cached after globex's update, got %d requests", gets)
+	}
+	if get(globex); gets != 3 {
+		t.Fatalf("Expected globex's roles to be fetched again, got %d requests", gets)
+	}
+}
+
+func TestResponseCacheSkipsErrorsAndNoStore(t *testing.T) {
+	var requests int32
+	status := http.StatusInternalServerError
+	header := http.Header{}
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		return jsonResponse(status, header, `[]`), nil
+	})
+	sdk.Client.(*api.Client).Cache = api.NewResponseCache(map[string]time.Duration{"/api/2/roles": time.Minute})
+	sdk.Client.(*api.Client).RetryPolicy = nil
+
+	sdk.GetRoles(nil)
+	status = http.StatusOK
+	header.Set("Cache-Control", "no-store")
+	sdk.GetRoles(nil)
+	sdk.GetRoles(nil)
+	if requests != 3 {
+		t.Fatalf("Expected errors and no-store responses not to be cached, got %d requests", requests)
+	}
+}
+
+func TestMemoryCacheStorageEvictsOldest(t *testing.T) {
+	storage := api.NewMemoryCacheStorage(2)
+	now := time.Now()
+	storage.Set("a", &api.CachedResponse{StoredAt: now})
+	storage.Set("b", &api.CachedResponse{StoredAt: now.Add(time.Second)})
+	storage.Set("c", &api.CachedResponse{StoredAt: now.Add(2 * time.Second)})
+	if _, ok := storage.Get("a"); ok {
+		t.Fatal("Expected the oldest response to be evicted")
+	}
+	if _, ok := storage.Get("c"); !ok {
+		t.Fatal("Expected the newest response to be kept")
+	}
+}
+
+func TestResponseCacheLeavesRequestsUntouched(t *testing.T) {
+	var validators []string
+	client := mockClient(func(req *http.Request) (*http.Response, error) {
+		validators = append(validators, req.Header.Get("If-None-Match"))
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			return jsonResponse(http.StatusNotModified, nil, ""), nil
+		}
+		return jsonResponse(http.StatusOK, http.Header{"Etag": {`"v1"`}}, `[{"id": 1}]`), nil
+	})
+	client.Cache = &api.ResponseCache{Storage: api.NewMemoryCacheStorage(0), DefaultTTL: time.Nanosecond}
+
+	path := "/api/2/roles"
+	if _, err := client.Get(&path, nil); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(&path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(validators) != 2 || validators[1] != `"v1"` {
+		t.Fatalf("Expected the second request to be revalidated, got validators %q", validators)
+	}
+	if v := resp.Request.Header.Get("If-None-Match"); v != "" {
+		t.Fatalf("Expected the validator to be set on a copy of the request, got %q on the request", v)
+	}
+}
@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// clearCredentialsEnv hides credentials of the machine running the tests from the default chain.
+func clearCredentialsEnv(t *testing.T) {
+	for _, name := range []string{"ONELOGIN_CLIENT_ID", "ONELOGIN_CLIENT_SECRET", "ONELOGIN_SUBDOMAIN", "ONELOGIN_PROFILE", "ONELOGIN_CREDENTIALS_COMMAND"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("ONELOGIN_CONFIG_FILE", filepath.Join(t.TempDir(), "missing.yml"))
+	t.Setenv("ONELOGIN_SECRETS_DIR", filepath.Join(t.TempDir(), "missing"))
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChainCredentials(t *testing.T) {
+	none := authentication.StaticCredentials(nil)
+	broken := authentication.CredentialsProviderFunc(func(context.Context) (*models.APICredentials, error) {
+		return nil, errors.New("vault unavailable")
+	})
+	found := authentication.StaticCredentials(&models.APICredentials{ClientID: "id"})
+
+	creds, err := authentication.ChainCredentials(none, nil, found, broken).Credentials(context.Background())
+	if err != nil || creds.ClientID != "id" {
+		t.Fatalf("Expected the first credentials found, got %v (%v)", creds, err)
+	}
+	if _, err := authentication.ChainCredentials(none, broken, found).Credentials(context.Background()); err == nil || errors.Is(err, authentication.ErrNoCredentials) {
+		t.Fatalf("Expected the provider error to stop the chain, got %v", err)
+	}
+	if _, err := authentication.ChainCredentials(none).Credentials(context.Background()); !errors.Is(err, authentication.ErrNoCredentials) {
+		t.Fatalf("Expected ErrNoCredentials, got %v", err)
+	}
+}
+
+func TestEnvCredentials(t *testing.T) {
+	clearCredentialsEnv(t)
+	if _, err := authentication.EnvCredentials().Credentials(context.Background()); !errors.Is(err, authentication.ErrNoCredentials) {
+		t.Fatalf("Expected ErrNoCredentials, got %v", err)
+	}
+
+	t.Setenv("ONELOGIN_CLIENT_ID", "env-id")
+	t.Setenv("ONELOGIN_CLIENT_SECRET", "env-secret")
+	creds, err := authentication.DefaultCredentialsChain(nil).Credentials(context.Background())
+	if err != nil || creds.ClientID != "env-id" || creds.ClientSecret != "env-secret" {
+		t.Fatalf("Expected the environment credentials, got %v (%v)", creds, err)
+	}
+
+	creds, err = authentication.DefaultCredentialsChain(testCredentials()).Credentials(context.Background())
+	if err != nil || creds.ClientID != "id" {
+		t.Fatalf("Expected explicit credentials to take precedence, got %v (%v)", creds, err)
+	}
+}
+
+func TestProfileCredentials(t *testing.T) {
+	clearCredentialsEnv(t)
+	path := filepath.Join(t.TempDir(), "config.yml")
+	writeFile(t, path, `
+profiles:
+  default:
+    subdomain: acme
+    client_id: default-id
+    client_secret: default-secret
+  staging:
+    subdomain: acme-staging
+    client_id: staging-id
+    client_secret: staging-secret
+`)
+	t.Setenv("ONELOGIN_CONFIG_FILE", path)
+
+	creds, err := authentication.DefaultCredentialsChain(nil).Credentials(context.Background())
+	if err != nil || creds.ClientID != "default-id" || creds.Subdomain != "acme" {
+		t.Fatalf("Expected the default profile, got %v (%v)", creds, err)
+	}
+
+	t.Setenv("ONELOGIN_PROFILE", "staging")
+	creds, err = authentication.DefaultCredentialsChain(nil).Credentials(context.Background())
+	if err != nil || creds.ClientID != "staging-id" || creds.Subdomain != "acme-staging" {
+		t.Fatalf("Expected the staging profile, got %v (%v)", creds, err)
+	}
+
+	t.Setenv("ONELOGIN_PROFILE", "production")
+	if _, err := authentication.DefaultCredentialsChain(nil).Credentials(context.Background()); !errors.Is(err, authentication.ErrProfileNotFound) {
+		t.Fatalf("Expected a profile that was asked for by name to be required, got %v", err)
+	}
+}
+
+func TestFileCredentials(t *testing.T) {
+	clearCredentialsEnv(t)
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "client_id"), "mounted-id\n")
+	writeFile(t, filepath.Join(dir, "client_secret"), "mounted-secret\n")
+	t.Setenv("ONELOGIN_SECRETS_DIR", dir)
+
+	creds, err := authentication.DefaultCredentialsChain(nil).Credentials(context.Background())
+	if err != nil || creds.ClientID != "mounted-id" || creds.ClientSecret != "mounted-secret" || creds.Subdomain != "" {
+		t.Fatalf("Expected the mounted credentials, got %v (%v)", creds, err)
+	}
+
+	os.Remove(filepath.Join(dir, "client_secret"))
+	if _, err := authentication.FileCredentials(dir).Credentials(context.Background()); err == nil {
+		t.Fatal("Expected a missing client_secret file to be reported")
+	}
+}
+
+// TestCredentialsHelperProcess is run as the credentials command by TestCommandCredentials. Every run
+// is recorded in the file named by CREDENTIALS_HELPER_LOG, if set.
+func TestCredentialsHelperProcess(t *testing.T) {
+	if path := os.Getenv("CREDENTIALS_HELPER_LOG"); path != "" && os.Getenv("CREDENTIALS_HELPER") != "" {
+		if f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err == nil {
+			f.WriteString("run\n")
+			f.Close()
+		}
+	}
+	switch os.Getenv("CREDENTIALS_HELPER") {
+	case "ok":
+		fmt.Print(`{"client_id": "command-id", "client_secret": "command-secret", "subdomain": "acme"}`)
+		os.Exit(0)
+	case "fail":
+		fmt.Fprint(os.Stderr, "not logged in")
+		os.Exit(1)
+	}
+}
+
+func TestCommandCredentials(t *testing.T) {
+	clearCredentialsEnv(t)
+	t.Setenv("CREDENTIALS_HELPER", "ok")
+	t.Setenv("ONELOGIN_CREDENTIALS_COMMAND", os.Args[0]+" -test.run=TestCredentialsHelperProcess")
+
+	creds, err := authentication.DefaultCredentialsChain(nil).Credentials(context.Background())
+	if err != nil || creds.ClientID != "command-id" || creds.ClientSecret != "command-secret" || creds.Subdomain != "acme" {
+		t.Fatalf("Expected the command credentials, got %v (%v)", creds, err)
+	}
+
+	t.Setenv("CREDENTIALS_HELPER", "fail")
+	if _, err := authentication.CommandCredentials(os.Args[0], "-test.run=TestCredentialsHelperProcess").Credentials(context.Background()); err == nil {
+		t.Fatal("Expected a failing command to be reported")
+	}
+}
+
+func TestAuthenticatorPicksUpRotatedSecrets(t *testing.T) {
+	server := newOAuthStandIn(t)
+	secret := "expired-secret"
+	auth := authentication.NewAuthenticator("test", nil,
+		authentication.WithBaseURL(server.URL),
+		authentication.WithHTTPClient(server.Client()),
+		authentication.WithCredentialsProvider(authentication.CredentialsProviderFunc(func(context.Context) (*models.APICredentials, error) {
+			return &models.APICredentials{ClientID: "id", ClientSecret: secret}, nil
+		})),
+	)
+
+	if err := auth.GenerateToken(); err == nil {
+		t.Fatal("Expected the old secret to be rejected")
+	}
+	secret = "secret"
+	if err := auth.GenerateToken(); err != nil {
+		t.Fatalf("Expected the rotated secret to be used, got %v", err)
+	}
+}
+
+func TestNewClientTakesSubdomainFromProvider(t *testing.T) {
+	clearCredentialsEnv(t)
+	stubTokenEndpoint(t, 3600, 0)
+	provider := authentication.StaticCredentials(&models.APICredentials{ClientID: "id", ClientSecret: "secret", Subdomain: "acme"})
+
+	client, err := api.NewClient(api.WithCredentialsProvider(provider))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain := client.(*api.Client).OLdomain; domain != "https://acme.onelogin.com" {
+		t.Fatalf("Expected https://acme.onelogin.com, got %s", domain)
+	}
+
+	// with lazy auth the subdomain is taken from the provider on the first token request
+	client, err = api.NewClient(api.WithLazyAuth(), api.WithCredentialsProvider(provider))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetToken(); err != nil {
+		t.Fatal(err)
+	}
+	if domain := client.(*api.Client).Auth.BaseURL(); domain != "https://acme.onelogin.com" {
+		t.Fatalf("Expected https://acme.onelogin.com, got %s", domain)
+	}
+}
+
+func TestLazyClientDoesNotRunCredentialsCommand(t *testing.T) {
+	clearCredentialsEnv(t)
+	t.Setenv("CREDENTIALS_HELPER", "fail")
+	t.Setenv("ONELOGIN_CREDENTIALS_COMMAND", os.Args[0]+" -test.run=TestCredentialsHelperProcess")
+
+	client, err := api.NewClient(api.WithLazyAuth())
+	if err != nil {
+		t.Fatalf("Expected the credentials command not to run before the first call, got %v", err)
+	}
+	path := "/api/2/roles"
+	if _, err := client.Get(&path, nil); err == nil || !strings.Contains(err.Error(), "not logged in") {
+		t.Fatalf("Expected the first call to report the failing command, got %v", err)
+	}
+}
+
+func TestNewClientRunsCredentialsCommandOnce(t *testing.T) {
+	clearCredentialsEnv(t)
+	calls := stubTokenEndpoint(t, 3600, 0)
+	log := filepath.Join(t.TempDir(), "runs")
+	t.Setenv("CREDENTIALS_HELPER", "ok")
+	t.Setenv("CREDENTIALS_HELPER_LOG", log)
+	t.Setenv("ONELOGIN_CREDENTIALS_COMMAND", os.Args[0]+" -test.run=TestCredentialsHelperProcess")
+
+	client, err := api.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs, _ := ioutil.ReadFile(log)
+	if n := strings.Count(string(runs), "run"); n != 1 || atomic.LoadInt32(calls) != 1 {
+		t.Fatalf("Expected the command to run once for 1 token request, got %d runs and %d requests", n, atomic.LoadInt32(calls))
+	}
+
+	// later token requests ask the command again, so rotated secrets are picked up
+	if err := client.(*api.Client).Auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+	runs, _ = ioutil.ReadFile(log)
+	if n := strings.Count(string(runs), "run"); n != 2 {
+		t.Fatalf("Expected the command to run again for the next token, got %d runs", n)
+	}
+}
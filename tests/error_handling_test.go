@@ -2,8 +2,12 @@ package tests
 
 import (
 	"errors"
+	"fmt"
 	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestNewSerializationError(t *testing.T) {
@@ -83,3 +87,112 @@ func TestSDKError_Is(t *testing.T) {
 		}
 	})
 }
+
+func TestCheckHTTPResponseReturnsAPIError(t *testing.T) {
+	header := http.Header{
+		"X-Request-Id":          []string{"req-123"},
+		"X-Ratelimit-Limit":     []string{"5000"},
+		"X-Ratelimit-Remaining": []string{"4999"},
+		"X-Ratelimit-Reset":     []string{"120"},
+	}
+	resp := jsonResponse(http.StatusNotFound, header, `{"statusCode":404,"name":"NotFound","message":"User not found"}`)
+
+	_, err := utilities.CheckHTTPResponse(resp)
+
+	var apiErr *error.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an APIError, got %T: %v", err, err)
+	}
+	if apiErr.Code != http.StatusNotFound || apiErr.Type != "NotFound" || apiErr.Message != "User not found" {
+		t.Errorf("Unexpected error details: %+v", apiErr)
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("Expected request ID req-123, got %q", apiErr.RequestID)
+	}
+	if apiErr.RateLimitLimit != 5000 || apiErr.RateLimitRemaining != 4999 || apiErr.RateLimitReset != 120 {
+		t.Errorf("Unexpected rate limit details: %+v", apiErr)
+	}
+	if !error.IsNotFound(err) || error.IsConflict(err) {
+		t.Errorf("Expected IsNotFound only")
+	}
+}
+
+func TestCheckHTTPResponseParsesV1ErrorBody(t *testing.T) {
+	resp := jsonResponse(http.StatusBadRequest, nil, `{"status":{"error":true,"code":400,"type":"bad request","message":"Invalid role ids"}}`)
+
+	_, err := utilities.CheckHTTPResponse(resp)
+
+	var apiErr *error.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an APIError, got %T: %v", err, err)
+	}
+	if apiErr.Type != "bad request" || apiErr.Message != "Invalid role ids" {
+		t.Errorf("Unexpected error details: %+v", apiErr)
+	}
+	if !errors.Is(err, error.ErrBadRequest) {
+		t.Errorf("Expected errors.Is to match ErrBadRequest")
+	}
+}
+
+func TestCheckHTTPResponseParsesFieldErrors(t *testing.T) {
+	resp := jsonResponse(http.StatusUnprocessableEntity, nil, `{"statusCode":422,"name":"UnprocessableEntity","message":{"email":["has already been taken"],"username":"is invalid"}}`)
+
+	_, err := utilities.CheckHTTPResponse(resp)
+
+	var apiErr *error.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an APIError, got %T: %v", err, err)
+	}
+	expected := []error.FieldError{
+		{Field: "email", Message: "has already been taken"},
+		{Field: "username", Message: "is invalid"},
+	}
+	if len(apiErr.FieldErrors) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, apiErr.FieldErrors)
+	}
+	for i := range expected {
+		if apiErr.FieldErrors[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, apiErr.FieldErrors)
+		}
+	}
+}
+
+func TestCheckHTTPResponseNonJSONError(t *testing.T) {
+	resp := jsonResponse(http.StatusBadGateway, nil, `<html>Bad Gateway</html>`)
+
+	_, err := utilities.CheckHTTPResponse(resp)
+
+	var apiErr *error.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an APIError, got %T: %v", err, err)
+	}
+	if apiErr.Message != "<html>Bad Gateway</html>" || !errors.Is(err, error.ErrServerError) {
+		t.Errorf("Unexpected error details: %+v", apiErr)
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	// The error package shadows the builtin error type in this file, so the checks are wrapped
+	tests := []struct {
+		code  int
+		check func(e interface{ Error() string }) bool
+	}{
+		{http.StatusNotFound, func(e interface{ Error() string }) bool { return error.IsNotFound(e) }},
+		{http.StatusConflict, func(e interface{ Error() string }) bool { return error.IsConflict(e) }},
+		{http.StatusTooManyRequests, func(e interface{ Error() string }) bool { return error.IsRateLimited(e) }},
+		{http.StatusUnauthorized, func(e interface{ Error() string }) bool { return error.IsUnauthorized(e) }},
+	}
+	for _, tt := range tests {
+		err := fmt.Errorf("wrapped: %w", error.NewAPIError("failed", tt.code))
+		if !tt.check(err) {
+			t.Errorf("Expected status %d to match its sentinel check", tt.code)
+		}
+		if tt.check(error.NewAPIError("failed", http.StatusInternalServerError)) {
+			t.Errorf("Expected status 500 not to match the check for %d", tt.code)
+		}
+	}
+
+	if !error.IsRateLimited(error.NewRateLimitError("budget exhausted", time.Second)) {
+		t.Errorf("Expected client-side rate limit errors to be reported as rate limited")
+	}
+}
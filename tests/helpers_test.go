@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/mocks"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+)
+
+// mockClient returns a mock client whose API requests are answered by handler, while its token
+// requests are answered by the mock's own stub.
+func mockClient(handler func(req *http.Request) (*http.Response, error)) *api.Client {
+	client := mocks.CreateMockClient()
+	client.HttpClient.(*mocks.MockHttpClient).DoFunc = handler
+	return client
+}
+
+// mockSDK returns an SDK built on mockClient.
+func mockSDK(handler func(req *http.Request) (*http.Response, error)) *onelogin.OneloginSDK {
+	return &onelogin.OneloginSDK{Client: mockClient(handler)}
+}
+
+// jsonResponse returns a response with status, header and body. A nil header is sent as an empty one.
+func jsonResponse(status int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{StatusCode: status, Header: header, Body: ioutil.NopCloser(bytes.NewBufferString(body))}
+}
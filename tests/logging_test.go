@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/logging"
+)
+
+type logEntry struct {
+	level  string
+	msg    string
+	fields []interface{}
+}
+
+// recordingLogger keeps every entry so tests can inspect what would have been logged.
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) record(level, msg string, fields []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, logEntry{level: level, msg: msg, fields: fields})
+}
+
+func (l *recordingLogger) Debug(msg string, kv ...interface{}) { l.record("debug", msg, kv) }
+func (l *recordingLogger) Info(msg string, kv ...interface{})  { l.record("info", msg, kv) }
+func (l *recordingLogger) Warn(msg string, kv ...interface{})  { l.record("warn", msg, kv) }
+func (l *recordingLogger) Error(msg string, kv ...interface{}) { l.record("error", msg, kv) }
+
+func (l *recordingLogger) dump() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var b strings.Builder
+	for _, e := range l.entries {
+		fmt.Fprintf(&b, "%s %s %v\n", e.level, e.msg, e.fields)
+	}
+	return b.String()
+}
+
+func TestClientLogsRequestsWithRedactedHeaders(t *testing.T) {
+	logger := &recordingLogger{}
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, nil, `{}`), nil
+	})
+	client.Logger = logger
+
+	if _, err := client.Get(new(string), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	out := logger.dump()
+	if !strings.Contains(out, "sending request") || !strings.Contains(out, "received response") {
+		t.Fatalf("Expected request and response to be logged, got:\n%s", out)
+	}
+	if strings.Contains(out, "mockToken") {
+		t.Fatalf("Expected the bearer token to be redacted, got:\n%s", out)
+	}
+	if !strings.Contains(out, logging.Redacted) {
+		t.Fatalf("Expected the Authorization header to be redacted, got:\n%s", out)
+	}
+}
+
+func TestAuthenticatorLogsWithoutSecrets(t *testing.T) {
+	stubTokenEndpoint(t, 3600, 0)
+	logger := &recordingLogger{}
+	auth := authentication.NewAuthenticator("test", testCredentials(), authentication.WithLogger(logger))
+
+	if err := auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	out := logger.dump()
+	if !strings.Contains(out, "access token obtained") {
+		t.Fatalf("Expected the token request to be logged, got:\n%s", out)
+	}
+	// "aWQ6c2VjcmV0" is the base64 encoding of the test client ID and secret
+	if strings.Contains(out, "aWQ6c2VjcmV0") || strings.Contains(out, "token-1") {
+		t.Fatalf("Expected credentials and tokens to be redacted, got:\n%s", out)
+	}
+}
+
+func TestRedactString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"bearer token", "Authorization: Bearer abc.def-123", "Authorization: Bearer [REDACTED]"},
+		{"basic credentials", "Basic aWQ6c2VjcmV0", "Basic [REDACTED]"},
+		{"json secrets", `{"client_id":"id","client_secret":"s3cr3t","password":"p\"w"}`, `{"client_id":"id","client_secret":"[REDACTED]","password":"[REDACTED]"}`},
+		{"form fields", "grant_type=password&password=hunter2", "grant_type=password&password=[REDACTED]"},
+		{"plain text", "nothing to hide", "nothing to hide"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logging.RedactString(tt.input); got != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRedactValueBySensitiveKey(t *testing.T) {
+	if got := logging.RedactValue("client_secret", "s3cr3t"); got != logging.Redacted {
+		t.Fatalf("Expected the value to be redacted, got %v", got)
+	}
+	body := map[string]interface{}{"email": "jane@example.com", "password": "hunter2"}
+	redacted := logging.RedactValue("body", body).(map[string]interface{})
+	if redacted["password"] != logging.Redacted || redacted["email"] != "jane@example.com" {
+		t.Fatalf("Unexpected redaction result %v", redacted)
+	}
+	if body["password"] != "hunter2" {
+		t.Fatal("Expected the original value to be left untouched")
+	}
+}
+
+func TestStdLoggerFiltersByLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logging.NewStdLogger(log.New(&buf, "", 0), logging.LevelInfo)
+
+	logger.Debug("hidden")
+	logger.Info("sending request", "method", "GET", "path", "/api/2/users")
+
+	expected := "level=INFO msg=\"sending request\" method=GET path=/api/2/users\n"
+	if buf.String() != expected {
+		t.Fatalf("Expected %q, got %q", expected, buf.String())
+	}
+}
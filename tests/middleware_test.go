@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+)
+
+func okResponse(*http.Request) (*http.Response, error) {
+	return jsonResponse(http.StatusOK, nil, `{}`), nil
+}
+
+func tracingMiddleware(name string, trace *[]string) api.Middleware {
+	return api.MiddlewareFuncs{
+		BeforeSendFunc: func(req *http.Request) (*http.Request, error) {
+			*trace = append(*trace, name+" before")
+			return req, nil
+		},
+		AfterReceiveFunc: func(req *http.Request, resp *http.Response) (*http.Response, error) {
+			*trace = append(*trace, name+" after")
+			return resp, nil
+		},
+		OnErrorFunc: func(req *http.Request, err error) error {
+			*trace = append(*trace, name+" error")
+			return err
+		},
+	}
+}
+
+func TestMiddlewareRunsInOrder(t *testing.T) {
+	client := mockClient(okResponse)
+
+	var trace []string
+	client.Use(tracingMiddleware("a", &trace), tracingMiddleware("b", &trace))
+
+	if _, err := client.Get(new(string), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"a before", "b before", "b after", "a after"}
+	if len(trace) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, trace)
+	}
+	for i := range expected {
+		if trace[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, trace)
+		}
+	}
+}
+
+func TestMiddlewareCanInjectFaults(t *testing.T) {
+	sent := 0
+	client := mockClient(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return okResponse(req)
+	})
+
+	injected := errors.New("injected fault")
+	var trace []string
+	client.Use(tracingMiddleware("a", &trace), api.MiddlewareFuncs{
+		BeforeSendFunc: func(req *http.Request) (*http.Request, error) {
+			return nil, injected
+		},
+	})
+
+	_, err := client.Get(new(string), nil)
+	if err != injected {
+		t.Fatalf("Expected %v, got %v", injected, err)
+	}
+	if sent != 0 {
+		t.Fatalf("Expected no request to be sent, got %d", sent)
+	}
+	if len(trace) != 2 || trace[1] != "a error" {
+		t.Fatalf("Expected OnError hooks to run, got %v", trace)
+	}
+}
+
+func TestTimingMiddleware(t *testing.T) {
+	client := mockClient(func(req *http.Request) (*http.Response, error) {
+		time.Sleep(10 * time.Millisecond)
+		return okResponse(req)
+	})
+
+	var observed time.Duration
+	var status int
+	client.Use(api.TimingMiddleware(func(req *http.Request, resp *http.Response, d time.Duration, err error) {
+		observed = d
+		status = resp.StatusCode
+	}))
+
+	if _, err := client.Get(new(string), nil); err != nil {
+		t.Fatal(err)
+	}
+	if observed < 10*time.Millisecond {
+		t.Fatalf("Expected at least 10ms, got %v", observed)
+	}
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+}
+
+func TestHeaderMiddlewareAppliesToTokenAndAPIRequests(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.URL.Path] = r.Header.Get("X-Request-Source")
+		mu.Unlock()
+		if r.URL.Path == "/auth/oauth2/v2/token" {
+			w.Write([]byte(`{"access_token":"stand-in-token","expires_in":3600}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithMiddleware(api.HeaderMiddleware(http.Header{"X-Request-Source": []string{"nightly-sync"}})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sdk.ListHooks(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"/auth/oauth2/v2/token", "/api/2/hooks"} {
+		if seen[path] != "nightly-sync" {
+			t.Fatalf("Expected the injected header on %s, got %q", path, seen[path])
+		}
+	}
+}
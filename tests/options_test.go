@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// newStandIn starts a local stand-in for the OneLogin token and users endpoints that
+// records the path and User-Agent of every request it receives.
+func newStandIn(t *testing.T) (*httptest.Server, *[]string, *[]string) {
+	var mu sync.Mutex
+	var paths, agents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		agents = append(agents, r.Header.Get("User-Agent"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/auth/oauth2/v2/token":
+			w.Write([]byte(`{"access_token":"stand-in-token","account_id":1,"expires_in":3600}`))
+		case "/api/2/users":
+			w.Write([]byte(`[{"id":1,"email":"jane@example.com"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &paths, &agents
+}
+
+func TestNewOneloginSDKWithOptions(t *testing.T) {
+	server, paths, agents := newStandIn(t)
+
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithHTTPClient(server.Client()),
+		onelogin.WithUserAgent("sdk-test/1.0"),
+		onelogin.WithRetryPolicy(nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tk, _ := sdk.GetToken(); tk != "stand-in-token" {
+		t.Fatalf("Expected stand-in-token, got %s", tk)
+	}
+	if _, err := sdk.GetUsers(&models.UserQuery{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPaths := []string{"/auth/oauth2/v2/token", "/api/2/users"}
+	if len(*paths) != len(expectedPaths) {
+		t.Fatalf("Expected requests to %v, got %v", expectedPaths, *paths)
+	}
+	for i, p := range expectedPaths {
+		if (*paths)[i] != p {
+			t.Fatalf("Expected request %d to %s, got %s", i+1, p, (*paths)[i])
+		}
+		if (*agents)[i] != "sdk-test/1.0" {
+			t.Fatalf("Expected User-Agent sdk-test/1.0 on %s, got %q", p, (*agents)[i])
+		}
+	}
+}
+
+func TestNewOneloginSDKWithTransport(t *testing.T) {
+	server, paths, _ := newStandIn(t)
+
+	_, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithTransport(server.Client().Transport),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*paths) != 1 || (*paths)[0] != "/auth/oauth2/v2/token" {
+		t.Fatalf("Expected the token request to use the configured transport, got %v", *paths)
+	}
+}
+
+func TestNewOneloginSDKWithLazyAuth(t *testing.T) {
+	server, paths, _ := newStandIn(t)
+
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithLazyAuth(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*paths) != 0 {
+		t.Fatalf("Expected no requests during construction, got %v", *paths)
+	}
+
+	if _, err := sdk.GetUsers(&models.UserQuery{}); err != nil {
+		t.Fatal(err)
+	}
+	expectedPaths := []string{"/auth/oauth2/v2/token", "/api/2/users"}
+	if len(*paths) != 2 || (*paths)[0] != expectedPaths[0] || (*paths)[1] != expectedPaths[1] {
+		t.Fatalf("Expected requests to %v, got %v", expectedPaths, *paths)
+	}
+}
+
+func TestLazyAuthSurfacesAuthenticationErrorOnFirstCall(t *testing.T) {
+	server, paths, _ := newStandIn(t)
+	missingSecret := &models.APICredentials{ClientID: "id", Subdomain: "test"}
+
+	_, eagerErr := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(missingSecret),
+		onelogin.WithBaseURL(server.URL),
+	)
+
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(missingSecret),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithLazyAuth(),
+	)
+	if err != nil {
+		t.Fatalf("Expected lazy construction to succeed, got %v", err)
+	}
+	_, lazyErr := sdk.GetUsers(&models.UserQuery{})
+
+	var authErr *olerror.AuthenticationError
+	if !errors.As(eagerErr, &authErr) {
+		t.Fatalf("Expected an AuthenticationError from eager construction, got %v", eagerErr)
+	}
+	if !errors.As(lazyErr, &authErr) {
+		t.Fatalf("Expected an AuthenticationError from the first call, got %v", lazyErr)
+	}
+	if lazyErr.Error() != eagerErr.Error() {
+		t.Fatalf("Expected %q, got %q", eagerErr, lazyErr)
+	}
+	if len(*paths) != 0 {
+		t.Fatalf("Expected no requests to be sent, got %v", *paths)
+	}
+}
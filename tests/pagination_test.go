@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+func TestPaginatorFollowsHeaderCursors(t *testing.T) {
+	pages := map[string]string{
+		"":   `[{"id":1},{"id":2}]`,
+		"c2": `[{"id":3}]`,
+	}
+	next := map[string]string{"": "c2"}
+
+	var cursors []string
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		cursor := req.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		header := http.Header{}
+		header.Set("After-Cursor", next[cursor])
+		return jsonResponse(http.StatusOK, header, pages[cursor]), nil
+	})
+
+	users, err := onelogin.CollectAll(context.Background(), &models.UserQuery{}, sdk.GetUsersTypedWithContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 3 || users[2].ID != 3 {
+		t.Fatalf("Expected 3 users, got %+v", users)
+	}
+	if len(cursors) != 2 || cursors[1] != "c2" {
+		t.Fatalf("Expected requests with cursors [\"\" c2], got %q", cursors)
+	}
+}
+
+func TestPaginatorUsesGroupAfterCursor(t *testing.T) {
+	var cursors []string
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		cursor := req.URL.Query().Get("after_cursor")
+		cursors = append(cursors, cursor)
+		body := `{"status":{"code":200},"pagination":{"after_cursor":"g2"},"data":[{"id":1,"name":"a"}]}`
+		if cursor == "g2" {
+			body = `{"status":{"code":200},"pagination":{"after_cursor":null},"data":[{"id":2,"name":"b"}]}`
+		}
+		return jsonResponse(http.StatusOK, nil, body), nil
+	})
+
+	p := onelogin.NewPaginator[models.Group](&models.GroupQuery{}, func(ctx context.Context, query models.Queryable) (*models.Response[[]models.Group], error) {
+		return sdk.GetGroupsTypedWithContext(ctx, query)
+	})
+	var names []string
+	for p.Next(context.Background()) {
+		names = append(names, p.Item().Name)
+	}
+	if p.Err() != nil {
+		t.Fatal(p.Err())
+	}
+	if len(names) != 2 || names[1] != "b" {
+		t.Fatalf("Expected groups a and b, got %q", names)
+	}
+	if len(cursors) != 2 || cursors[1] != "g2" {
+		t.Fatalf("Expected after_cursor g2 on the second request, got %q", cursors)
+	}
+}
+
+func TestPaginatorFallsBackToPageNumbers(t *testing.T) {
+	var requested []string
+	fetch := func(ctx context.Context, query models.Queryable) (*models.Response[[]int], error) {
+		page := query.(*models.RoleQuery).Page
+		requested = append(requested, page)
+		current, _ := strconv.Atoi(page)
+		if current == 0 {
+			current = 1
+		}
+		return &models.Response[[]int]{
+			Data:     []int{current},
+			Metadata: models.ResponseMetadata{CurrentPage: current, TotalPages: 3},
+		}, nil
+	}
+
+	items, err := onelogin.CollectAll(context.Background(), &models.RoleQuery{}, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 || items[2] != 3 {
+		t.Fatalf("Expected items from 3 pages, got %v", items)
+	}
+	if len(requested) != 3 || requested[1] != "2" || requested[2] != "3" {
+		t.Fatalf("Expected pages \"\", 2, 3, got %q", requested)
+	}
+}
+
+func TestPaginatorStopsOnError(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, query models.Queryable) (*models.Response[[]int], error) {
+		calls++
+		if calls == 2 {
+			return nil, errors.New("boom")
+		}
+		return &models.Response[[]int]{Data: []int{1}, Metadata: models.ResponseMetadata{NextCursor: "next"}}, nil
+	}
+
+	items, err := onelogin.CollectAll(context.Background(), &models.RoleQuery{}, fetch)
+	if err == nil || err.Error() != "boom" {
+		t.Fatalf("Expected boom, got %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("Expected the items fetched before the error, got %v", items)
+	}
+}
+
+func TestRawPageFunc(t *testing.T) {
+	fetch := onelogin.RawPageFunc(func(ctx context.Context, query models.Queryable) (interface{}, error) {
+		return &models.ResponseWithMetadata{
+			Data: map[string]interface{}{"data": []interface{}{"a", "b"}},
+		}, nil
+	})
+
+	items, err := onelogin.CollectAll(context.Background(), &models.PrivilegeQuery{}, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0] != "a" {
+		t.Fatalf("Expected [a b], got %v", items)
+	}
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// pagedFetch serves totalPages pages of one item each, numbered after their page.
+func pagedFetch(totalPages int, inFlight, maxInFlight *int32, fail map[int]bool) onelogin.PageFunc[int] {
+	return func(ctx context.Context, query models.Queryable) (*models.Response[[]int], error) {
+		n := atomic.AddInt32(inFlight, 1)
+		defer atomic.AddInt32(inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		page, _ := strconv.Atoi(query.(*models.UserQuery).Page)
+		if page == 0 {
+			page = 1
+		}
+		if fail[page] {
+			return nil, errors.New("page failed")
+		}
+		return &models.Response[[]int]{
+			Data:     []int{page},
+			Metadata: models.ResponseMetadata{CurrentPage: page, TotalPages: totalPages},
+		}, nil
+	}
+}
+
+func TestCollectAllParallelReturnsPagesInOrder(t *testing.T) {
+	var inFlight, maxInFlight int32
+	query := &models.UserQuery{}
+
+	items, err := onelogin.CollectAllParallel(context.Background(), query, pagedFetch(10, &inFlight, &maxInFlight, nil), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 10 {
+		t.Fatalf("Expected 10 items, got %v", items)
+	}
+	for i, item := range items {
+		if item != i+1 {
+			t.Fatalf("Expected items in page order, got %v", items)
+		}
+	}
+	if maxInFlight > 3 {
+		t.Fatalf("Expected at most 3 concurrent requests, got %d", maxInFlight)
+	}
+	if maxInFlight < 2 {
+		t.Fatalf("Expected pages to be fetched concurrently, got %d at a time", maxInFlight)
+	}
+	if query.Page != "" {
+		t.Fatalf("Expected the caller's query to be left untouched, got page %q", query.Page)
+	}
+}
+
+func TestFetchPagesParallelReportsPageErrors(t *testing.T) {
+	var inFlight, maxInFlight int32
+	fetch := pagedFetch(5, &inFlight, &maxInFlight, map[int]bool{2: true, 4: true})
+
+	results, err := onelogin.FetchPagesParallel(context.Background(), &models.UserQuery{}, fetch, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("Expected 5 page results, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Page != i+1 {
+			t.Fatalf("Expected page %d at index %d, got %d", i+1, i, r.Page)
+		}
+		if (r.Err != nil) != (r.Page == 2 || r.Page == 4) {
+			t.Fatalf("Unexpected error state for page %d: %v", r.Page, r.Err)
+		}
+	}
+
+	items, err := onelogin.CollectAllParallel(context.Background(), &models.UserQuery{}, fetch, 2)
+	var pagesErr *onelogin.PagesError
+	if !errors.As(err, &pagesErr) || len(pagesErr.Pages) != 2 || pagesErr.Pages[1].Page != 4 {
+		t.Fatalf("Expected pages 2 and 4 to fail, got %v", err)
+	}
+	if len(items) != 3 || items[1] != 3 {
+		t.Fatalf("Expected items of pages 1, 3 and 5, got %v", items)
+	}
+}
+
+func TestFetchPagesParallelFirstPageError(t *testing.T) {
+	var inFlight, maxInFlight int32
+	fetch := pagedFetch(5, &inFlight, &maxInFlight, map[int]bool{1: true})
+
+	if _, err := onelogin.FetchPagesParallel(context.Background(), &models.UserQuery{}, fetch, 2); err == nil {
+		t.Fatal("Expected an error when the first page fails")
+	}
+}
+
+func TestFetchPagesParallelRequiresQueryStruct(t *testing.T) {
+	var inFlight, maxInFlight int32
+	if _, err := onelogin.FetchPagesParallel(context.Background(), nil, pagedFetch(1, &inFlight, &maxInFlight, nil), 2); err == nil {
+		t.Fatal("Expected an error for a nil query")
+	}
+}
@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+)
+
+// writeProfiles points ONELOGIN_CONFIG_FILE at a profiles file with the given content.
+func writeProfiles(t *testing.T, content string) string {
+	clearCredentialsEnv(t)
+	path := filepath.Join(t.TempDir(), "config.yml")
+	writeFile(t, path, content)
+	t.Setenv("ONELOGIN_CONFIG_FILE", path)
+	return path
+}
+
+const testProfiles = `
+profiles:
+  default:
+    subdomain: acme
+    client_id: default-id
+    client_secret: default-secret
+    timeout: 5
+  staging:
+    subdomain: acme-staging
+    timeout: 30s
+    client_id: staging-id
+    client_secret_env: STAGING_SECRET
+  europe:
+    subdomain: acme-eu
+    region: eu
+    client_id: eu-id
+    client_secret: eu-secret
+`
+
+func TestWithProfile(t *testing.T) {
+	writeProfiles(t, testProfiles)
+	t.Setenv("STAGING_SECRET", "staging-secret")
+
+	client, err := api.NewClient(api.WithLazyAuth(), api.WithProfile("staging"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := client.(*api.Client)
+	if c.OLdomain != "https://acme-staging.onelogin.com" {
+		t.Fatalf("Expected https://acme-staging.onelogin.com, got %s", c.OLdomain)
+	}
+	if c.Timeout != 30*time.Second {
+		t.Fatalf("Expected the profile's 30s timeout, got %v", c.Timeout)
+	}
+
+	client, err = api.NewClient(api.WithLazyAuth(), api.WithProfile("europe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain := client.(*api.Client).OLdomain; domain != "https://api.eu.onelogin.com" {
+		t.Fatalf("Expected https://api.eu.onelogin.com, got %s", domain)
+	}
+}
+
+func TestDefaultProfileSettings(t *testing.T) {
+	writeProfiles(t, testProfiles)
+
+	client, err := api.NewClient(api.WithLazyAuth())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := client.(*api.Client)
+	if c.OLdomain != "https://acme.onelogin.com" || c.Timeout != 5*time.Second {
+		t.Fatalf("Expected the default profile's subdomain and timeout, got %s and %v", c.OLdomain, c.Timeout)
+	}
+
+	// the default profile's timeout yields to ONELOGIN_TIMEOUT, a selected profile's doesn't
+	t.Setenv("ONELOGIN_TIMEOUT", "20")
+	client, _ = api.NewClient(api.WithLazyAuth())
+	if timeout := client.(*api.Client).Timeout; timeout != 20*time.Second {
+		t.Fatalf("Expected ONELOGIN_TIMEOUT to take precedence, got %v", timeout)
+	}
+	client, _ = api.NewClient(api.WithLazyAuth(), api.WithProfile("default"))
+	if timeout := client.(*api.Client).Timeout; timeout != 5*time.Second {
+		t.Fatalf("Expected the selected profile's timeout, got %v", timeout)
+	}
+	client, _ = api.NewClient(api.WithLazyAuth(), api.WithProfile("default"), api.WithTimeout(time.Minute))
+	if timeout := client.(*api.Client).Timeout; timeout != time.Minute {
+		t.Fatalf("Expected WithTimeout to take precedence, got %v", timeout)
+	}
+
+	// the default profile's settings are not applied to credentials from the environment
+	t.Setenv("ONELOGIN_CLIENT_ID", "env-id")
+	t.Setenv("ONELOGIN_CLIENT_SECRET", "env-secret")
+	t.Setenv("ONELOGIN_SUBDOMAIN", "other")
+	client, _ = api.NewClient(api.WithLazyAuth())
+	if domain := client.(*api.Client).OLdomain; domain != "https://other.onelogin.com" {
+		t.Fatalf("Expected https://other.onelogin.com, got %s", domain)
+	}
+}
+
+func TestSelectedProfileIgnoresEnvironment(t *testing.T) {
+	writeProfiles(t, testProfiles)
+	t.Setenv("STAGING_SECRET", "staging-secret")
+	t.Setenv("ONELOGIN_CLIENT_ID", "production-id")
+	t.Setenv("ONELOGIN_CLIENT_SECRET", "production-secret")
+	t.Setenv("ONELOGIN_SUBDOMAIN", "acme")
+	t.Setenv("ONELOGIN_PROFILE", "staging")
+
+	client, err := api.NewClient(api.WithLazyAuth())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain := client.(*api.Client).OLdomain; domain != "https://acme-staging.onelogin.com" {
+		t.Fatalf("Expected https://acme-staging.onelogin.com, got %s", domain)
+	}
+	creds, err := authentication.DefaultCredentialsChain(nil).Credentials(context.Background())
+	if err != nil || creds.ClientID != "staging-id" || creds.ClientSecret != "staging-secret" {
+		t.Fatalf("Expected the staging credentials, got %v (%v)", creds, err)
+	}
+
+	// WithProfile takes precedence over ONELOGIN_PROFILE
+	client, err = api.NewClient(api.WithLazyAuth(), api.WithProfile("europe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain := client.(*api.Client).OLdomain; domain != "https://api.eu.onelogin.com" {
+		t.Fatalf("Expected https://api.eu.onelogin.com, got %s", domain)
+	}
+}
+
+func TestUnknownProfile(t *testing.T) {
+	writeProfiles(t, testProfiles)
+	if _, err := api.NewClient(api.WithLazyAuth(), api.WithProfile("production")); !errors.Is(err, authentication.ErrProfileNotFound) {
+		t.Fatalf("Expected ErrProfileNotFound, got %v", err)
+	}
+
+	writeProfiles(t, "profiles:\n  default:\n    timeout: soon\n")
+	if _, err := api.NewClient(api.WithLazyAuth()); err == nil {
+		t.Fatal("Expected an invalid timeout to be reported")
+	}
+}
+
+func TestProfileCredentialReferences(t *testing.T) {
+	dir := t.TempDir()
+	secrets := filepath.Join(dir, "secrets")
+	if err := os.Mkdir(secrets, 0700); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dir, "secret"), "file-secret\n")
+	writeFile(t, filepath.Join(secrets, "client_id"), "mounted-id")
+	writeFile(t, filepath.Join(secrets, "client_secret"), "mounted-secret")
+	path := writeProfiles(t, `
+profiles:
+  file:
+    subdomain: acme
+    client_id: file-id
+    client_secret_file: `+filepath.Join(dir, "secret")+`
+  mounted:
+    subdomain: acme
+    secrets_dir: `+secrets+`
+  command:
+    subdomain: ignored
+    credentials_command: ["`+os.Args[0]+`", "-test.run=TestCredentialsHelperProcess"]
+`)
+	t.Setenv("CREDENTIALS_HELPER", "ok")
+
+	for name, want := range map[string][3]string{
+		"file":    {"file-id", "file-secret", "acme"},
+		"mounted": {"mounted-id", "mounted-secret", "acme"},
+		"command": {"command-id", "command-secret", "acme"},
+	} {
+		creds, err := authentication.ProfileCredentials(path, name).Credentials(context.Background())
+		if err != nil || creds.ClientID != want[0] || creds.ClientSecret != want[1] || creds.Subdomain != want[2] {
+			t.Fatalf("Expected %v from profile %s, got %v (%v)", want, name, creds, err)
+		}
+	}
+}
+
+func TestProfileAuthenticates(t *testing.T) {
+	server := newOAuthStandIn(t)
+	writeProfiles(t, "profiles:\n  test:\n    subdomain: test\n    client_id: id\n    client_secret: secret\n")
+
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithProfile("test"),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithHTTPClient(server.Client()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk, err := sdk.Client.GetToken(); err != nil || tk != "token-1" {
+		t.Fatalf("Expected token-1, got %s (%v)", tk, err)
+	}
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+func TestEncodeQueryUserQuery(t *testing.T) {
+	since := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	email := "alice@example.com"
+	query := &models.UserQuery{
+		BaseQueryRequest: models.BaseQueryRequest{Limit: "50", Cursor: "abc"},
+		CreatedSince:     &since,
+		Email:            &email,
+	}
+
+	values, err := utilities.EncodeQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "created_since=2024-03-01T12%3A30%3A00Z&cursor=abc&email=alice%40example.com&limit=50"
+	if values.Encode() != expected {
+		t.Fatalf("Expected %s, got %s", expected, values.Encode())
+	}
+}
+
+func TestEncodeQueryIntsAndBools(t *testing.T) {
+	connectorID := 108419
+	values, err := utilities.EncodeQuery(&models.AppQuery{ConnectorID: &connectorID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Encode() != "connector_id=108419" {
+		t.Fatalf("Expected connector_id=108419, got %s", values.Encode())
+	}
+
+	values, err = utilities.EncodeQuery(&models.AppRuleQuery{Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Encode() != "enabled=true" {
+		t.Fatalf("Expected enabled=true, got %s", values.Encode())
+	}
+}
+
+func TestEncodeQueryGroupCursor(t *testing.T) {
+	query := &models.GroupQuery{}
+	query.SetCursor("next")
+	query.SetLimit("10")
+
+	values, err := utilities.EncodeQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Encode() != "after_cursor=next&limit=10" {
+		t.Fatalf("Expected after_cursor=next&limit=10, got %s", values.Encode())
+	}
+}
+
+func TestEncodeQueryTagsAndSlices(t *testing.T) {
+	type inner struct {
+		Name string `json:"name,omitempty"`
+		Page int    `json:"page,omitempty"`
+	}
+	type query struct {
+		inner
+		Name    string    `url:"override"`
+		IDs     []int     `json:"ids,omitempty"`
+		Fields  [2]string `json:"fields"`
+		Skipped string    `json:"-"`
+		Ratio   float64   `json:"ratio,omitempty"`
+		Zero    *int      `json:"zero,omitempty"`
+		Empty   []string  `json:"empty,omitempty"`
+	}
+	zero := 0
+	q := query{
+		inner:   inner{Name: "inner", Page: 2},
+		Name:    "outer",
+		IDs:     []int{3, 1, 2},
+		Fields:  [2]string{"id", "email"},
+		Skipped: "secret",
+		Ratio:   0.5,
+		Zero:    &zero,
+	}
+
+	values, err := utilities.EncodeQuery(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "fields=id%2Cemail&ids=3%2C1%2C2&name=inner&override=outer&page=2&ratio=0.5&zero=0"
+	if values.Encode() != expected {
+		t.Fatalf("Expected %s, got %s", expected, values.Encode())
+	}
+}
+
+func TestEncodeQueryMap(t *testing.T) {
+	values, err := utilities.EncodeQuery(map[string]interface{}{"b": 2, "a": "x", "c": nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values.Encode() != "a=x&b=2" {
+		t.Fatalf("Expected a=x&b=2, got %s", values.Encode())
+	}
+}
+
+func TestEncodeQueryUnsupportedType(t *testing.T) {
+	type query struct {
+		Nested map[string]string `json:"nested"`
+	}
+	if _, err := utilities.EncodeQuery(query{Nested: map[string]string{}}); err == nil {
+		t.Fatal("Expected an error for a nested map")
+	}
+}
+
+func TestAddQueryToPathNilPointer(t *testing.T) {
+	var query *models.UserQuery
+	p, err := utilities.AddQueryToPath("/api/2/users", query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != "/api/2/users" {
+		t.Fatalf("Expected /api/2/users, got %s", p)
+	}
+}
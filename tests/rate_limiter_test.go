@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+)
+
+func rateLimitedResponse(limit, remaining, reset string) *http.Response {
+	return jsonResponse(http.StatusOK, http.Header{
+		"X-Ratelimit-Limit":     []string{limit},
+		"X-Ratelimit-Remaining": []string{remaining},
+		"X-Ratelimit-Reset":     []string{reset},
+	}, `{}`)
+}
+
+func TestRateLimiterAllowsUntilHeadersAreSeen(t *testing.T) {
+	rl := api.NewRateLimiter(true)
+	for i := 0; i < 10; i++ {
+		if err := rl.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected no error before rate limit headers are seen, got %v", err)
+		}
+	}
+}
+
+func TestRateLimiterFailsFastWhenExhausted(t *testing.T) {
+	rl := api.NewRateLimiter(true)
+	rl.Update(rateLimitedResponse("5", "1", "60"))
+
+	if err := rl.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	err := rl.Wait(context.Background())
+	var rateLimitErr *olerror.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected a RateLimitError, got %v", err)
+	}
+	if rateLimitErr.RetryAfter <= 0 || rateLimitErr.RetryAfter > time.Minute {
+		t.Fatalf("Unexpected RetryAfter %v", rateLimitErr.RetryAfter)
+	}
+}
+
+func TestRateLimiterBlocksUntilReset(t *testing.T) {
+	rl := api.NewRateLimiter(false)
+	rl.Update(rateLimitedResponse("5", "0", "1"))
+
+	start := time.Now()
+	if err := rl.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if waited := time.Since(start); waited < 900*time.Millisecond {
+		t.Fatalf("Expected to block until the window resets, waited %v", waited)
+	}
+}
+
+func TestRateLimiterKeepsLimitingAfterReset(t *testing.T) {
+	rl := api.NewRateLimiter(true)
+	rl.Update(rateLimitedResponse("2", "0", "1"))
+	time.Sleep(1100 * time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		if err := rl.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected the budget to be refilled after the reset, got %v", err)
+		}
+	}
+	var rateLimitErr *olerror.RateLimitError
+	if err := rl.Wait(context.Background()); !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter <= 0 {
+		t.Fatalf("Expected the refilled budget to be enforced, got %v", err)
+	}
+}
+
+func TestRemoveSharedRateLimiter(t *testing.T) {
+	first := api.SharedRateLimiter("acme/remove")
+	if api.SharedRateLimiter("acme/remove") != first {
+		t.Fatal("Expected the shared rate limiter to be reused")
+	}
+	api.RemoveSharedRateLimiter("acme/remove")
+	if api.SharedRateLimiter("acme/remove") == first {
+		t.Fatal("Expected a new rate limiter after removal")
+	}
+}
+
+func TestRateLimiterWaitHonorsContext(t *testing.T) {
+	rl := api.NewRateLimiter(false)
+	rl.Update(rateLimitedResponse("5", "0", "60"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := rl.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestClientSharesRateLimitAcrossGoroutines(t *testing.T) {
+	var mu sync.Mutex
+	sent := 0
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		mu.Lock()
+		sent++
+		mu.Unlock()
+		return jsonResponse(http.StatusOK, nil, `{}`), nil
+	})
+	client.RateLimiter = api.NewRateLimiter(true)
+	client.RateLimiter.Update(rateLimitedResponse("100", "10", "60"))
+
+	var wg sync.WaitGroup
+	limited := 0
+	var limitedMu sync.Mutex
+	for i := 0; i < 25; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Get(new(string), nil); err != nil {
+				limitedMu.Lock()
+				limited++
+				limitedMu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if sent != 10 {
+		t.Fatalf("Expected 10 requests to be sent, got %d", sent)
+	}
+	if limited != 15 {
+		t.Fatalf("Expected 15 requests to be rejected, got %d", limited)
+	}
+}
+
+func TestSharedRateLimiterIsKeyed(t *testing.T) {
+	if api.SharedRateLimiter("tenant/a") != api.SharedRateLimiter("tenant/a") {
+		t.Fatal("Expected the same limiter for the same key")
+	}
+	if api.SharedRateLimiter("tenant/a") == api.SharedRateLimiter("tenant/b") {
+		t.Fatal("Expected different limiters for different keys")
+	}
+}
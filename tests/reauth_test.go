@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+)
+
+type sentRequest struct {
+	authorization string
+	body          string
+}
+
+// reauthClient returns a client whose first API call is rejected with a 401, and records
+// every request it sends.
+func reauthClient(t *testing.T) (*api.Client, *[]sentRequest) {
+	stubTokenEndpoint(t, 3600, 0)
+
+	var sent []sentRequest
+	client := mockClient(func(req *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(req.Body)
+		sent = append(sent, sentRequest{authorization: req.Header.Get("Authorization"), body: string(body)})
+		status := http.StatusOK
+		if len(sent) == 1 {
+			status = http.StatusUnauthorized
+		}
+		return jsonResponse(status, nil, `{}`), nil
+	})
+	client.Auth = authentication.NewAuthenticator("test", testCredentials())
+	if err := client.Auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+	return client, &sent
+}
+
+func assertReplayed(t *testing.T, sent []sentRequest, expectedBody string) {
+	t.Helper()
+	if len(sent) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(sent))
+	}
+	if sent[0].authorization != "Bearer token-1" {
+		t.Fatalf("Expected first request to use token-1, got %q", sent[0].authorization)
+	}
+	if sent[1].authorization != "Bearer token-2" {
+		t.Fatalf("Expected retried request to use token-2, got %q", sent[1].authorization)
+	}
+	for i, req := range sent {
+		if req.body != expectedBody {
+			t.Fatalf("Expected request %d to carry body %q, got %q", i+1, expectedBody, req.body)
+		}
+	}
+}
+
+func TestClientPostReplaysBodyAfterReauthentication(t *testing.T) {
+	client, sent := reauthClient(t)
+
+	if _, err := client.Post(new(string), map[string]string{"foo": "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	assertReplayed(t, *sent, `{"foo":"bar"}`)
+}
+
+func TestClientPutReplaysBodyAfterReauthentication(t *testing.T) {
+	client, sent := reauthClient(t)
+
+	if _, err := client.Put(new(string), map[string]int{"state": 1}); err != nil {
+		t.Fatal(err)
+	}
+	assertReplayed(t, *sent, `{"state":1}`)
+}
+
+func TestClientDeleteWithBodyReplaysBodyAfterReauthentication(t *testing.T) {
+	client, sent := reauthClient(t)
+
+	if _, err := client.DeleteWithBody(new(string), []int{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	assertReplayed(t, *sent, `[1,2,3]`)
+}
+
+func TestConcurrentUnauthorizedResponsesRefreshOnce(t *testing.T) {
+	calls := stubTokenEndpoint(t, 3600, 20*time.Millisecond)
+
+	var rejected int32
+	client := mockClient(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		if req.Header.Get("Authorization") == "Bearer token-1" {
+			// spread the rejections so that some arrive after the refresh has finished
+			n := atomic.AddInt32(&rejected, 1)
+			time.Sleep(time.Duration(n%4) * 15 * time.Millisecond)
+			status = http.StatusUnauthorized
+		}
+		return jsonResponse(status, nil, `{}`), nil
+	})
+	client.Auth = authentication.NewAuthenticator("test", testCredentials())
+	if err := client.Auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Get(new(string), nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(calls); n != 2 {
+		t.Fatalf("Expected the initial token request and a single refresh, got %d token requests", n)
+	}
+}
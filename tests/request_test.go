@@ -1 +1,101 @@
 package tests
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+func TestDoKnownPath(t *testing.T) {
+	var got *http.Request
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		got = req
+		return jsonResponse(http.StatusOK, http.Header{"After-Cursor": {"next"}}, `[{"id": 7, "name": "Salesforce"}]`), nil
+	})
+
+	var apps []models.UserApp
+	metadata, err := sdk.Do(context.Background(), "get", "/api/2/users/42/apps", map[string]string{"ignore_visibility": "true"}, nil, &apps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != http.MethodGet || got.URL.Path != "/api/2/users/42/apps" || got.URL.RawQuery != "ignore_visibility=true" {
+		t.Fatalf("Unexpected request %s %s", got.Method, got.URL)
+	}
+	if got.Header.Get("Authorization") == "" {
+		t.Fatal("Expected the request to be authenticated")
+	}
+	if len(apps) != 1 || *apps[0].ID != 7 {
+		t.Fatalf("Expected one app with ID 7, got %v", apps)
+	}
+	if metadata.NextCursor != "next" {
+		t.Fatalf("Expected next cursor next, got %s", metadata.NextCursor)
+	}
+}
+
+func TestDoUnknownPath(t *testing.T) {
+	var body []byte
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		body, _ = ioutil.ReadAll(req.Body)
+		return jsonResponse(http.StatusCreated, nil, `{"status": {"error": false, "code": 200}, "data": [{"id": 5}]}`), nil
+	})
+
+	payload := map[string]interface{}{"name": "widget"}
+	var out struct {
+		ID int `json:"id"`
+	}
+	if _, err := sdk.Do(context.Background(), http.MethodPost, "/api/2/widgets", nil, payload, &out); err == nil {
+		t.Fatal("Expected an unknown path to be rejected")
+	}
+	if body != nil {
+		t.Fatal("Expected the rejected request not to be sent")
+	}
+
+	if _, err := sdk.Do(context.Background(), http.MethodPost, "/api/2/widgets", nil, payload, &out, onelogin.AllowUnknownPath()); err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"name":"widget"}` {
+		t.Fatalf("Expected the payload to be sent as JSON, got %s", body)
+	}
+	if out.ID != 5 {
+		t.Fatalf("Expected the v1 envelope to be unwrapped, got %+v", out)
+	}
+}
+
+func TestDoRejectsRelativeAndForeignPaths(t *testing.T) {
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("Unexpected request to %s", req.URL)
+		return nil, nil
+	})
+	for _, path := range []string{"api/2/users", "//evil.example.com/api/2/users", "/api/2/users?limit=1"} {
+		if _, err := sdk.Do(context.Background(), http.MethodGet, path, nil, nil, nil, onelogin.AllowUnknownPath()); err == nil {
+			t.Fatalf("Expected %q to be rejected", path)
+		}
+	}
+}
+
+func TestDoReturnsAPIErrors(t *testing.T) {
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusNotFound, nil, `{"statusCode": 404, "name": "NotFound", "message": "User not found"}`), nil
+	})
+
+	_, err := sdk.Do(context.Background(), http.MethodDelete, "/api/2/users/1", nil, nil, nil)
+	if !olerror.IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestDoValidatesQueryables(t *testing.T) {
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("Unexpected request to %s", req.URL)
+		return nil, nil
+	})
+	query := &models.UserQuery{BaseQueryRequest: models.BaseQueryRequest{Limit: "many"}}
+	if _, err := sdk.Do(context.Background(), http.MethodGet, "/api/2/users", query, nil, nil); !olerror.IsValidation(err) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+)
+
+func fastRetryPolicy() *api.RetryPolicy {
+	policy := api.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+func TestClientRetriesRetryableStatus(t *testing.T) {
+	calls := 0
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		calls++
+		status := http.StatusServiceUnavailable
+		if calls == 3 {
+			status = http.StatusOK
+		}
+		return jsonResponse(status, nil, `{}`), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+
+	resp, err := client.Get(new(string), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestClientStopsAfterMaxAttempts(t *testing.T) {
+	calls := 0
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		calls++
+		return jsonResponse(http.StatusBadGateway, nil, ``), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+
+	resp, err := client.Get(new(string), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Expected status 502, got %d", resp.StatusCode)
+	}
+	if calls != api.DefaultMaxAttempts {
+		t.Fatalf("Expected %d attempts, got %d", api.DefaultMaxAttempts, calls)
+	}
+}
+
+func TestClientHonorsRetryAfter(t *testing.T) {
+	var sent []time.Time
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		sent = append(sent, time.Now())
+		if len(sent) == 1 {
+			return jsonResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}}, ``), nil
+		}
+		return jsonResponse(http.StatusOK, nil, `{}`), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+	client.RetryPolicy.MaxDelay = 2 * time.Second
+
+	if _, err := client.Get(new(string), nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", len(sent))
+	}
+	if waited := sent[1].Sub(sent[0]); waited < time.Second {
+		t.Fatalf("Expected to wait at least 1s before retrying, waited %v", waited)
+	}
+}
+
+func TestClientDoesNotWaitLongerThanMaxDelay(t *testing.T) {
+	calls := 0
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		calls++
+		return jsonResponse(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"86400"}}, ``), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+
+	start := time.Now()
+	resp, err := client.Get(new(string), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests || calls != 1 {
+		t.Fatalf("Expected the 429 to be returned without retrying, got status %d after %d attempts", resp.StatusCode, calls)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		t.Fatalf("Expected not to wait for the server's hint, waited %v", waited)
+	}
+}
+
+func TestClientDoesNotRetryPostByDefault(t *testing.T) {
+	calls := 0
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		calls++
+		return jsonResponse(http.StatusServiceUnavailable, nil, ``), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+
+	if _, err := client.Post(new(string), map[string]string{"foo": "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestClientRetriesPostWhenAllowed(t *testing.T) {
+	var bodies []string
+	client := mockClient(func(req *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		status := http.StatusServiceUnavailable
+		if len(bodies) == 2 {
+			status = http.StatusCreated
+		}
+		return jsonResponse(status, nil, ``), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+	client.RetryPolicy.RetryNonIdempotent = true
+
+	if _, err := client.Post(new(string), map[string]string{"foo": "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", len(bodies))
+	}
+	if bodies[1] != `{"foo":"bar"}` {
+		t.Fatalf("Expected retried body to be replayed, got %q", bodies[1])
+	}
+}
+
+func TestClientRetriesTransientNetworkError(t *testing.T) {
+	calls := 0
+	client := mockClient(func(*http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("connection reset by peer")
+		}
+		return jsonResponse(http.StatusOK, nil, `{}`), nil
+	})
+	client.RetryPolicy = fastRetryPolicy()
+
+	if _, err := client.Get(new(string), nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", calls)
+	}
+}
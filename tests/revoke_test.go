@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+)
+
+// oauthStandIn is a local stand-in for the OneLogin token and revoke endpoints.
+type oauthStandIn struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	issued       int
+	revoked      []string
+	revokeStatus int
+}
+
+func newOAuthStandIn(t *testing.T) *oauthStandIn {
+	s := &oauthStandIn{revokeStatus: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if user, pass, ok := r.BasicAuth(); !ok || user != "id" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case authentication.TkPath:
+			s.issued++
+			fmt.Fprintf(w, `{"access_token":"token-%d","account_id":1,"expires_in":3600}`, s.issued)
+		case authentication.RevokePath:
+			var body struct {
+				AccessToken string `json:"access_token"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			s.revoked = append(s.revoked, body.AccessToken)
+			w.WriteHeader(s.revokeStatus)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *oauthStandIn) counts() (int, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issued, append([]string(nil), s.revoked...)
+}
+
+func TestRevokeToken(t *testing.T) {
+	server := newOAuthStandIn(t)
+	auth := authentication.NewAuthenticator("test", testCredentials(),
+		authentication.WithBaseURL(server.URL),
+		authentication.WithHTTPClient(server.Client()),
+	)
+
+	token := "some-other-token"
+	if err := auth.RevokeToken(&token); err != nil {
+		t.Fatal(err)
+	}
+	if _, revoked := server.counts(); len(revoked) != 1 || revoked[0] != token {
+		t.Fatalf("Expected %s to be revoked, got %v", token, revoked)
+	}
+}
+
+func TestRevokeCurrentToken(t *testing.T) {
+	server := newOAuthStandIn(t)
+	auth := authentication.NewAuthenticator("test", testCredentials(),
+		authentication.WithBaseURL(server.URL),
+		authentication.WithHTTPClient(server.Client()),
+	)
+
+	// nothing to revoke before a token was obtained
+	if err := auth.RevokeTokenWithContext(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if issued, revoked := server.counts(); issued != 0 || len(revoked) != 0 {
+		t.Fatalf("Expected no requests, got %d tokens and %v revoked", issued, revoked)
+	}
+
+	first, err := auth.GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := auth.RevokeTokenWithContext(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	second, err := auth.GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Fatal("Expected a new token after the current one was revoked")
+	}
+	if _, revoked := server.counts(); len(revoked) != 1 || revoked[0] != first {
+		t.Fatalf("Expected %s to be revoked, got %v", first, revoked)
+	}
+}
+
+func TestRevokeTokenFailure(t *testing.T) {
+	server := newOAuthStandIn(t)
+	server.revokeStatus = http.StatusBadRequest
+	auth := authentication.NewAuthenticator("test", testCredentials(),
+		authentication.WithBaseURL(server.URL),
+		authentication.WithHTTPClient(server.Client()),
+	)
+
+	token := "token"
+	if err := auth.RevokeToken(&token); err == nil {
+		t.Fatal("Expected a failed revocation to be reported")
+	}
+}
+
+func TestSDKClose(t *testing.T) {
+	server := newOAuthStandIn(t)
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithHTTPClient(server.Client()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sdk.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if issued, revoked := server.counts(); issued != 1 || len(revoked) != 1 || revoked[0] != "token-1" {
+		t.Fatalf("Expected token-1 to be revoked, got %d tokens and %v revoked", issued, revoked)
+	}
+
+	// a second Close has nothing left to revoke
+	if err := sdk.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, revoked := server.counts(); len(revoked) != 1 {
+		t.Fatalf("Expected a single revocation, got %v", revoked)
+	}
+}
+
+func TestSDKCloseWithLazyAuth(t *testing.T) {
+	server := newOAuthStandIn(t)
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithHTTPClient(server.Client()),
+		onelogin.WithLazyAuth(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sdk.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if issued, revoked := server.counts(); issued != 0 || len(revoked) != 0 {
+		t.Fatalf("Expected no requests, got %d tokens and %v revoked", issued, revoked)
+	}
+}
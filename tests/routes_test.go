@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+func TestRouteBuild(t *testing.T) {
+	route, err := utilities.ParseRoute("/api/2/mfa/users/{user_id:int}/devices/{device_id:alnum}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if route.Version != 2 || route.Resource != "mfa" {
+		t.Fatalf("Expected version 2 and resource mfa, got %d and %s", route.Version, route.Resource)
+	}
+
+	p, err := route.Build(map[string]interface{}{"user_id": 42, "device_id": "abc123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != "/api/2/mfa/users/42/devices/abc123" {
+		t.Fatalf("Expected /api/2/mfa/users/42/devices/abc123, got %s", p)
+	}
+
+	if _, err := route.Build(map[string]interface{}{"user_id": "alice", "device_id": "abc"}); err == nil {
+		t.Fatal("Expected an error for a non-numeric user_id")
+	}
+	if _, err := route.Build(map[string]interface{}{"user_id": 42}); err == nil {
+		t.Fatal("Expected an error for a missing device_id")
+	}
+}
+
+func TestParseRouteRejectsInvalidTemplates(t *testing.T) {
+	for _, template := range []string{
+		"api/2/users",
+		"/api/2/users/",
+		"/api//users",
+		"/api/2/users/{id:uuid}",
+		"/api/2/users/{id}/apps/{id}",
+		"/api/2/users/{id",
+	} {
+		if _, err := utilities.ParseRoute(template); err == nil {
+			t.Fatalf("Expected %q to be rejected", template)
+		}
+	}
+}
+
+func TestLookupRoute(t *testing.T) {
+	route, params, ok := utilities.LookupRoute("/api/2/apps/12/rules/conditions/has_role/values")
+	if ok {
+		t.Fatalf("Expected underscores to be rejected by an alnum parameter, got %s", route.Template)
+	}
+
+	route, params, ok = utilities.LookupRoute("/api/2/apps/12/rules/conditions/hasRole/values")
+	if !ok {
+		t.Fatal("Expected the rule condition values route to match")
+	}
+	if route.Template != "/api/2/apps/{app_id:int}/rules/conditions/{condition:alnum}/values" {
+		t.Fatalf("Unexpected route %s", route.Template)
+	}
+	if params["app_id"] != "12" || params["condition"] != "hasRole" {
+		t.Fatalf("Unexpected params %v", params)
+	}
+	if route.Version != 2 || route.Resource != "apps" {
+		t.Fatalf("Expected version 2 and resource apps, got %d and %s", route.Version, route.Resource)
+	}
+}
+
+func TestLookupRoutePrefersLiterals(t *testing.T) {
+	route, _, ok := utilities.LookupRoute("/api/2/apps/12/rules/sort")
+	if !ok || route.Template != "/api/2/apps/{app_id:int}/rules/sort" {
+		t.Fatalf("Expected the literal sort route, got %v", route)
+	}
+
+	route, params, ok := utilities.LookupRoute("/api/2/apps/12/rules/99")
+	if !ok || params["rule_id"] != "99" {
+		t.Fatalf("Expected the rule route with rule_id 99, got %v %v", route, params)
+	}
+}
+
+func TestIsPathValid(t *testing.T) {
+	valid := []string{"/api/2/users", "/api/1/users/5/otp_devices/7/verify", "/api/2/hooks/envs", "/api/2/branding/brands/3/templates/welcome/en"}
+	for _, p := range valid {
+		if !utilities.IsPathValid(p) {
+			t.Fatalf("Expected %s to be valid", p)
+		}
+	}
+	invalid := []string{"api/2/users", "/api/2/users/", "/api/2/users/abc", "/api/3/users", "/api/2/users/1/apps/2"}
+	for _, p := range invalid {
+		if utilities.IsPathValid(p) {
+			t.Fatalf("Expected %s to be invalid", p)
+		}
+	}
+}
+
+func TestRegisterRoute(t *testing.T) {
+	if _, err := utilities.BuildAPIPath("api/2/test_only_widgets", 7); err == nil {
+		t.Fatal("Expected an unknown route to be rejected")
+	}
+
+	route, err := utilities.RegisterRoute("/api/2/test_only_widgets/{widget_id:int}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { utilities.UnregisterRoute("/api/2/test_only_widgets/{widget_id:int}") })
+	again, err := utilities.RegisterRoute("/api/2/test_only_widgets/{widget_id:int}")
+	if err != nil || again != route {
+		t.Fatalf("Expected registering twice to return the same route, got %v", err)
+	}
+
+	p, err := utilities.BuildAPIPath("api/2/test_only_widgets", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != "/api/2/test_only_widgets/7" {
+		t.Fatalf("Expected /api/2/test_only_widgets/7, got %s", p)
+	}
+}
+
+func TestRouteTableRejectsConflictingParams(t *testing.T) {
+	table, err := utilities.NewRouteTable("/api/2/widgets/{widget_id:int}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.Register("/api/2/widgets/{id:int}"); err == nil {
+		t.Fatal("Expected a template differing only in its parameter names to be rejected")
+	}
+	if _, err := table.Register("/api/2/widgets/{id:int}/parts"); err != nil {
+		t.Fatalf("Expected a longer template to be accepted, got %v", err)
+	}
+	if route, params, ok := table.Match("/api/2/widgets/7"); !ok || route.Template != "/api/2/widgets/{widget_id:int}" || params["widget_id"] != "7" {
+		t.Fatalf("Expected the original route, got %v %v", route, params)
+	}
+
+	if table.Unregister("/api/2/widgets/{id:int}") {
+		t.Fatal("Expected a template that wasn't registered not to be removed")
+	}
+	if !table.Unregister("/api/2/widgets/{widget_id:int}") {
+		t.Fatal("Expected the route to be removed")
+	}
+	if _, _, ok := table.Match("/api/2/widgets/7"); ok {
+		t.Fatal("Expected the removed route not to match")
+	}
+	if _, _, ok := table.Match("/api/2/widgets/7/parts"); !ok {
+		t.Fatal("Expected the other route to still match")
+	}
+}
+
+func BenchmarkIsPathValid(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		utilities.IsPathValid("/api/2/branding/brands/3/templates/welcome/en")
+	}
+}
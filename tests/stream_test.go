@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/utilities"
+)
+
+type trackingBody struct {
+	*bytes.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func streamResponse(header http.Header, body string) (*http.Response, *trackingBody) {
+	if header == nil {
+		header = http.Header{}
+	}
+	tb := &trackingBody{Reader: bytes.NewReader([]byte(body))}
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: tb}, tb
+}
+
+func TestStreamResponseArray(t *testing.T) {
+	resp, body := streamResponse(http.Header{"After-Cursor": {"next"}}, `[{"id": 1, "email": "a@example.com"}, {"id": 2}]`)
+
+	var ids []int32
+	md, err := utilities.StreamResponse(resp, func(user models.User) error {
+		ids = append(ids, user.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Fatalf("Expected users 1 and 2, got %v", ids)
+	}
+	if md.NextCursor != "next" {
+		t.Fatalf("Expected next cursor next, got %s", md.NextCursor)
+	}
+	if !body.closed {
+		t.Fatal("Expected the body to be closed")
+	}
+}
+
+func TestStreamResponseV1Envelope(t *testing.T) {
+	resp, _ := streamResponse(nil, `{"status": {"error": false, "code": 200}, "data": [{"id": 3}, {"id": 4}], "pagination": {"before_cursor": null, "after_cursor": "abc"}}`)
+
+	d, err := utilities.NewStreamDecoder[models.Role](resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int32
+	for d.Next() {
+		ids = append(ids, *d.Item().ID)
+	}
+	if d.Err() != nil {
+		t.Fatal(d.Err())
+	}
+	if len(ids) != 2 || ids[0] != 3 || ids[1] != 4 {
+		t.Fatalf("Expected roles 3 and 4, got %v", ids)
+	}
+	if d.Metadata().NextCursor != "abc" {
+		t.Fatalf("Expected the cursor after the data to be read, got %q", d.Metadata().NextCursor)
+	}
+}
+
+func TestStreamResponseEmpty(t *testing.T) {
+	for _, body := range []string{``, `[]`, `{"status": {"code": 200}, "data": null}`} {
+		resp, _ := streamResponse(nil, body)
+		_, err := utilities.StreamResponse(resp, func(user models.User) error {
+			t.Fatalf("Unexpected item in %q", body)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Expected %q to decode, got %v", body, err)
+		}
+	}
+}
+
+func TestStreamResponseStopsOnCallbackError(t *testing.T) {
+	resp, body := streamResponse(nil, `[{"id": 1}, {"id": 2}, {"id": 3}]`)
+	stop := errors.New("stop")
+
+	count := 0
+	_, err := utilities.StreamResponse(resp, func(user models.User) error {
+		count++
+		return stop
+	})
+	if err != stop {
+		t.Fatalf("Expected the callback error, got %v", err)
+	}
+	if count != 1 || !body.closed {
+		t.Fatalf("Expected one item and a closed body, got %d items", count)
+	}
+}
+
+func TestStreamResponseErrors(t *testing.T) {
+	resp := jsonResponse(http.StatusNotFound, nil, `{"statusCode": 404, "message": "Not Found"}`)
+	if _, err := utilities.StreamResponse(resp, func(interface{}) error { return nil }); !olerror.IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+
+	resp, _ = streamResponse(nil, `{"id": 1}`)
+	if _, err := utilities.StreamResponse(resp, func(interface{}) error { return nil }); err == nil {
+		t.Fatal("Expected an object without data to be rejected")
+	}
+
+	resp, _ = streamResponse(nil, `[{"id": 1}, {"id": "two"}]`)
+	if _, err := utilities.StreamResponse(resp, func(models.User) error { return nil }); err == nil {
+		t.Fatal("Expected an invalid item to be reported")
+	}
+}
+
+func TestStreamUsersFollowsCursors(t *testing.T) {
+	var cursors []string
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		cursor := req.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		if cursor == "" {
+			return jsonResponse(http.StatusOK, http.Header{"After-Cursor": {"page2"}}, `[{"id": 1}, {"id": 2}]`), nil
+		}
+		return jsonResponse(http.StatusOK, nil, `[{"id": 3}]`), nil
+	})
+
+	var ids []int32
+	err := sdk.StreamUsers(&models.UserQuery{}, func(user models.User) error {
+		ids = append(ids, user.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || len(cursors) != 2 || cursors[1] != "page2" {
+		t.Fatalf("Expected 3 users over 2 pages, got %v with cursors %v", ids, cursors)
+	}
+}
+
+var benchmarkUsersBody = func() []byte {
+	users := make([]map[string]interface{}, 10000)
+	for i := range users {
+		users[i] = map[string]interface{}{
+			"id":         i,
+			"email":      fmt.Sprintf("user%d@example.com", i),
+			"username":   fmt.Sprintf("user%d", i),
+			"firstname":  "First",
+			"lastname":   "Last",
+			"status":     1,
+			"state":      1,
+			"created_at": "2024-01-01T00:00:00Z",
+			"custom_attributes": map[string]interface{}{
+				"department": "Engineering",
+			},
+		}
+	}
+	body, _ := json.Marshal(users)
+	return body
+}()
+
+func benchmarkUsersResponse() *http.Response {
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(benchmarkUsersBody))}
+}
+
+func BenchmarkCheckHTTPResponseUsers(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := utilities.CheckHTTPResponse(benchmarkUsersResponse()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeResponseUsers(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := utilities.DecodeResponse[[]models.User](benchmarkUsersResponse()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStreamResponseUsers(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := utilities.StreamResponse(benchmarkUsersResponse(), func(models.User) error { return nil })
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/api"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	requests int32
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&c.requests, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestTenantManager(t *testing.T, server *oauthStandIn, opts ...onelogin.TenantManagerOption) *onelogin.TenantManager {
+	clearCredentialsEnv(t)
+	opts = append([]onelogin.TenantManagerOption{onelogin.WithTenantDefaults(onelogin.WithBaseURL(server.URL))}, opts...)
+	m := onelogin.NewTenantManager(opts...)
+	for _, name := range []string{"acme", "globex", "initech"} {
+		if err := m.Add(name, onelogin.WithCredentials(&models.APICredentials{ClientID: "id", ClientSecret: "secret", Subdomain: name})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+func TestTenantManagerCreatesSDKsLazily(t *testing.T) {
+	server := newOAuthStandIn(t)
+	transport := &countingTransport{}
+	m := newTestTenantManager(t, server, onelogin.WithTenantTransport(transport))
+
+	if issued, _ := server.counts(); issued != 0 {
+		t.Fatalf("Expected no token requests before first use, got %d", issued)
+	}
+	first, err := m.SDK("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := m.SDK("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Fatal("Expected the tenant's SDK to be cached")
+	}
+	if _, err := m.SDK("globex"); err != nil {
+		t.Fatal(err)
+	}
+	if issued, _ := server.counts(); issued != 2 {
+		t.Fatalf("Expected 2 token requests, got %d", issued)
+	}
+	if n := atomic.LoadInt32(&transport.requests); n != 2 {
+		t.Fatalf("Expected the tenants to share the transport, got %d requests through it", n)
+	}
+
+	if _, err := m.SDK("umbrella"); err == nil {
+		t.Fatal("Expected an unregistered tenant to be reported")
+	}
+	if err := m.Add("acme"); err == nil {
+		t.Fatal("Expected a duplicate tenant to be rejected")
+	}
+}
+
+func TestTenantManagerRateLimitersPerTenant(t *testing.T) {
+	server := newOAuthStandIn(t)
+	m := newTestTenantManager(t, server)
+
+	acme, globex := m.RateLimiter("acme"), m.RateLimiter("globex")
+	if acme == nil || globex == nil || acme == globex {
+		t.Fatal("Expected every tenant to have its own rate limiter")
+	}
+	sdk, err := m.SDK("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limiter := sdk.Client.(*api.Client).RateLimiter; limiter != acme {
+		t.Fatal("Expected the tenant's SDK to use its rate limiter")
+	}
+}
+
+func TestFanOutAggregatesResults(t *testing.T) {
+	server := newOAuthStandIn(t)
+	m := newTestTenantManager(t, server, onelogin.WithFanOutWorkers(2))
+	if err := m.Add("broken", onelogin.WithCredentials(&models.APICredentials{ClientID: "id", ClientSecret: "wrong", Subdomain: "broken"})); err != nil {
+		t.Fatal(err)
+	}
+
+	var inFlight, maxInFlight int32
+	results, err := onelogin.FanOut(context.Background(), m, func(ctx context.Context, name string, sdk *onelogin.OneloginSDK) (string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if name == "initech" {
+			return "", errors.New("tenant offline")
+		}
+		return sdk.Client.(*api.Client).OLdomain, nil
+	})
+
+	var tenantsErr *onelogin.TenantsError
+	if !errors.As(err, &tenantsErr) || len(tenantsErr.Tenants) != 2 {
+		t.Fatalf("Expected 2 failed tenants, got %v", err)
+	}
+	if tenantsErr.Tenants[0].Tenant != "broken" || tenantsErr.Tenants[1].Tenant != "initech" {
+		t.Fatalf("Expected the failed tenants in name order, got %v", err)
+	}
+	if len(results) != 4 || results[0].Tenant != "acme" || results[0].Value != server.URL || results[1].Tenant != "broken" {
+		t.Fatalf("Expected one result per tenant in name order, got %+v", results)
+	}
+	if maxInFlight > 2 {
+		t.Fatalf("Expected at most 2 tenants at once, got %d", maxInFlight)
+	}
+}
+
+func TestTenantManagerClose(t *testing.T) {
+	server := newOAuthStandIn(t)
+	m := newTestTenantManager(t, server)
+	if _, err := onelogin.FanOut(context.Background(), m, func(ctx context.Context, name string, sdk *onelogin.OneloginSDK) (struct{}, error) {
+		return struct{}{}, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Remove(context.Background(), "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, revoked := server.counts(); len(revoked) != 3 {
+		t.Fatalf("Expected every tenant's token to be revoked, got %v", revoked)
+	}
+	if names := m.Tenants(); len(names) != 2 {
+		t.Fatalf("Expected the removed tenant to be gone, got %v", names)
+	}
+}
+
+func TestTenantManagerUsesWrappedDefaultTransport(t *testing.T) {
+	server := newOAuthStandIn(t)
+	var requests int32
+	original := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&requests, 1)
+		return original.RoundTrip(req)
+	})
+	t.Cleanup(func() { http.DefaultTransport = original })
+
+	m := newTestTenantManager(t, server)
+	if _, err := m.SDK("acme"); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("Expected the token request to go through the wrapped transport, got %d requests", n)
+	}
+}
+
+func TestTenantSDKCreationHonorsContext(t *testing.T) {
+	clearCredentialsEnv(t)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { <-release }))
+	defer server.Close()
+	defer close(release)
+
+	m := onelogin.NewTenantManager(onelogin.WithTenantDefaults(onelogin.WithBaseURL(server.URL)))
+	for _, name := range []string{"acme", "globex"} {
+		if err := m.Add(name, onelogin.WithCredentials(&models.APICredentials{ClientID: "id", ClientSecret: "secret", Subdomain: name})); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	results, _ := onelogin.FanOut(ctx, m, func(ctx context.Context, name string, sdk *onelogin.OneloginSDK) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	if waited := time.Since(start); waited > time.Second {
+		t.Fatalf("Expected cancelling to abort the hanging token requests, waited %v", waited)
+	}
+	for _, r := range results {
+		if !errors.Is(r.Err, context.DeadlineExceeded) {
+			t.Fatalf("Expected tenant %s to fail with the context's error, got %v", r.Tenant, r.Err)
+		}
+	}
+
+	// a caller waiting for another one creating the same SDK gives up with its own context
+	creating, cancelCreating := context.WithTimeout(context.Background(), time.Second)
+	defer cancelCreating()
+	go m.SDKWithContext(creating, "acme")
+	time.Sleep(50 * time.Millisecond)
+	waiting, cancelWaiting := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelWaiting()
+	if _, err := m.SDKWithContext(waiting, "acme"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the waiting caller to give up, got %v", err)
+	}
+}
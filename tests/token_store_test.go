@@ -0,0 +1,304 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/authentication"
+)
+
+func storeAuthenticator(server *oauthStandIn, store authentication.TokenStore) *authentication.Authenticator {
+	return authentication.NewAuthenticator("test", testCredentials(),
+		authentication.WithBaseURL(server.URL),
+		authentication.WithHTTPClient(server.Client()),
+		authentication.WithTokenStore(store),
+	)
+}
+
+func TestMemoryTokenStoreSharesTokens(t *testing.T) {
+	server := newOAuthStandIn(t)
+	store := authentication.NewMemoryTokenStore()
+
+	first, err := storeAuthenticator(server, store).GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := storeAuthenticator(server, store).GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Fatalf("Expected the stored token %s to be reused, got %s", first, second)
+	}
+	if issued, _ := server.counts(); issued != 1 {
+		t.Fatalf("Expected 1 token request, got %d", issued)
+	}
+}
+
+func TestFileTokenStoreSharesTokensAcrossStores(t *testing.T) {
+	server := newOAuthStandIn(t)
+	dir := filepath.Join(t.TempDir(), "tokens")
+
+	// separate stores on the same directory stand in for separate processes
+	for i := 0; i < 3; i++ {
+		store, err := authentication.NewFileTokenStore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tk, err := storeAuthenticator(server, store).GetToken(); err != nil || tk != "token-1" {
+			t.Fatalf("Expected token-1, got %s (%v)", tk, err)
+		}
+	}
+	if issued, _ := server.counts(); issued != 1 {
+		t.Fatalf("Expected 1 token request, got %d", issued)
+	}
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0700 {
+		t.Fatalf("Expected the directory to have mode 0700, got %v", info.Mode().Perm())
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("Expected one token file, got %v", files)
+	}
+	if info, err := os.Stat(files[0]); err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected the token file to have mode 0600, got %v (%v)", info.Mode().Perm(), err)
+	}
+}
+
+func TestTokenStoreSkipsExpiringTokens(t *testing.T) {
+	server := newOAuthStandIn(t)
+	store := authentication.NewMemoryTokenStore()
+	key := authentication.TokenKey("test", "id")
+	store.Save(context.Background(), key, &authentication.Token{AccessToken: "old", ExpiresAt: time.Now().Add(30 * time.Second)})
+
+	tk, err := storeAuthenticator(server, store).GetToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk != "token-1" {
+		t.Fatalf("Expected a new token instead of one about to expire, got %s", tk)
+	}
+	if stored, _ := store.Load(context.Background(), key); stored == nil || stored.AccessToken != "token-1" {
+		t.Fatalf("Expected the new token to be saved, got %v", stored)
+	}
+}
+
+func TestTokenStoreReplacesRejectedToken(t *testing.T) {
+	server := newOAuthStandIn(t)
+	store := authentication.NewMemoryTokenStore()
+	auth := storeAuthenticator(server, store)
+	if _, err := auth.GetToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a forced refresh, as after an HTTP 401, must not adopt the token it is replacing
+	if err := auth.GenerateToken(); err != nil {
+		t.Fatal(err)
+	}
+	if tk, _ := auth.GetToken(); tk != "token-2" {
+		t.Fatalf("Expected token-2, got %s", tk)
+	}
+}
+
+func TestTokenStoreKeys(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	store, err := authentication.NewFileTokenStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	if err := store.Save(ctx, authentication.TokenKey("acme", "one"), &authentication.Token{AccessToken: "a", ExpiresAt: expires}); err != nil {
+		t.Fatal(err)
+	}
+	if tk, _ := store.Load(ctx, authentication.TokenKey("acme", "two")); tk != nil {
+		t.Fatalf("Expected no token for another client ID, got %v", tk)
+	}
+	tk, err := store.Load(ctx, authentication.TokenKey("acme", "one"))
+	if err != nil || tk == nil || tk.AccessToken != "a" || !tk.ExpiresAt.Equal(expires) {
+		t.Fatalf("Expected the saved token, got %v (%v)", tk, err)
+	}
+
+	if err := store.Delete(ctx, authentication.TokenKey("acme", "one")); err != nil {
+		t.Fatal(err)
+	}
+	if tk, _ := store.Load(ctx, authentication.TokenKey("acme", "one")); tk != nil {
+		t.Fatalf("Expected the token to be deleted, got %v", tk)
+	}
+}
+
+func TestFileTokenStoreConcurrentSaves(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	ctx := context.Background()
+	key := authentication.TokenKey("acme", "id")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		store, err := authentication.NewFileTokenStore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- store.Save(ctx, key, &authentication.Token{AccessToken: fmt.Sprintf("token-%d", i), ExpiresAt: time.Now().Add(time.Hour)})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store, _ := authentication.NewFileTokenStore(dir)
+	if tk, err := store.Load(ctx, key); err != nil || tk == nil {
+		t.Fatalf("Expected one of the saved tokens, got %v (%v)", tk, err)
+	}
+	if leftovers, _ := filepath.Glob(filepath.Join(dir, ".token-*")); len(leftovers) != 0 {
+		t.Fatalf("Expected no temporary files, got %v", leftovers)
+	}
+}
+
+func TestRevokeDeletesStoredToken(t *testing.T) {
+	server := newOAuthStandIn(t)
+	store := authentication.NewMemoryTokenStore()
+	sdk, err := onelogin.NewOneloginSDK(
+		onelogin.WithCredentials(testCredentials()),
+		onelogin.WithBaseURL(server.URL),
+		onelogin.WithHTTPClient(server.Client()),
+		onelogin.WithTokenStore(store),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := authentication.TokenKey("test", "id")
+	if tk, _ := store.Load(context.Background(), key); tk == nil {
+		t.Fatal("Expected the SDK's token to be saved")
+	}
+
+	if err := sdk.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if tk, _ := store.Load(context.Background(), key); tk != nil {
+		t.Fatalf("Expected the revoked token to be deleted, got %v", tk)
+	}
+}
+
+// slowClient delays every request, widening the window in which processes starting together miss the store.
+type slowClient struct {
+	client *http.Client
+}
+
+func (c slowClient) Do(req *http.Request) (*http.Response, error) {
+	time.Sleep(50 * time.Millisecond)
+	return c.client.Do(req)
+}
+
+func TestFileTokenStoreSerializesTokenRequests(t *testing.T) {
+	server := newOAuthStandIn(t)
+	dir := filepath.Join(t.TempDir(), "tokens")
+
+	// separate stores on the same directory stand in for processes starting together
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		store, err := authentication.NewFileTokenStore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth := authentication.NewAuthenticator("test", testCredentials(),
+			authentication.WithBaseURL(server.URL),
+			authentication.WithHTTPClient(slowClient{server.Client()}),
+			authentication.WithTokenStore(store),
+		)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], _ = auth.GetToken()
+		}(i)
+	}
+	wg.Wait()
+
+	if issued, _ := server.counts(); issued != 1 {
+		t.Fatalf("Expected 1 token request, got %d", issued)
+	}
+	for _, tk := range tokens {
+		if tk != "token-1" {
+			t.Fatalf("Expected every process to use token-1, got %v", tokens)
+		}
+	}
+}
+
+func TestFileTokenStoreRejectsSharedDirectory(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permissions are not enforced on Windows")
+	}
+	dir := t.TempDir()
+	if err := os.Chmod(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := authentication.NewFileTokenStore(dir); err == nil {
+		t.Fatal("Expected a directory other users can access to be rejected")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0755 {
+		t.Fatalf("Expected the directory's mode to be left alone, got %v", info.Mode().Perm())
+	}
+
+	if _, err := authentication.NewFileTokenStore(filepath.Join(dir, "tokens")); err != nil {
+		t.Fatalf("Expected a new directory to be created, got %v", err)
+	}
+}
+
+func TestFileTokenStoreKeepsRequestLockFresh(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tokens")
+	store, err := authentication.NewFileTokenStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock, err := store.Lock(context.Background(), "test/id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	// make the lock look abandoned; its holder refreshes it while the token request runs
+	locks, _ := filepath.Glob(filepath.Join(dir, "*.request.lock"))
+	if len(locks) != 1 {
+		t.Fatalf("Expected 1 request lock file, got %v", locks)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(locks[0], old, old); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(1500 * time.Millisecond)
+
+	other, _ := authentication.NewFileTokenStore(dir)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := other.Lock(ctx, "test/id"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the held lock not to be taken as stale, got %v", err)
+	}
+}
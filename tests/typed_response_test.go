@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+func TestGetUsersTyped(t *testing.T) {
+	header := http.Header{}
+	header.Set("After-Cursor", "next")
+	header.Set("Total-Pages", "3")
+	sdk := mockSDK(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, header, `[{"id":1,"username":"alice"},{"id":2,"username":"bob"}]`), nil
+	})
+
+	res, err := sdk.GetUsersTyped(&models.UserQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Data) != 2 || res.Data[0].Username != "alice" || res.Data[1].ID != 2 {
+		t.Fatalf("Expected alice and bob, got %+v", res.Data)
+	}
+	if res.Metadata.NextCursor != "next" || res.Metadata.TotalPages != 3 {
+		t.Fatalf("Expected cursor next and 3 pages, got %+v", res.Metadata)
+	}
+}
+
+func TestGetUserByIDTyped(t *testing.T) {
+	sdk := mockSDK(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, http.Header{}, `{"id":7,"email":"alice@example.com","custom_attributes":{"team":"core"}}`), nil
+	})
+
+	res, err := sdk.GetUserByIDTyped(7, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Data.ID != 7 || res.Data.Email != "alice@example.com" || res.Data.CustomAttributes["team"] != "core" {
+		t.Fatalf("Expected user 7, got %+v", res.Data)
+	}
+}
+
+func TestGetGroupsTypedUnwrapsV1Envelope(t *testing.T) {
+	body := `{"status":{"error":false,"code":200,"type":"success","message":"Success"},` +
+		`"pagination":{"before_cursor":null,"after_cursor":"abc"},` +
+		`"data":[{"id":1,"name":"Admins","reference":null},{"id":2,"name":"Users","reference":"ref"}]}`
+	sdk := mockSDK(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, http.Header{}, body), nil
+	})
+
+	res, err := sdk.GetGroupsTyped(&models.GroupQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Data) != 2 || res.Data[0].Name != "Admins" || *res.Data[1].Reference != "ref" {
+		t.Fatalf("Expected two groups, got %+v", res.Data)
+	}
+	if res.Metadata.NextCursor != "abc" {
+		t.Fatalf("Expected cursor abc, got %q", res.Metadata.NextCursor)
+	}
+}
+
+func TestGetGroupByIDTypedUnwrapsSingleElement(t *testing.T) {
+	body := `{"status":{"error":false,"code":200},"data":[{"id":5,"name":"Admins"}]}`
+	sdk := mockSDK(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, http.Header{}, body), nil
+	})
+
+	res, err := sdk.GetGroupByIDTyped(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Data.ID != 5 || res.Data.Name != "Admins" {
+		t.Fatalf("Expected group 5, got %+v", res.Data)
+	}
+}
+
+func TestTypedResponseReturnsAPIError(t *testing.T) {
+	sdk := mockSDK(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusNotFound, http.Header{}, `{"statusCode":404,"name":"NotFound","message":"Role not found"}`), nil
+	})
+
+	_, err := sdk.GetRoleByIDTyped(1, nil)
+	if !olerror.IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestTypedResponseRejectsMismatchedBody(t *testing.T) {
+	sdk := mockSDK(func(*http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, http.Header{}, `{"id":1}`), nil
+	})
+
+	if _, err := sdk.GetRolesTyped(nil); err == nil {
+		t.Fatal("Expected an error decoding an object into a list")
+	}
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	olerror "github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/error"
+	"github.com/onelogin/onelogin-go-sdk/v4/pkg/onelogin/models"
+)
+
+func validationFields(t *testing.T, err error) map[string]string {
+	t.Helper()
+	var validationErr *olerror.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected a *ValidationError, got %v", err)
+	}
+	fields := map[string]string{}
+	for _, fe := range validationErr.Fields {
+		fields[fe.Field] = fe.Message
+	}
+	return fields
+}
+
+func TestValidateUserModel(t *testing.T) {
+	user := models.User{
+		Email:                "not-an-email",
+		Password:             "secret1",
+		PasswordConfirmation: "secret2",
+		State:                9,
+	}
+
+	fields := validationFields(t, models.Validate(user))
+	expected := map[string]string{
+		"email":                 "must be an email address",
+		"password_confirmation": "must match password",
+		"state":                 "must be one of 0, 1, 2, 3",
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("Expected %d invalid fields, got %v", len(expected), fields)
+	}
+	for field, msg := range expected {
+		if fields[field] != msg {
+			t.Fatalf("Expected %s to be reported as %q, got %q", field, msg, fields[field])
+		}
+	}
+
+	valid := models.User{Email: "alice@example.com", Password: "secret", PasswordConfirmation: "secret"}
+	if err := models.Validate(valid); err != nil {
+		t.Fatalf("Expected a valid user, got %v", err)
+	}
+}
+
+func TestValidateAppRuleNestedFields(t *testing.T) {
+	rule := models.AppRule{
+		Match:      "some",
+		Conditions: []models.Condition{{Source: "has_role", Operator: "ri"}, {Operator: "ri"}},
+		Actions:    []models.Action{{}},
+	}
+
+	fields := validationFields(t, models.Validate(&rule))
+	for _, field := range []string{"name", "match", "conditions[1].source", "actions[0].action"} {
+		if _, ok := fields[field]; !ok {
+			t.Fatalf("Expected %s to be reported, got %v", field, fields)
+		}
+	}
+	if _, ok := fields["conditions[0].source"]; ok {
+		t.Fatalf("Expected conditions[0] to be valid, got %v", fields)
+	}
+}
+
+func TestValidateQueryUsesJSONNames(t *testing.T) {
+	blank := " "
+	directoryID := "abc"
+	userIDs := "1,,3"
+	query := &models.UserQuery{
+		BaseQueryRequest: models.BaseQueryRequest{Limit: "ten"},
+		Email:            &blank,
+		DirectoryID:      &directoryID,
+		UserIDs:          &userIDs,
+	}
+
+	err := models.ValidateQuery(query)
+	if !olerror.IsValidation(err) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	fields := validationFields(t, err)
+	for _, field := range []string{"limit", "email", "directory_id", "user_ids"} {
+		if _, ok := fields[field]; !ok {
+			t.Fatalf("Expected %s to be reported, got %v", field, fields)
+		}
+	}
+}
+
+type customQuery struct {
+	models.BaseQueryRequest
+	Region string `json:"region,omitempty"`
+}
+
+func (q *customQuery) GetKeyValidators() map[string]func(interface{}) bool {
+	return map[string]func(interface{}) bool{
+		"region": func(v interface{}) bool { return v == "us" || v == "eu" },
+	}
+}
+
+func TestValidateQueryRunsKeyValidators(t *testing.T) {
+	fields := validationFields(t, models.ValidateQuery(&customQuery{Region: "mars"}))
+	if fields["region"] != "is not valid" {
+		t.Fatalf("Expected region to be reported, got %v", fields)
+	}
+	if err := models.ValidateQuery(&customQuery{Region: "eu"}); err != nil {
+		t.Fatalf("Expected a valid query, got %v", err)
+	}
+}
+
+func TestInvalidRequestIsNotSent(t *testing.T) {
+	sent := false
+	sdk := mockSDK(func(req *http.Request) (*http.Response, error) {
+		sent = true
+		return okResponse(req)
+	})
+
+	if _, err := sdk.CreateUser(models.User{Email: "bad"}); !olerror.IsValidation(err) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	if _, err := sdk.GetUsers(&models.UserQuery{BaseQueryRequest: models.BaseQueryRequest{Page: "first"}}); !olerror.IsValidation(err) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	if sent {
+		t.Fatal("Expected invalid requests not to be sent")
+	}
+}